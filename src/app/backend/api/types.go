@@ -87,6 +87,10 @@ type TypeMeta struct {
 type ListMeta struct {
 	// Total number of items on the list. Used for pagination.
 	TotalItems int `json:"totalItems"`
+
+	// Continue is an opaque cursor of the next page. It is set only when cursor pagination is used and there are
+	// more items to fetch.
+	Continue string `json:"continue,omitempty"`
 }
 
 // NewObjectMeta returns internal endpoint name for the given service properties, e.g.,
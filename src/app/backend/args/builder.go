@@ -38,12 +38,18 @@ func (self *holderBuilder) SetPort(port int) *holderBuilder {
 
 // SetTokenTTL 'token-ttl' argument of Dashboard binary.
 func (self *holderBuilder) SetTokenTTL(ttl int) *holderBuilder {
+	self.holder.mux.Lock()
+	defer self.holder.mux.Unlock()
+
 	self.holder.tokenTTL = ttl
 	return self
 }
 
 // SetMetricClientCheckPeriod 'metric-client-check-period' argument of Dashboard binary.
 func (self *holderBuilder) SetMetricClientCheckPeriod(period int) *holderBuilder {
+	self.holder.mux.Lock()
+	defer self.holder.mux.Unlock()
+
 	self.holder.metricClientCheckPeriod = period
 	return self
 }
@@ -110,22 +116,37 @@ func (self *holderBuilder) SetKubeConfigFile(kubeConfigFile string) *holderBuild
 
 // SetSystemBanner 'system-banner' argument of Dashboard binary.
 func (self *holderBuilder) SetSystemBanner(systemBanner string) *holderBuilder {
+	self.holder.mux.Lock()
+	defer self.holder.mux.Unlock()
+
 	self.holder.systemBanner = systemBanner
 	return self
 }
 
 // SetSystemBannerSeverity 'system-banner-severity' argument of Dashboard binary.
 func (self *holderBuilder) SetSystemBannerSeverity(systemBannerSeverity string) *holderBuilder {
+	self.holder.mux.Lock()
+	defer self.holder.mux.Unlock()
+
 	self.holder.systemBannerSeverity = systemBannerSeverity
 	return self
 }
 
 // SetLogLevel 'api-log-level' argument of Dashboard binary.
 func (self *holderBuilder) SetAPILogLevel(apiLogLevel string) *holderBuilder {
+	self.holder.mux.Lock()
+	defer self.holder.mux.Unlock()
+
 	self.holder.apiLogLevel = apiLogLevel
 	return self
 }
 
+// SetLogFormat 'log-format' argument of Dashboard binary.
+func (self *holderBuilder) SetLogFormat(format string) *holderBuilder {
+	self.holder.logFormat = format
+	return self
+}
+
 // SetAuthenticationMode 'authentication-mode' argument of Dashboard binary.
 func (self *holderBuilder) SetAuthenticationMode(authMode []string) *holderBuilder {
 	self.holder.authenticationMode = authMode
@@ -168,6 +189,162 @@ func (self *holderBuilder) SetLocaleConfig(localeConfig string) *holderBuilder {
 	return self
 }
 
+// SetEnableResourceCache 'enable-resource-cache' argument of Dashboard binary.
+func (self *holderBuilder) SetEnableResourceCache(enableResourceCache bool) *holderBuilder {
+	self.holder.enableResourceCache = enableResourceCache
+	return self
+}
+
+// SetResourceCacheAccessReviewTTL 'resource-cache-access-review-ttl' argument of Dashboard binary.
+func (self *holderBuilder) SetResourceCacheAccessReviewTTL(ttl int) *holderBuilder {
+	self.holder.resourceCacheAccessReviewTTL = ttl
+	return self
+}
+
+// SetAuditLogFile 'audit-log-file' argument of Dashboard binary.
+func (self *holderBuilder) SetAuditLogFile(file string) *holderBuilder {
+	self.holder.auditLogFile = file
+	return self
+}
+
+// SetAuditLogMaxSize 'audit-log-max-size' argument of Dashboard binary.
+func (self *holderBuilder) SetAuditLogMaxSize(size int) *holderBuilder {
+	self.holder.auditLogMaxSize = size
+	return self
+}
+
+// SetAuditLogMaxBackups 'audit-log-max-backups' argument of Dashboard binary.
+func (self *holderBuilder) SetAuditLogMaxBackups(backups int) *holderBuilder {
+	self.holder.auditLogMaxBackups = backups
+	return self
+}
+
+// SetAuditLogStdout 'audit-log-stdout' argument of Dashboard binary.
+func (self *holderBuilder) SetAuditLogStdout(stdout bool) *holderBuilder {
+	self.holder.auditLogStdout = stdout
+	return self
+}
+
+// SetAuditWebhookURL 'audit-webhook-url' argument of Dashboard binary.
+func (self *holderBuilder) SetAuditWebhookURL(url string) *holderBuilder {
+	self.holder.auditWebhookURL = url
+	return self
+}
+
+// SetRateLimitRead 'rate-limit-read' argument of Dashboard binary.
+func (self *holderBuilder) SetRateLimitRead(limit int) *holderBuilder {
+	self.holder.rateLimitRead = limit
+	return self
+}
+
+// SetRateLimitWrite 'rate-limit-write' argument of Dashboard binary.
+func (self *holderBuilder) SetRateLimitWrite(limit int) *holderBuilder {
+	self.holder.rateLimitWrite = limit
+	return self
+}
+
+// SetRateLimitExec 'rate-limit-exec' argument of Dashboard binary.
+func (self *holderBuilder) SetRateLimitExec(limit int) *holderBuilder {
+	self.holder.rateLimitExec = limit
+	return self
+}
+
+// SetRateLimitLogs 'rate-limit-logs' argument of Dashboard binary.
+func (self *holderBuilder) SetRateLimitLogs(limit int) *holderBuilder {
+	self.holder.rateLimitLogs = limit
+	return self
+}
+
+// SetRateLimitBurst 'rate-limit-burst' argument of Dashboard binary.
+func (self *holderBuilder) SetRateLimitBurst(limit int) *holderBuilder {
+	self.holder.rateLimitBurst = limit
+	return self
+}
+
+// SetRateLimitTrustedProxies 'rate-limit-trusted-proxies' argument of Dashboard binary.
+func (self *holderBuilder) SetRateLimitTrustedProxies(proxies []string) *holderBuilder {
+	self.holder.rateLimitTrustedProxies = proxies
+	return self
+}
+
+// SetRequestTimeout 'request-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetRequestTimeout(timeout int) *holderBuilder {
+	self.holder.requestTimeout = timeout
+	return self
+}
+
+// SetRouteTimeouts 'route-timeouts' argument of Dashboard binary.
+func (self *holderBuilder) SetRouteTimeouts(timeouts map[string]int) *holderBuilder {
+	self.holder.routeTimeouts = timeouts
+	return self
+}
+
+// SetShutdownTimeout 'shutdown-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetShutdownTimeout(timeout int) *holderBuilder {
+	self.holder.shutdownTimeout = timeout
+	return self
+}
+
+// SetConfigFile 'config' argument of Dashboard binary.
+func (self *holderBuilder) SetConfigFile(configFile string) *holderBuilder {
+	self.holder.configFile = configFile
+	return self
+}
+
+// SetKubeConfigContexts 'kubeconfig-contexts' argument of Dashboard binary.
+func (self *holderBuilder) SetKubeConfigContexts(contexts []string) *holderBuilder {
+	self.holder.kubeConfigContexts = contexts
+	return self
+}
+
+// SetClusterRegistryFile 'cluster-registry' argument of Dashboard binary.
+func (self *holderBuilder) SetClusterRegistryFile(clusterRegistryFile string) *holderBuilder {
+	self.holder.clusterRegistryFile = clusterRegistryFile
+	return self
+}
+
+// SetOIDCIssuerURL 'oidc-issuer-url' argument of Dashboard binary.
+func (self *holderBuilder) SetOIDCIssuerURL(issuerURL string) *holderBuilder {
+	self.holder.oidcIssuerURL = issuerURL
+	return self
+}
+
+// SetOIDCClientID 'oidc-client-id' argument of Dashboard binary.
+func (self *holderBuilder) SetOIDCClientID(clientID string) *holderBuilder {
+	self.holder.oidcClientID = clientID
+	return self
+}
+
+// SetOIDCClientSecret 'oidc-client-secret' argument of Dashboard binary.
+func (self *holderBuilder) SetOIDCClientSecret(clientSecret string) *holderBuilder {
+	self.holder.oidcClientSecret = clientSecret
+	return self
+}
+
+// SetOIDCRedirectURL 'oidc-redirect-url' argument of Dashboard binary.
+func (self *holderBuilder) SetOIDCRedirectURL(redirectURL string) *holderBuilder {
+	self.holder.oidcRedirectURL = redirectURL
+	return self
+}
+
+// SetOIDCScopes 'oidc-scopes' argument of Dashboard binary.
+func (self *holderBuilder) SetOIDCScopes(scopes []string) *holderBuilder {
+	self.holder.oidcScopes = scopes
+	return self
+}
+
+// SetTokenKeyRotationInterval 'token-key-rotation-interval' argument of Dashboard binary.
+func (self *holderBuilder) SetTokenKeyRotationInterval(interval int) *holderBuilder {
+	self.holder.tokenKeyRotationInterval = interval
+	return self
+}
+
+// SetTokenKeyMaxAge 'token-key-max-age' argument of Dashboard binary.
+func (self *holderBuilder) SetTokenKeyMaxAge(maxAge int) *holderBuilder {
+	self.holder.tokenKeyMaxAge = maxAge
+	return self
+}
+
 // GetHolderBuilder returns singleton instance of argument holder builder.
 func GetHolderBuilder() *holderBuilder {
 	return builder
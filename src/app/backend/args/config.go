@@ -0,0 +1,210 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package args
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// reloadableArguments are arguments, that are applied without restart when they change in the config file.
+var reloadableArguments = map[string]bool{
+	"system-banner":              true,
+	"system-banner-severity":     true,
+	"api-log-level":              true,
+	"token-ttl":                  true,
+	"metric-client-check-period": true,
+}
+
+// ConfigWatcher loads arguments of Dashboard binary from YAML config file, that maps flag names to their values.
+// Flags set on the command line override values from the file. Once started, it polls the file for changes and
+// applies changed reloadable arguments.
+type ConfigWatcher struct {
+	path  string
+	flags *pflag.FlagSet
+
+	// commandLine holds names of flags set on the command line.
+	commandLine map[string]bool
+	// values holds values of arguments read from the file.
+	values  map[string]string
+	content []byte
+}
+
+// Load reads the config file and sets flags that were not set on the command line.
+func (self *ConfigWatcher) Load() error {
+	content, values, err := self.read()
+	if err != nil {
+		return err
+	}
+
+	for name, value := range values {
+		if self.commandLine[name] {
+			continue
+		}
+
+		if err = self.flags.Set(name, value); err != nil {
+			return fmt.Errorf("invalid value of %s in config file: %s", name, err.Error())
+		}
+	}
+
+	self.content = content
+	self.values = values
+	return nil
+}
+
+// Watch polls the config file every period until stopCh is closed. Changed reloadable arguments are set on the
+// flags and their names are passed to the onReload function. Changes of other arguments are only logged, as they
+// need a restart.
+func (self *ConfigWatcher) Watch(period time.Duration, stopCh <-chan struct{}, onReload func(changed []string)) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			changed, err := self.reload()
+			if err != nil {
+				log.Printf("Could not reload config file %s: %s", self.path, err.Error())
+				continue
+			}
+
+			if len(changed) > 0 {
+				onReload(changed)
+			}
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// reload applies changes of the config file and returns names of changed reloadable arguments.
+func (self *ConfigWatcher) reload() ([]string, error) {
+	content, values, err := self.read()
+	if err != nil || bytes.Equal(content, self.content) {
+		return nil, err
+	}
+
+	names := make([]string, 0)
+	for name := range values {
+		names = append(names, name)
+	}
+	for name := range self.values {
+		if _, exists := values[name]; !exists {
+			// Removed arguments get back their default values.
+			values[name] = self.flags.Lookup(name).DefValue
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changed := make([]string, 0)
+	for _, name := range names {
+		previous, exists := self.values[name]
+		if !exists {
+			previous = self.flags.Lookup(name).DefValue
+		}
+
+		switch {
+		case values[name] == previous:
+			continue
+		case self.commandLine[name]:
+			log.Printf("Argument %s changed in config file, but it is overridden by command line flag", name)
+		case !reloadableArguments[name]:
+			log.Printf("Argument %s changed in config file, restart Dashboard to apply it", name)
+		default:
+			if err = self.flags.Set(name, values[name]); err != nil {
+				log.Printf("Invalid value of %s in config file: %s", name, err.Error())
+				values[name] = previous
+				continue
+			}
+			changed = append(changed, name)
+		}
+	}
+
+	self.content = content
+	self.values = values
+	return changed, nil
+}
+
+// read reads the config file and converts its values to the string form accepted by flags.
+func (self *ConfigWatcher) read() ([]byte, map[string]string, error) {
+	content, err := ioutil.ReadFile(self.path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config := make(map[string]interface{})
+	if err = yaml.Unmarshal(content, &config); err != nil {
+		return nil, nil, err
+	}
+
+	values := make(map[string]string, len(config))
+	for name, value := range config {
+		if name == "config" || self.flags.Lookup(name) == nil {
+			return nil, nil, fmt.Errorf("unknown argument %s in config file", name)
+		}
+		values[name] = toFlagValue(value)
+	}
+
+	return content, values, nil
+}
+
+// toFlagValue converts YAML value to flag value. Lists are converted to comma separated values and maps to comma
+// separated key=value pairs.
+func toFlagValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = fmt.Sprint(item)
+		}
+		return strings.Join(items, ",")
+	case map[interface{}]interface{}:
+		items := make([]string, 0, len(v))
+		for key, item := range v {
+			items = append(items, fmt.Sprintf("%v=%v", key, item))
+		}
+		sort.Strings(items)
+		return strings.Join(items, ",")
+	}
+
+	return fmt.Sprint(value)
+}
+
+// NewConfigWatcher creates watcher of given config file. It has to be created after flags are parsed, so it can
+// tell which of them were set on the command line.
+func NewConfigWatcher(path string, flags *pflag.FlagSet) *ConfigWatcher {
+	commandLine := make(map[string]bool)
+	flags.Visit(func(flag *pflag.Flag) {
+		commandLine[flag.Name] = true
+	})
+
+	return &ConfigWatcher{
+		path:        path,
+		flags:       flags,
+		commandLine: commandLine,
+		values:      make(map[string]string),
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package args
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func newTestFlags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.Int("port", 8443, "")
+	flags.String("system-banner", "", "")
+	flags.StringSlice("authentication-mode", []string{"token"}, "")
+	flags.StringToInt("route-timeouts", map[string]int{}, "")
+	return flags
+}
+
+func writeConfig(t *testing.T, path, content string) {
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConfigWatcherLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, path, `
+port: 9443
+system-banner: Hello
+authentication-mode: [token, basic]
+route-timeouts:
+  /api/v1/search: 120
+`)
+
+	flags := newTestFlags()
+	if err := flags.Parse([]string{"--port=8000"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewConfigWatcher(path, flags).Load(); err != nil {
+		t.Fatalf("Load(): unexpected error: %s", err)
+	}
+
+	port, _ := flags.GetInt("port")
+	banner, _ := flags.GetString("system-banner")
+	modes, _ := flags.GetStringSlice("authentication-mode")
+	timeouts, _ := flags.GetStringToInt("route-timeouts")
+	if port != 8000 {
+		t.Errorf("Expected port set on the command line to take precedence, got %d", port)
+	}
+	if banner != "Hello" {
+		t.Errorf("Expected system banner %q, got %q", "Hello", banner)
+	}
+	if !reflect.DeepEqual(modes, []string{"token", "basic"}) {
+		t.Errorf("Expected authentication modes %v, got %v", []string{"token", "basic"}, modes)
+	}
+	if !reflect.DeepEqual(timeouts, map[string]int{"/api/v1/search": 120}) {
+		t.Errorf("Expected route timeouts %v, got %v", map[string]int{"/api/v1/search": 120}, timeouts)
+	}
+}
+
+func TestConfigWatcherLoadUnknownArgument(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, path, "unknown: true\n")
+
+	if err := NewConfigWatcher(path, newTestFlags()).Load(); err == nil {
+		t.Error("Load(): expected error for unknown argument")
+	}
+}
+
+func TestConfigWatcherReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, path, "port: 9443\nsystem-banner: Hello\n")
+
+	flags := newTestFlags()
+	watcher := NewConfigWatcher(path, flags)
+	if err := watcher.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		info    string
+		content string
+		changed []string
+		port    int
+		banner  string
+	}{
+		{"file did not change", "port: 9443\nsystem-banner: Hello\n", nil, 9443, "Hello"},
+		{"reloadable argument changed", "port: 9443\nsystem-banner: Bye\n", []string{"system-banner"}, 9443, "Bye"},
+		{"argument that needs restart changed", "port: 10443\nsystem-banner: Bye\n", []string{}, 9443, "Bye"},
+		{"reloadable argument removed", "port: 10443\n", []string{"system-banner"}, 9443, ""},
+	}
+
+	for _, c := range cases {
+		writeConfig(t, path, c.content)
+		changed, err := watcher.reload()
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %s", c.info, err)
+		}
+
+		port, _ := flags.GetInt("port")
+		banner, _ := flags.GetString("system-banner")
+		if !reflect.DeepEqual(changed, c.changed) || port != c.port || banner != c.banner {
+			t.Errorf("Test Case: %s. Expected changed %v, port %d and banner %q, got %v, %d and %q", c.info,
+				c.changed, c.port, c.banner, changed, port, banner)
+		}
+	}
+}
@@ -16,6 +16,7 @@ package args
 
 import (
 	"net"
+	"sync"
 
 	"github.com/kubernetes/dashboard/src/app/backend/cert/api"
 )
@@ -25,6 +26,9 @@ var Holder = &holder{}
 // Argument holder structure. It is private to make sure that only 1 instance can be created. It holds all
 // arguments values passed to Dashboard binary.
 type holder struct {
+	// mux guards arguments, that can be reloaded from the config file while Dashboard is running.
+	mux sync.RWMutex
+
 	insecurePort            int
 	port                    int
 	tokenTTL                int
@@ -44,6 +48,7 @@ type holder struct {
 	systemBanner         string
 	systemBannerSeverity string
 	apiLogLevel          string
+	logFormat            string
 	namespace            string
 
 	authenticationMode []string
@@ -55,6 +60,42 @@ type holder struct {
 	enableSkipLogin bool
 
 	localeConfig string
+
+	enableResourceCache          bool
+	resourceCacheAccessReviewTTL int
+
+	auditLogFile       string
+	auditLogMaxSize    int
+	auditLogMaxBackups int
+	auditLogStdout     bool
+	auditWebhookURL    string
+
+	rateLimitRead  int
+	rateLimitWrite int
+	rateLimitExec  int
+	rateLimitLogs  int
+	rateLimitBurst int
+
+	rateLimitTrustedProxies []string
+
+	requestTimeout int
+	routeTimeouts  map[string]int
+
+	shutdownTimeout int
+
+	configFile string
+
+	kubeConfigContexts  []string
+	clusterRegistryFile string
+
+	oidcIssuerURL    string
+	oidcClientID     string
+	oidcClientSecret string
+	oidcRedirectURL  string
+	oidcScopes       []string
+
+	tokenKeyRotationInterval int
+	tokenKeyMaxAge           int
 }
 
 // GetInsecurePort 'insecure-port' argument of Dashboard binary.
@@ -69,11 +110,17 @@ func (self *holder) GetPort() int {
 
 // GetTokenTTL 'token-ttl' argument of Dashboard binary.
 func (self *holder) GetTokenTTL() int {
+	self.mux.RLock()
+	defer self.mux.RUnlock()
+
 	return self.tokenTTL
 }
 
 // GetMetricClientCheckPeriod 'metric-client-check-period' argument of Dashboard binary.
 func (self *holder) GetMetricClientCheckPeriod() int {
+	self.mux.RLock()
+	defer self.mux.RUnlock()
+
 	return self.metricClientCheckPeriod
 }
 
@@ -137,19 +184,33 @@ func (self *holder) GetKubeConfigFile() string {
 
 // GetSystemBanner 'system-banner' argument of Dashboard binary.
 func (self *holder) GetSystemBanner() string {
+	self.mux.RLock()
+	defer self.mux.RUnlock()
+
 	return self.systemBanner
 }
 
 // GetSystemBannerSeverity 'system-banner-severity' argument of Dashboard binary.
 func (self *holder) GetSystemBannerSeverity() string {
+	self.mux.RLock()
+	defer self.mux.RUnlock()
+
 	return self.systemBannerSeverity
 }
 
 // LogLevel 'api-log-level' argument of Dashboard binary.
 func (self *holder) GetAPILogLevel() string {
+	self.mux.RLock()
+	defer self.mux.RUnlock()
+
 	return self.apiLogLevel
 }
 
+// GetLogFormat 'log-format' argument of Dashboard binary.
+func (self *holder) GetLogFormat() string {
+	return self.logFormat
+}
+
 // GetAuthenticationMode 'authentication-mode' argument of Dashboard binary.
 func (self *holder) GetAuthenticationMode() []string {
 	return self.authenticationMode
@@ -184,3 +245,133 @@ func (self *holder) GetNamespace() string {
 func (self *holder) GetLocaleConfig() string {
 	return self.localeConfig
 }
+
+// GetEnableResourceCache 'enable-resource-cache' argument of Dashboard binary.
+func (self *holder) GetEnableResourceCache() bool {
+	return self.enableResourceCache
+}
+
+// GetResourceCacheAccessReviewTTL 'resource-cache-access-review-ttl' argument of Dashboard binary.
+func (self *holder) GetResourceCacheAccessReviewTTL() int {
+	return self.resourceCacheAccessReviewTTL
+}
+
+// GetAuditLogFile 'audit-log-file' argument of Dashboard binary.
+func (self *holder) GetAuditLogFile() string {
+	return self.auditLogFile
+}
+
+// GetAuditLogMaxSize 'audit-log-max-size' argument of Dashboard binary.
+func (self *holder) GetAuditLogMaxSize() int {
+	return self.auditLogMaxSize
+}
+
+// GetAuditLogMaxBackups 'audit-log-max-backups' argument of Dashboard binary.
+func (self *holder) GetAuditLogMaxBackups() int {
+	return self.auditLogMaxBackups
+}
+
+// GetAuditLogStdout 'audit-log-stdout' argument of Dashboard binary.
+func (self *holder) GetAuditLogStdout() bool {
+	return self.auditLogStdout
+}
+
+// GetAuditWebhookURL 'audit-webhook-url' argument of Dashboard binary.
+func (self *holder) GetAuditWebhookURL() string {
+	return self.auditWebhookURL
+}
+
+// GetRateLimitRead 'rate-limit-read' argument of Dashboard binary.
+func (self *holder) GetRateLimitRead() int {
+	return self.rateLimitRead
+}
+
+// GetRateLimitWrite 'rate-limit-write' argument of Dashboard binary.
+func (self *holder) GetRateLimitWrite() int {
+	return self.rateLimitWrite
+}
+
+// GetRateLimitExec 'rate-limit-exec' argument of Dashboard binary.
+func (self *holder) GetRateLimitExec() int {
+	return self.rateLimitExec
+}
+
+// GetRateLimitLogs 'rate-limit-logs' argument of Dashboard binary.
+func (self *holder) GetRateLimitLogs() int {
+	return self.rateLimitLogs
+}
+
+// GetRateLimitBurst 'rate-limit-burst' argument of Dashboard binary.
+func (self *holder) GetRateLimitBurst() int {
+	return self.rateLimitBurst
+}
+
+// GetRateLimitTrustedProxies 'rate-limit-trusted-proxies' argument of Dashboard binary.
+func (self *holder) GetRateLimitTrustedProxies() []string {
+	return self.rateLimitTrustedProxies
+}
+
+// GetRequestTimeout 'request-timeout' argument of Dashboard binary.
+func (self *holder) GetRequestTimeout() int {
+	return self.requestTimeout
+}
+
+// GetRouteTimeouts 'route-timeouts' argument of Dashboard binary.
+func (self *holder) GetRouteTimeouts() map[string]int {
+	return self.routeTimeouts
+}
+
+// GetShutdownTimeout 'shutdown-timeout' argument of Dashboard binary.
+func (self *holder) GetShutdownTimeout() int {
+	return self.shutdownTimeout
+}
+
+// GetConfigFile 'config' argument of Dashboard binary.
+func (self *holder) GetConfigFile() string {
+	return self.configFile
+}
+
+// GetKubeConfigContexts 'kubeconfig-contexts' argument of Dashboard binary.
+func (self *holder) GetKubeConfigContexts() []string {
+	return self.kubeConfigContexts
+}
+
+// GetClusterRegistryFile 'cluster-registry' argument of Dashboard binary.
+func (self *holder) GetClusterRegistryFile() string {
+	return self.clusterRegistryFile
+}
+
+// GetOIDCIssuerURL 'oidc-issuer-url' argument of Dashboard binary.
+func (self *holder) GetOIDCIssuerURL() string {
+	return self.oidcIssuerURL
+}
+
+// GetOIDCClientID 'oidc-client-id' argument of Dashboard binary.
+func (self *holder) GetOIDCClientID() string {
+	return self.oidcClientID
+}
+
+// GetOIDCClientSecret 'oidc-client-secret' argument of Dashboard binary.
+func (self *holder) GetOIDCClientSecret() string {
+	return self.oidcClientSecret
+}
+
+// GetOIDCRedirectURL 'oidc-redirect-url' argument of Dashboard binary.
+func (self *holder) GetOIDCRedirectURL() string {
+	return self.oidcRedirectURL
+}
+
+// GetOIDCScopes 'oidc-scopes' argument of Dashboard binary.
+func (self *holder) GetOIDCScopes() []string {
+	return self.oidcScopes
+}
+
+// GetTokenKeyRotationInterval 'token-key-rotation-interval' argument of Dashboard binary.
+func (self *holder) GetTokenKeyRotationInterval() int {
+	return self.tokenKeyRotationInterval
+}
+
+// GetTokenKeyMaxAge 'token-key-max-age' argument of Dashboard binary.
+func (self *holder) GetTokenKeyMaxAge() int {
+	return self.tokenKeyMaxAge
+}
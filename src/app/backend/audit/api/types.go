@@ -0,0 +1,83 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"time"
+)
+
+const (
+	// MaxRecentEntries is the number of most recent entries kept in memory and served by the audit API.
+	MaxRecentEntries = 1000
+
+	// DefaultRecentEntries is the number of entries returned by the audit API when no limit is set.
+	DefaultRecentEntries = 100
+)
+
+// AuditManager records mutating actions done through Dashboard and keeps the most recent ones in memory.
+type AuditManager interface {
+	// Record passes the entry to all configured sinks.
+	Record(entry Entry)
+	// Recent returns up to limit most recent entries of given cluster, newest first. All entries are returned if
+	// limit is not positive.
+	Recent(cluster string, limit int) []Entry
+	// Close flushes and closes all configured sinks.
+	Close() error
+}
+
+// Sink is a destination of audit entries, e.g. a file or a webhook.
+type Sink interface {
+	// Write persists or forwards a single entry.
+	Write(entry Entry) error
+	// Close releases resources held by the sink.
+	Close() error
+}
+
+// Entry describes a single mutating action done through Dashboard.
+type Entry struct {
+	// Timestamp of the request.
+	Timestamp time.Time `json:"timestamp"`
+
+	// User is the name of the authenticated user. Empty if the request was done with privileges of Dashboard.
+	User string `json:"user"`
+
+	// SourceIP is the address of the client, taking proxy headers into account.
+	SourceIP string `json:"sourceIP"`
+
+	// Verb is the HTTP method of the request.
+	Verb string `json:"verb"`
+
+	// Cluster to which the request was routed.
+	Cluster string `json:"cluster,omitempty"`
+
+	// Path of the request without query parameters and cluster prefix.
+	Path string `json:"path"`
+
+	// Kind, Namespace and Name identify the target of the action as far as they are known from the path.
+	Kind      string `json:"kind,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+
+	// Summary lists changed fields of the request body. Values are never recorded.
+	Summary string `json:"summary,omitempty"`
+
+	// StatusCode of the response.
+	StatusCode int `json:"statusCode"`
+}
+
+// EntryList is a list of recent audit entries.
+type EntryList struct {
+	Entries []Entry `json:"entries"`
+}
@@ -0,0 +1,89 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	goerrors "errors"
+	"net/http"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubernetes/dashboard/src/app/backend/audit/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// AuditHandler manages all endpoints related to the audit log.
+type AuditHandler struct {
+	manager       api.AuditManager
+	clientManager clientapi.ClientManager
+	// Returns name of the cluster to which request was routed. Audit manager is shared by all clusters, but users
+	// can read only entries of the cluster in which they are admins.
+	getCluster func(request *http.Request) string
+}
+
+// Install creates new endpoints for the audit log.
+func (self AuditHandler) Install(ws *restful.WebService) {
+	ws.Route(
+		ws.GET("/audit").
+			To(self.handleGetAuditEntries).
+			Writes(api.EntryList{}))
+}
+
+func (self AuditHandler) handleGetAuditEntries(request *restful.Request, response *restful.Response) {
+	// Audit entries reveal actions of all users, so only cluster admins can read them, and only entries of their
+	// cluster.
+	if !self.clientManager.CanI(request, clusterAdminAccessReview()) {
+		errors.HandleInternalError(response, k8serrors.NewForbidden(schema.GroupResource{Resource: "audit"}, "",
+			goerrors.New("only cluster admins can read audit entries")))
+		return
+	}
+
+	limit := api.DefaultRecentEntries
+	if value := request.QueryParameter("limit"); len(value) > 0 {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			errors.HandleInternalError(response, errors.NewBadRequest("limit must be a positive number"))
+			return
+		}
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, api.EntryList{
+		Entries: self.manager.Recent(self.getCluster(request.Request), limit),
+	})
+}
+
+func clusterAdminAccessReview() *authorizationv1.SelfSubjectAccessReview {
+	return &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:    "*",
+				Resource: "*",
+				Verb:     "*",
+			},
+		},
+	}
+}
+
+// NewAuditHandler creates AuditHandler. getCluster returns name of the cluster to which request was routed.
+func NewAuditHandler(manager api.AuditManager, clientManager clientapi.ClientManager,
+	getCluster func(request *http.Request) string) AuditHandler {
+	return AuditHandler{manager: manager, clientManager: clientManager, getCluster: getCluster}
+}
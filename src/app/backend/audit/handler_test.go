@@ -0,0 +1,78 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	restful "github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/audit/api"
+)
+
+func TestHandleGetAuditEntries(t *testing.T) {
+	manager := NewAuditManager()
+	manager.Record(api.Entry{Cluster: "production", Name: "a"})
+	manager.Record(api.Entry{Cluster: "staging", Name: "b"})
+	manager.Record(api.Entry{Cluster: "production", Name: "c"})
+
+	cases := []struct {
+		info     string
+		cluster  string
+		admin    bool
+		code     int
+		expected []string
+	}{
+		{"admin of production cluster reads its entries", "production", true, http.StatusOK, []string{"c", "a"}},
+		{"admin of staging cluster reads its entries", "staging", true, http.StatusOK, []string{"b"}},
+		{"non-admin can not read entries", "production", false, http.StatusForbidden, nil},
+	}
+
+	for _, c := range cases {
+		ws := new(restful.WebService)
+		ws.Produces(restful.MIME_JSON)
+		NewAuditHandler(manager, &fakeClientManager{admin: c.admin}, func(request *http.Request) string {
+			return c.cluster
+		}).Install(ws)
+		container := restful.NewContainer()
+		container.Add(ws)
+
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/audit", nil))
+		if recorder.Code != c.code {
+			t.Errorf("Test Case: %s. Expected status code %d, got %d", c.info, c.code, recorder.Code)
+			continue
+		}
+		if c.code != http.StatusOK {
+			continue
+		}
+
+		list := api.EntryList{}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &list); err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %v", c.info, err)
+		}
+		actual := make([]string, 0)
+		for _, entry := range list.Entries {
+			actual = append(actual, entry.Name)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected entries %v, got %v", c.info, c.expected, actual)
+		}
+	}
+}
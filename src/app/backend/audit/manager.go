@@ -0,0 +1,87 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"log"
+	"sync"
+
+	"github.com/kubernetes/dashboard/src/app/backend/audit/api"
+)
+
+// auditManager implements AuditManager interface. It keeps recent entries in a ring buffer.
+type auditManager struct {
+	mux     sync.RWMutex
+	sinks   []api.Sink
+	recent  []api.Entry
+	next    int
+	wrapped bool
+}
+
+// Record implements AuditManager interface. See AuditManager for more information.
+func (self *auditManager) Record(entry api.Entry) {
+	self.mux.Lock()
+	self.recent[self.next] = entry
+	self.next = (self.next + 1) % len(self.recent)
+	if self.next == 0 {
+		self.wrapped = true
+	}
+	self.mux.Unlock()
+
+	for _, sink := range self.sinks {
+		if err := sink.Write(entry); err != nil {
+			log.Printf("Could not write audit entry: %s", err.Error())
+		}
+	}
+}
+
+// Recent implements AuditManager interface. See AuditManager for more information.
+func (self *auditManager) Recent(cluster string, limit int) []api.Entry {
+	self.mux.RLock()
+	defer self.mux.RUnlock()
+
+	size := self.next
+	if self.wrapped {
+		size = len(self.recent)
+	}
+	if limit <= 0 || limit > size {
+		limit = size
+	}
+
+	result := make([]api.Entry, 0, limit)
+	for i := 1; i <= size && len(result) < limit; i++ {
+		entry := self.recent[(self.next-i+len(self.recent))%len(self.recent)]
+		if entry.Cluster == cluster {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// Close implements AuditManager interface. All sinks are closed, the first error is returned.
+func (self *auditManager) Close() (result error) {
+	for _, sink := range self.sinks {
+		if err := sink.Close(); err != nil && result == nil {
+			result = err
+		}
+	}
+	return
+}
+
+// NewAuditManager creates audit manager that writes entries to the given sinks and keeps the last
+// api.MaxRecentEntries entries in memory.
+func NewAuditManager(sinks ...api.Sink) api.AuditManager {
+	return &auditManager{sinks: sinks, recent: make([]api.Entry, api.MaxRecentEntries)}
+}
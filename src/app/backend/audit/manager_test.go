@@ -0,0 +1,104 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/audit/api"
+)
+
+type fakeSink struct {
+	entries []api.Entry
+	err     error
+	closed  bool
+}
+
+func (self *fakeSink) Write(entry api.Entry) error {
+	self.entries = append(self.entries, entry)
+	return self.err
+}
+
+func (self *fakeSink) Close() error {
+	self.closed = true
+	return self.err
+}
+
+func TestAuditManagerRecent(t *testing.T) {
+	cases := []struct {
+		recorded int
+		limit    int
+		expected []string
+	}{
+		{0, 10, []string{}},
+		{3, 10, []string{"2", "1", "0"}},
+		{3, 2, []string{"2", "1"}},
+		{3, 0, []string{"2", "1", "0"}},
+		{api.MaxRecentEntries + 2, 3, []string{"1001", "1000", "999"}},
+	}
+
+	for _, c := range cases {
+		manager := NewAuditManager()
+		for i := 0; i < c.recorded; i++ {
+			manager.Record(api.Entry{Name: strconv.Itoa(i)})
+		}
+
+		actual := make([]string, 0)
+		for _, entry := range manager.Recent("", c.limit) {
+			actual = append(actual, entry.Name)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Recent(%d) after %d entries returned %v, expected %v", c.limit, c.recorded, actual,
+				c.expected)
+		}
+	}
+}
+
+func TestAuditManagerRecentOfCluster(t *testing.T) {
+	manager := NewAuditManager()
+	for i := 0; i < 6; i++ {
+		cluster := "production"
+		if i%2 == 1 {
+			cluster = "staging"
+		}
+		manager.Record(api.Entry{Cluster: cluster, Name: strconv.Itoa(i)})
+	}
+
+	actual := make([]string, 0)
+	for _, entry := range manager.Recent("staging", 2) {
+		actual = append(actual, entry.Name)
+	}
+	if expected := []string{"5", "3"}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Recent(staging, 2) returned %v, expected %v", actual, expected)
+	}
+}
+
+func TestAuditManagerShouldWriteToAllSinks(t *testing.T) {
+	failing := &fakeSink{err: errors.New("failed")}
+	working := &fakeSink{}
+	manager := NewAuditManager(failing, working)
+
+	manager.Record(api.Entry{Name: "a"})
+
+	if len(failing.entries) != 1 || len(working.entries) != 1 {
+		t.Errorf("Expected entry in all sinks, got %v and %v", failing.entries, working.entries)
+	}
+	if err := manager.Close(); err == nil || !failing.closed || !working.closed {
+		t.Errorf("Expected all sinks to be closed and error to be returned, got %v", err)
+	}
+}
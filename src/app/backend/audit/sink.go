@@ -0,0 +1,221 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kubernetes/dashboard/src/app/backend/audit/api"
+)
+
+const (
+	// webhookQueueSize is the number of entries buffered by the webhook sink before new entries are dropped.
+	webhookQueueSize = 1000
+
+	// webhookTimeout is the timeout of a single webhook request.
+	webhookTimeout = 10 * time.Second
+)
+
+// writerSink writes entries as JSON lines to the writer, e.g. standard output.
+type writerSink struct {
+	mux    sync.Mutex
+	writer io.Writer
+}
+
+// Write implements Sink interface. See Sink for more information.
+func (self *writerSink) Write(entry api.Entry) error {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	return json.NewEncoder(self.writer).Encode(entry)
+}
+
+// Close implements Sink interface. The writer is owned by the caller and is not closed.
+func (self *writerSink) Close() error {
+	return nil
+}
+
+// NewWriterSink creates sink that writes entries as JSON lines to the given writer.
+func NewWriterSink(writer io.Writer) api.Sink {
+	return &writerSink{writer: writer}
+}
+
+// fileSink writes entries as JSON lines to a file. The file is rotated when it would grow over maxSize bytes.
+// Rotated files get a numeric suffix, the oldest ones are removed when there are more than maxBackups of them.
+type fileSink struct {
+	mux        sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// Write implements Sink interface. See Sink for more information.
+func (self *fileSink) Write(entry api.Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	if self.size > 0 && self.size+int64(len(line)) > self.maxSize {
+		if err := self.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := self.file.Write(line)
+	self.size += int64(n)
+	return err
+}
+
+// Close implements Sink interface. See Sink for more information.
+func (self *fileSink) Close() error {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	return self.file.Close()
+}
+
+func (self *fileSink) rotate() error {
+	if err := self.file.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Remove(self.backupPath(self.maxBackups)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := self.maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(self.backupPath(i), self.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if self.maxBackups > 0 {
+		if err := os.Rename(self.path, self.backupPath(1)); err != nil {
+			return err
+		}
+	}
+
+	return self.open(os.O_TRUNC)
+}
+
+func (self *fileSink) backupPath(index int) string {
+	if index == 0 {
+		return self.path
+	}
+	return fmt.Sprintf("%s.%d", self.path, index)
+}
+
+func (self *fileSink) open(flag int) error {
+	file, err := os.OpenFile(self.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND|flag, 0600)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	self.file = file
+	self.size = info.Size()
+	return nil
+}
+
+// NewFileSink creates sink that appends entries as JSON lines to the file at the given path. The file is rotated
+// when it grows over maxSize bytes and at most maxBackups rotated files are kept.
+func NewFileSink(path string, maxSize int64, maxBackups int) (api.Sink, error) {
+	sink := &fileSink{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := sink.open(0); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
+// webhookSink posts every entry as JSON to the webhook URL. Entries are sent in the background, so that slow
+// webhooks do not delay responses of Dashboard.
+type webhookSink struct {
+	url    string
+	client *http.Client
+	queue  chan api.Entry
+	done   chan struct{}
+}
+
+// Write implements Sink interface. The entry is dropped if the queue of the webhook is full.
+func (self *webhookSink) Write(entry api.Entry) error {
+	select {
+	case self.queue <- entry:
+		return nil
+	default:
+		return fmt.Errorf("audit webhook queue is full, entry dropped")
+	}
+}
+
+// Close implements Sink interface. It waits until queued entries are sent.
+func (self *webhookSink) Close() error {
+	close(self.queue)
+	<-self.done
+	return nil
+}
+
+func (self *webhookSink) run() {
+	defer close(self.done)
+	for entry := range self.queue {
+		if err := self.send(entry); err != nil {
+			log.Printf("Could not send audit entry to webhook: %s", err.Error())
+		}
+	}
+}
+
+func (self *webhookSink) send(entry api.Entry) error {
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	response, err := self.client.Post(self.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+	return nil
+}
+
+// NewWebhookSink creates sink that posts entries as JSON to the given URL.
+func NewWebhookSink(url string) api.Sink {
+	sink := &webhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+		queue:  make(chan api.Entry, webhookQueueSize),
+		done:   make(chan struct{}),
+	}
+	go sink.run()
+	return sink
+}
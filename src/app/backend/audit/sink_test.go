@@ -0,0 +1,125 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/audit/api"
+)
+
+func TestWriterSink(t *testing.T) {
+	out := &bytes.Buffer{}
+	sink := NewWriterSink(out)
+
+	if err := sink.Write(api.Entry{User: "admin", Verb: http.MethodDelete, StatusCode: http.StatusOK}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `{"timestamp":"0001-01-01T00:00:00Z","user":"admin","sourceIP":"","verb":"DELETE","path":"",` +
+		`"statusCode":200}` + "\n"
+	if out.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, out.String())
+	}
+}
+
+func TestFileSinkShouldRotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	line, _ := json.Marshal(api.Entry{Name: "a"})
+	entrySize := int64(len(line) + 1)
+
+	// Every file holds two entries, so five entries end up in three files, the oldest of which is removed.
+	sink, err := NewFileSink(path, 2*entrySize, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		if err := sink.Write(api.Entry{Name: name}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string][]string{"audit.log": {"e"}, "audit.log.1": {"c", "d"}}
+	files, _ := ioutil.ReadDir(dir)
+	if len(files) != len(expected) {
+		t.Fatalf("Expected files %v, got %d files", expected, len(files))
+	}
+	for file, names := range expected {
+		content, err := ioutil.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		if len(lines) != len(names) {
+			t.Errorf("Expected %d entries in %s, got %s", len(names), file, content)
+			continue
+		}
+		for i, name := range names {
+			entry := api.Entry{}
+			if err := json.Unmarshal([]byte(lines[i]), &entry); err != nil || entry.Name != name {
+				t.Errorf("Expected entry %s in %s, got %s", name, file, lines[i])
+			}
+		}
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var mux sync.Mutex
+	received := make([]api.Entry, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		entry := api.Entry{}
+		if err := json.NewDecoder(r.Body).Decode(&entry); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mux.Lock()
+		received = append(received, entry)
+		mux.Unlock()
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL)
+	for _, name := range []string{"a", "b"} {
+		if err := sink.Write(api.Entry{Name: name}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	// Close waits until queued entries are sent.
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(received) != 2 || received[0].Name != "a" || received[1].Name != "b" {
+		t.Errorf("Expected entries a and b to be sent, got %v", received)
+	}
+}
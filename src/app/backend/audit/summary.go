@@ -0,0 +1,80 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// maxSummaryFields is the number of changed fields listed in a summary.
+	maxSummaryFields = 10
+
+	// maxSummaryDepth is the depth up to which nested fields are listed in a summary.
+	maxSummaryDepth = 3
+)
+
+// Summarize returns a short description of the change sent in a request body. It lists operations of JSON
+// patches and field paths of other JSON bodies. Values are left out, so that secrets do not leak into the log.
+func Summarize(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	fields := make([]string, 0)
+	if contentType == string(types.JSONPatchType) {
+		operations := make([]struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}, 0)
+		if err := json.Unmarshal(body, &operations); err != nil {
+			return ""
+		}
+		for _, operation := range operations {
+			fields = append(fields, operation.Op+" "+operation.Path)
+		}
+	} else {
+		object := make(map[string]interface{})
+		if err := json.Unmarshal(body, &object); err != nil {
+			return ""
+		}
+		fields = collectFields(object, "", 1, fields)
+		sort.Strings(fields)
+	}
+
+	if len(fields) > maxSummaryFields {
+		return fmt.Sprintf("%s and %d more", strings.Join(fields[:maxSummaryFields], ", "),
+			len(fields)-maxSummaryFields)
+	}
+	return strings.Join(fields, ", ")
+}
+
+func collectFields(object map[string]interface{}, prefix string, depth int, fields []string) []string {
+	for key, value := range object {
+		path := prefix + key
+		nested, ok := value.(map[string]interface{})
+		if !ok || depth == maxSummaryDepth || len(nested) == 0 {
+			fields = append(fields, path)
+			continue
+		}
+		fields = collectFields(nested, path+".", depth+1, fields)
+	}
+	return fields
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSummarize(t *testing.T) {
+	cases := []struct {
+		info        string
+		contentType string
+		body        string
+		expected    string
+	}{
+		{"empty body", "application/json", "", ""},
+		{"invalid body", "application/json", "not json", ""},
+		{
+			"json patch", string(types.JSONPatchType),
+			`[{"op":"replace","path":"/spec/replicas","value":3},{"op":"remove","path":"/metadata/labels/a"}]`,
+			"replace /spec/replicas, remove /metadata/labels/a",
+		},
+		{
+			"merge patch", string(types.MergePatchType),
+			`{"metadata":{"labels":{"team":"web"}},"spec":{"replicas":3,"template":{"spec":{"containers":[]}}}}`,
+			"metadata.labels.team, spec.replicas, spec.template.spec",
+		},
+		{
+			"values of secrets are left out", "application/json",
+			`{"kind":"Secret","data":{"password":"c2VjcmV0"}}`,
+			"data.password, kind",
+		},
+		{
+			"too many fields", "application/json",
+			`{"a":1,"b":1,"c":1,"d":1,"e":1,"f":1,"g":1,"h":1,"i":1,"j":1,"k":1,"l":1}`,
+			"a, b, c, d, e, f, g, h, i, j and 2 more",
+		},
+	}
+
+	for _, c := range cases {
+		actual := Summarize(c.contentType, []byte(c.body))
+		if actual != c.expected {
+			t.Errorf("Test Case: %s. Expected %q, got %q", c.info, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+)
+
+// DefaultUserCacheTTL is the time for which resolved user names are reused.
+const DefaultUserCacheTTL = 5 * time.Minute
+
+// maxCachedUsers is the maximum number of cached user names. Names are cached also for invalid credentials, so the
+// cache can not grow without limit when clients send random tokens.
+const maxCachedUsers = 10000
+
+type cachedUser struct {
+	name    string
+	expires time.Time
+}
+
+// UserResolver resolves names of users from authentication information of requests. Resolving a name requires
+// requests to the apiserver, so resolved names are cached by a hash of the authentication information.
+type UserResolver struct {
+	mux           sync.Mutex
+	clientManager clientapi.ClientManager
+	ttl           time.Duration
+	cache         map[string]cachedUser
+}
+
+// Username returns the name of the user that made the request. It is empty if the request carries no
+// authentication information or the name could not be resolved.
+func (self *UserResolver) Username(request *restful.Request) string {
+	authInfo, key := self.getAuthInfo(request)
+	if authInfo == nil {
+		return ""
+	}
+
+	now := time.Now()
+	if name, ok := self.lookup(key, now); ok {
+		return name
+	}
+
+	name, _ := self.clientManager.HasAccess(request.Request.Context(), *authInfo)
+
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	for cachedKey, user := range self.cache {
+		if now.After(user.expires) {
+			delete(self.cache, cachedKey)
+		}
+	}
+
+	if len(self.cache) >= maxCachedUsers {
+		// Evict any entry, it only costs another request to the apiserver.
+		for cachedKey := range self.cache {
+			delete(self.cache, cachedKey)
+			break
+		}
+	}
+
+	self.cache[key] = cachedUser{name: name, expires: now.Add(self.ttl)}
+	return name
+}
+
+// CachedUsername returns the name of the user that made the request, if it was resolved before. It does not send
+// any requests to the apiserver. Second return value is false if the name was not resolved yet.
+func (self *UserResolver) CachedUsername(request *restful.Request) (string, bool) {
+	authInfo, key := self.getAuthInfo(request)
+	if authInfo == nil {
+		return "", true
+	}
+
+	return self.lookup(key, time.Now())
+}
+
+// getAuthInfo returns authentication information of the request and its hash used as cache key. Authentication
+// information is nil if the request does not carry any.
+func (self *UserResolver) getAuthInfo(request *restful.Request) (*api.AuthInfo, string) {
+	authInfo, err := self.clientManager.AuthInfo(request)
+	if err != nil || authInfo == nil {
+		return nil, ""
+	}
+
+	raw, err := json.Marshal(authInfo)
+	if err != nil {
+		return nil, ""
+	}
+
+	sum := sha256.Sum256(raw)
+	return authInfo, hex.EncodeToString(sum[:])
+}
+
+func (self *UserResolver) lookup(key string, now time.Time) (string, bool) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	cached, ok := self.cache[key]
+	if !ok || !now.Before(cached.expires) {
+		return "", false
+	}
+
+	return cached.name, true
+}
+
+// NewUserResolver creates user resolver that caches resolved names for the given time.
+func NewUserResolver(clientManager clientapi.ClientManager, ttl time.Duration) *UserResolver {
+	return &UserResolver{clientManager: clientManager, ttl: ttl, cache: make(map[string]cachedUser)}
+}
@@ -0,0 +1,113 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// fakeClientManager resolves bearer tokens of requests to user names equal to the tokens. All access reviews
+// return admin.
+type fakeClientManager struct {
+	clientapi.ClientManager
+	hasAccessCalls int
+	admin          bool
+}
+
+func (self *fakeClientManager) AuthInfo(req *restful.Request) (*api.AuthInfo, error) {
+	if token := req.HeaderParameter("Authorization"); len(token) > 0 {
+		return &api.AuthInfo{Token: token}, nil
+	}
+	return nil, errors.NewUnauthorized(errors.MsgLoginUnauthorizedError)
+}
+
+func (self *fakeClientManager) CanI(req *restful.Request, ssar *authorizationv1.SelfSubjectAccessReview) bool {
+	return self.admin
+}
+
+func (self *fakeClientManager) HasAccess(ctx context.Context, authInfo api.AuthInfo) (string, error) {
+	self.hasAccessCalls++
+	return authInfo.Token, nil
+}
+
+func newRequest(token string) *restful.Request {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pod", nil)
+	if len(token) > 0 {
+		req.Header.Set("Authorization", token)
+	}
+	return restful.NewRequest(req)
+}
+
+func TestUserResolver(t *testing.T) {
+	manager := &fakeClientManager{}
+	resolver := NewUserResolver(manager, time.Minute)
+
+	cases := []struct {
+		info              string
+		token             string
+		resolve           bool
+		expected          string
+		expectedCached    bool
+		expectedHasAccess int
+	}{
+		{"request without credentials is resolved to empty name", "", false, "", true, 0},
+		{"unknown credentials are not resolved from cache", "alice", false, "", false, 0},
+		{"credentials are resolved with the apiserver", "alice", true, "alice", true, 1},
+		{"resolved credentials are cached", "alice", true, "alice", true, 1},
+	}
+
+	for _, c := range cases {
+		request := newRequest(c.token)
+		if c.resolve {
+			if name := resolver.Username(request); name != c.expected {
+				t.Errorf("Test Case: %s. Username() returned %q, expected %q", c.info, name, c.expected)
+			}
+		}
+
+		name, cached := resolver.CachedUsername(request)
+		if name != c.expected || cached != c.expectedCached {
+			t.Errorf("Test Case: %s. CachedUsername() returned %q, %t, expected %q, %t", c.info, name, cached,
+				c.expected, c.expectedCached)
+		}
+
+		if manager.hasAccessCalls != c.expectedHasAccess {
+			t.Errorf("Test Case: %s. Expected %d requests to the apiserver, got %d", c.info, c.expectedHasAccess,
+				manager.hasAccessCalls)
+		}
+	}
+}
+
+func TestUserResolverCacheLimit(t *testing.T) {
+	resolver := NewUserResolver(&fakeClientManager{}, time.Minute)
+	for i := 0; i < maxCachedUsers+10; i++ {
+		resolver.Username(newRequest("token-" + strconv.Itoa(i)))
+	}
+
+	if len(resolver.cache) > maxCachedUsers {
+		t.Errorf("Expected at most %d cached users, got %d", maxCachedUsers, len(resolver.cache))
+	}
+}
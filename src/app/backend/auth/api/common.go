@@ -17,6 +17,8 @@ package api
 import (
 	"strings"
 
+	"k8s.io/client-go/tools/clientcmd/api"
+
 	"github.com/kubernetes/dashboard/src/app/backend/args"
 )
 
@@ -25,7 +27,7 @@ func ToAuthenticationModes(modes []string) AuthenticationModes {
 	result := AuthenticationModes{}
 	modesMap := map[string]bool{}
 
-	for _, mode := range []AuthenticationMode{Token, Basic} {
+	for _, mode := range []AuthenticationMode{Token, Basic, OIDC, Certificate} {
 		modesMap[mode.String()] = true
 	}
 
@@ -38,10 +40,22 @@ func ToAuthenticationModes(modes []string) AuthenticationModes {
 	return result
 }
 
+// ClientAuthInfo returns AuthInfo that can be used to create K8S api client. Auth provider that keeps refresh token
+// obtained during oidc login is removed from it, because ID token is refreshed by Dashboard.
+func ClientAuthInfo(authInfo api.AuthInfo) api.AuthInfo {
+	if authInfo.AuthProvider != nil && authInfo.AuthProvider.Name == OIDCAuthProviderName {
+		authInfo.AuthProvider = nil
+	}
+
+	return authInfo
+}
+
 // List of protected resources that should be filtered out from dashboard UI.
 var protectedResources = []ProtectedResource{
 	{EncryptionKeyHolderName, args.Holder.GetNamespace()},
 	{CertificateHolderSecretName, args.Holder.GetNamespace()},
+	{RevokedTokensHolderName, args.Holder.GetNamespace()},
+	{CsrfTokenHolderName, args.Holder.GetNamespace()},
 }
 
 // ShouldRejectRequest returns true if url contains name and namespace of resource that should be filtered out from
@@ -55,3 +69,9 @@ func ShouldRejectRequest(url string) bool {
 
 	return false
 }
+
+// ShouldRejectResource returns true if resource with given name and namespace should be filtered out from dashboard.
+// It applies the same rule as ShouldRejectRequest to resources that are not identified by the request url.
+func ShouldRejectResource(name, namespace string) bool {
+	return ShouldRejectRequest(namespace + "/" + name)
+}
@@ -17,6 +17,8 @@ package api
 import (
 	"reflect"
 	"testing"
+
+	"k8s.io/client-go/tools/clientcmd/api"
 )
 
 func TestToAuthenticationModes(t *testing.T) {
@@ -27,6 +29,8 @@ func TestToAuthenticationModes(t *testing.T) {
 		{[]string{}, AuthenticationModes{}},
 		{[]string{"token"}, AuthenticationModes{Token: true}},
 		{[]string{"token", "basic", "test"}, AuthenticationModes{Token: true, Basic: true}},
+		{[]string{"oidc"}, AuthenticationModes{OIDC: true}},
+		{[]string{"certificate"}, AuthenticationModes{Certificate: true}},
 	}
 
 	for _, c := range cases {
@@ -37,6 +41,28 @@ func TestToAuthenticationModes(t *testing.T) {
 	}
 }
 
+func TestClientAuthInfo(t *testing.T) {
+	kubeConfigProvider := &api.AuthProviderConfig{Name: "oidc", Config: map[string]string{"id-token": "a"}}
+	cases := []struct {
+		authInfo api.AuthInfo
+		expected api.AuthInfo
+	}{
+		{api.AuthInfo{Token: "a"}, api.AuthInfo{Token: "a"}},
+		{
+			api.AuthInfo{Token: "a", AuthProvider: &api.AuthProviderConfig{Name: OIDCAuthProviderName}},
+			api.AuthInfo{Token: "a"},
+		},
+		{api.AuthInfo{AuthProvider: kubeConfigProvider}, api.AuthInfo{AuthProvider: kubeConfigProvider}},
+	}
+
+	for _, c := range cases {
+		got := ClientAuthInfo(c.authInfo)
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Fatalf("ClientAuthInfo(): expected %v, but got %v", c.expected, got)
+		}
+	}
+}
+
 func TestShouldRejectRequest(t *testing.T) {
 	cases := []struct {
 		url      string
@@ -51,6 +77,7 @@ func TestShouldRejectRequest(t *testing.T) {
 		{"#!/secret/kube-system/kubernetes-dashboard-key-holder", true},
 		{"#!/secret/test/kubernetes-dashboard-certs", true},
 		{"#!/secret/kube-system/kubernetes-dashboard-certs", true},
+		{"#!/secret/kube-system/kubernetes-dashboard-csrf", true},
 	}
 
 	for _, c := range cases {
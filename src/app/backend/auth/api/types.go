@@ -15,6 +15,7 @@
 package api
 
 import (
+	"context"
 	"time"
 
 	"k8s.io/client-go/tools/clientcmd/api"
@@ -27,8 +28,19 @@ const (
 	// Resource information that are used as certificate storage for custom certificates used by the user.
 	CertificateHolderSecretName = "kubernetes-dashboard-certs"
 
+	// Resource information that are used as storage of revoked tokens. Can be accessible by multiple dashboard
+	// replicas.
+	RevokedTokensHolderName = "kubernetes-dashboard-revoked-tokens"
+
+	// Resource information that are used as csrf token storage. Can be accessible by multiple dashboard replicas.
+	CsrfTokenHolderName = "kubernetes-dashboard-csrf"
+
 	// Expiration time (in seconds) of tokens generated by dashboard. Default: 15 min.
 	DefaultTokenTTL = 900
+
+	// Name of the auth provider that keeps refresh token obtained during oidc login in AuthInfo of generated tokens.
+	// Dashboard refreshes ID token itself, so it is removed from AuthInfo used to create K8S api client.
+	OIDCAuthProviderName = "dashboard-oidc"
 )
 
 // AuthenticationModes represents auth modes supported by dashboard.
@@ -75,13 +87,16 @@ func (self AuthenticationMode) String() string {
 const (
 	Token AuthenticationMode = "token"
 	Basic AuthenticationMode = "basic"
+	OIDC  AuthenticationMode = "oidc"
+
+	Certificate AuthenticationMode = "certificate"
 )
 
 // AuthManager is used for user authentication management.
 type AuthManager interface {
 	// Login authenticates user based on provided LoginSpec and returns AuthResponse. AuthResponse contains
 	// generated token and list of non-critical errors such as 'Failed authentication'.
-	Login(*LoginSpec) (*AuthResponse, error)
+	Login(context.Context, *LoginSpec) (*AuthResponse, error)
 	// Refresh takes valid token that hasn't expired yet and returns a new one with expiration time set to TokenTTL. In
 	// case provided token has expired, token expiration error is returned.
 	Refresh(string) (string, error)
@@ -89,6 +104,16 @@ type AuthManager interface {
 	AuthenticationModes() []AuthenticationMode
 	// AuthenticationSkippable tells if the Skip button should be enabled or not
 	AuthenticationSkippable() bool
+	// OIDCLoginURL starts oidc login and returns URL of the issuer, to which user should be redirected to log in, and
+	// encrypted login state, that has to be passed to OIDCLogin.
+	OIDCLoginURL(ctx context.Context) (string, string, error)
+	// OIDCLogin finishes oidc login. It exchanges authorization code returned by the issuer for ID token and returns
+	// AuthResponse with generated token that contains it.
+	OIDCLogin(ctx context.Context, code, state, loginState string) (*AuthResponse, error)
+	// Logout revokes given token, so it can not be used anymore even if it has not expired yet.
+	Logout(string) error
+	// RotateKey generates new key used to encrypt tokens. Tokens encrypted with replaced key are valid until it expires.
+	RotateKey() error
 }
 
 // TokenManager is responsible for generating and decrypting tokens used for authorization. Authorization is handled
@@ -99,17 +124,42 @@ type TokenManager interface {
 	// Decrypt generated token and return AuthInfo structure that will be used for K8S api client creation.
 	Decrypt(string) (*api.AuthInfo, error)
 	// Refresh returns refreshed token based on provided token. In case provided token has expired, token expiration
-	// error is returned.
+	// error is returned. Provided token is revoked once revocation list is set, unless it never expires.
 	Refresh(string) (string, error)
 	// SetTokenTTL sets expiration time (in seconds) of generated tokens.
 	SetTokenTTL(time.Duration)
+	// SetTokenRefresher sets refresher of upstream credentials embedded in tokens. They are refreshed together with
+	// the token.
+	SetTokenRefresher(TokenRefresher)
+	// Revoke revokes given token. Revoked tokens are rejected by Decrypt and Refresh.
+	Revoke(string) error
+	// SetRevocationList sets list, that keeps ids of revoked tokens. Tokens can not be revoked until it is set.
+	SetRevocationList(RevocationList)
+	// RotateKey generates new key used to encrypt tokens. Tokens encrypted with replaced key are valid until it expires.
+	RotateKey() error
+}
+
+// TokenRefresher refreshes upstream credentials embedded in AuthInfo, i.e. ID token obtained during oidc login.
+type TokenRefresher interface {
+	// Refresh returns AuthInfo with refreshed credentials, or unchanged AuthInfo if they do not need to be refreshed.
+	Refresh(api.AuthInfo) (api.AuthInfo, error)
+}
+
+// RevocationList keeps ids of revoked tokens until they expire. It is shared by all Dashboard replicas.
+type RevocationList interface {
+	// Revoke adds token id to the list. It is kept until given expiration time, or forever if it is zero. Returns
+	// error if the list is full.
+	Revoke(id string, expires time.Time) error
+	// IsRevoked returns true if token with given id was revoked.
+	IsRevoked(id string) bool
 }
 
 // Authenticator represents authentication methods supported by Dashboard. Currently supported types are:
 //    - Token based - Any bearer token accepted by apiserver
 //	  - Basic - Username and password based authentication. Requires that apiserver has basic auth enabled also
-//    - Kubeconfig based - Authenticates user based on kubeconfig file. Only token/basic modes are supported within
-// 		the kubeconfig file.
+//    - Certificate - Client certificate and key signed by a CA trusted by apiserver
+//    - Kubeconfig based - Authenticates user based on kubeconfig file. Only token/basic/certificate modes are
+// 		supported within the kubeconfig file.
 type Authenticator interface {
 	// GetAuthInfo returns filled AuthInfo structure that can be used for K8S api client creation.
 	GetAuthInfo() (api.AuthInfo, error)
@@ -124,6 +174,10 @@ type LoginSpec struct {
 	Password string `json:"password,omitempty"`
 	// Token is the bearer token for authentication to the kubernetes cluster.
 	Token string `json:"token,omitempty"`
+	// ClientCertificate is the PEM encoded client certificate for authentication to the kubernetes cluster.
+	ClientCertificate string `json:"clientCertificate,omitempty"`
+	// ClientKey is the PEM encoded private key of the client certificate.
+	ClientKey string `json:"clientKey,omitempty"`
 	// KubeConfig is the content of users' kubeconfig file. It will be parsed and auth data will be extracted.
 	// Kubeconfig can not contain any paths. All data has to be provided within the file.
 	KubeConfig string `json:"kubeconfig,omitempty"`
@@ -0,0 +1,51 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// Implements Authenticator interface
+type certificateAuthenticator struct {
+	certificate []byte
+	key         []byte
+}
+
+// GetAuthInfo implements Authenticator interface. See Authenticator for more information.
+func (self *certificateAuthenticator) GetAuthInfo() (api.AuthInfo, error) {
+	if _, err := tls.X509KeyPair(self.certificate, self.key); err != nil {
+		return api.AuthInfo{}, errors.NewInvalid(fmt.Sprintf("Invalid client certificate or key: %s", err.Error()))
+	}
+
+	return api.AuthInfo{
+		ClientCertificateData: self.certificate,
+		ClientKeyData:         self.key,
+	}, nil
+}
+
+// NewCertificateAuthenticator returns Authenticator based on LoginSpec.
+func NewCertificateAuthenticator(spec *authApi.LoginSpec) authApi.Authenticator {
+	return &certificateAuthenticator{
+		certificate: []byte(spec.ClientCertificate),
+		key:         []byte(spec.ClientKey),
+	}
+}
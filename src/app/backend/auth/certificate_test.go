@@ -0,0 +1,102 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+)
+
+// newTestCertificate returns PEM encoded self-signed client certificate and its private key.
+func newTestCertificate(t *testing.T, commonName string) (certificate, key []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func TestCertificateAuthenticator(t *testing.T) {
+	certificate, key := newTestCertificate(t, "foo")
+	_, otherKey := newTestCertificate(t, "bar")
+
+	cases := []struct {
+		info      string
+		spec      *authApi.LoginSpec
+		expected  api.AuthInfo
+		expectErr bool
+	}{
+		{
+			"Valid certificate and key should be returned in auth info",
+			&authApi.LoginSpec{ClientCertificate: string(certificate), ClientKey: string(key)},
+			api.AuthInfo{ClientCertificateData: certificate, ClientKeyData: key},
+			false,
+		},
+		{
+			"Key not matching the certificate should return error",
+			&authApi.LoginSpec{ClientCertificate: string(certificate), ClientKey: string(otherKey)},
+			api.AuthInfo{},
+			true,
+		},
+		{
+			"Malformed certificate should return error",
+			&authApi.LoginSpec{ClientCertificate: "foo", ClientKey: string(key)},
+			api.AuthInfo{},
+			true,
+		},
+	}
+
+	for _, c := range cases {
+		response, err := NewCertificateAuthenticator(c.spec).GetAuthInfo()
+		if (err != nil) != c.expectErr {
+			t.Errorf("Test Case: %s. Expected error: %v, but got %v.", c.info, c.expectErr, err)
+		}
+
+		if !reflect.DeepEqual(response, c.expected) {
+			t.Errorf("Test Case: %s. Expected response to be: %v, but got %v.", c.info, c.expected, response)
+		}
+	}
+}
@@ -15,18 +15,36 @@
 package auth
 
 import (
+	goerrors "errors"
 	"net/http"
+	"strings"
 
 	"github.com/emicklei/go-restful/v3"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/auth/oidc"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/validation"
 )
 
+const (
+	// Name of the cookie, from which frontend reads the token generated during login.
+	jweTokenCookieName = "jweToken"
+	// Name of the header, that contains token generated during login.
+	jweTokenHeaderName = "jweToken"
+	// Name of the cookie, that keeps encrypted state of oidc login until issuer redirects user back to Dashboard.
+	oidcLoginCookieName = "oidcLogin"
+)
+
 // AuthHandler manages all endpoints related to dashboard auth, such as login.
 type AuthHandler struct {
-	manager authApi.AuthManager
+	manager       authApi.AuthManager
+	clientManager clientapi.ClientManager
 }
 
 // Install creates new endpoints for dashboard auth, such as login. It allows user to log in to dashboard using
@@ -37,6 +55,9 @@ func (self AuthHandler) Install(ws *restful.WebService) {
 			To(self.handleLogin).
 			Reads(authApi.LoginSpec{}).
 			Writes(authApi.AuthResponse{}))
+	ws.Route(
+		ws.POST("/logout").
+			To(self.handleLogout))
 	ws.Route(
 		ws.GET("/login/status").
 			To(self.handleLoginStatus).
@@ -46,6 +67,9 @@ func (self AuthHandler) Install(ws *restful.WebService) {
 			Reads(authApi.TokenRefreshSpec{}).
 			To(self.handleJWETokenRefresh).
 			Writes(authApi.AuthResponse{}))
+	ws.Route(
+		ws.POST("/token/key/rotate").
+			To(self.handleTokenKeyRotate))
 	ws.Route(
 		ws.GET("/login/modes").
 			To(self.handleLoginModes).
@@ -54,6 +78,13 @@ func (self AuthHandler) Install(ws *restful.WebService) {
 		ws.GET("/login/skippable").
 			To(self.handleLoginSkippable).
 			Writes(authApi.LoginSkippableResponse{}))
+	ws.Route(
+		ws.GET("/login/oidc/start").
+			To(self.handleOIDCLoginStart))
+	ws.Route(
+		ws.GET("/login/oidc/callback").
+			To(self.handleOIDCLoginCallback).
+			Writes(authApi.AuthResponse{}))
 }
 
 func (self AuthHandler) handleLogin(request *restful.Request, response *restful.Response) {
@@ -64,7 +95,7 @@ func (self AuthHandler) handleLogin(request *restful.Request, response *restful.
 		return
 	}
 
-	loginResponse, err := self.manager.Login(loginSpec)
+	loginResponse, err := self.manager.Login(request.Request.Context(), loginSpec)
 	if err != nil {
 		response.AddHeader("Content-Type", "text/plain")
 		response.WriteErrorString(errors.HandleHTTPError(err), err.Error()+"\n")
@@ -74,6 +105,131 @@ func (self AuthHandler) handleLogin(request *restful.Request, response *restful.
 	response.WriteHeaderAndEntity(http.StatusOK, loginResponse)
 }
 
+// Revokes token sent in the request header, so it can not be used anymore. Cookie set during oidc login is removed.
+func (self AuthHandler) handleLogout(request *restful.Request, response *restful.Response) {
+	if err := self.manager.Logout(request.HeaderParameter(jweTokenHeaderName)); err != nil {
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(errors.HandleHTTPError(err), err.Error()+"\n")
+		return
+	}
+
+	http.SetCookie(response.ResponseWriter, &http.Cookie{
+		Name:     jweTokenCookieName,
+		Path:     "/",
+		MaxAge:   -1,
+		Secure:   request.Request.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+	response.WriteHeader(http.StatusNoContent)
+}
+
+// Forces rotation of the key used to encrypt tokens, i.e. when it might have leaked. Tokens encrypted with previous
+// keys stay valid until the keys expire.
+func (self AuthHandler) handleTokenKeyRotate(request *restful.Request, response *restful.Response) {
+	// Rotation replaces key used to encrypt tokens of all users, so only users that can update the key holder secret
+	// can force it. Access review of request without credentials would be run as Dashboard, so it is rejected first.
+	if _, err := self.clientManager.AuthInfo(request); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	if !self.clientManager.CanI(request, keyHolderUpdateAccessReview()) {
+		errors.HandleInternalError(response, k8serrors.NewForbidden(schema.GroupResource{Resource: "secrets"},
+			authApi.EncryptionKeyHolderName, goerrors.New("only users that can update encryption key holder can rotate the key")))
+		return
+	}
+
+	if err := self.manager.RotateKey(); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func keyHolderUpdateAccessReview() *authorizationv1.SelfSubjectAccessReview {
+	return &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: args.Holder.GetNamespace(),
+				Resource:  "secrets",
+				Name:      authApi.EncryptionKeyHolderName,
+				Verb:      "update",
+			},
+		},
+	}
+}
+
+// Redirects user to the oidc issuer to log in. Encrypted state of the login is kept in a cookie until issuer
+// redirects user back to Dashboard.
+func (self AuthHandler) handleOIDCLoginStart(request *restful.Request, response *restful.Response) {
+	loginURL, loginState, err := self.manager.OIDCLoginURL(request.Request.Context())
+	if err != nil {
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(errors.HandleHTTPError(err), err.Error()+"\n")
+		return
+	}
+
+	http.SetCookie(response.ResponseWriter, &http.Cookie{
+		Name:     oidcLoginCookieName,
+		Value:    loginState,
+		Path:     "/",
+		MaxAge:   int(oidc.LoginTimeout.Seconds()),
+		HttpOnly: true,
+		Secure:   request.Request.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(response.ResponseWriter, request.Request, loginURL, http.StatusFound)
+}
+
+// Finishes oidc login when issuer redirects user back to Dashboard. Generated token is stored in a cookie, from
+// which frontend reads it, and user is redirected to the frontend. Cookie with the login state is removed, as it can
+// not be used again.
+func (self AuthHandler) handleOIDCLoginCallback(request *restful.Request, response *restful.Response) {
+	loginState := ""
+	if cookie, err := request.Request.Cookie(oidcLoginCookieName); err == nil {
+		loginState = cookie.Value
+	}
+
+	http.SetCookie(response.ResponseWriter, &http.Cookie{
+		Name:     oidcLoginCookieName,
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   request.Request.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	if reason := request.QueryParameter("error"); len(reason) > 0 {
+		err := errors.NewUnauthorized(strings.TrimSpace(reason + " " + request.QueryParameter("error_description")))
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(errors.HandleHTTPError(err), err.Error()+"\n")
+		return
+	}
+
+	loginResponse, err := self.manager.OIDCLogin(request.Request.Context(), request.QueryParameter("code"),
+		request.QueryParameter("state"), loginState)
+	if err != nil {
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(errors.HandleHTTPError(err), err.Error()+"\n")
+		return
+	}
+
+	if len(loginResponse.JWEToken) == 0 {
+		response.WriteHeaderAndEntity(http.StatusUnauthorized, loginResponse)
+		return
+	}
+
+	http.SetCookie(response.ResponseWriter, &http.Cookie{
+		Name:     jweTokenCookieName,
+		Value:    loginResponse.JWEToken,
+		Path:     "/",
+		Secure:   request.Request.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(response.ResponseWriter, request.Request, "/", http.StatusFound)
+}
+
 func (self *AuthHandler) handleLoginStatus(request *restful.Request, response *restful.Response) {
 	response.WriteHeaderAndEntity(http.StatusOK, validation.ValidateLoginStatus(request))
 }
@@ -108,6 +264,6 @@ func (self *AuthHandler) handleLoginSkippable(request *restful.Request, response
 }
 
 // NewAuthHandler created AuthHandler instance.
-func NewAuthHandler(manager authApi.AuthManager) AuthHandler {
-	return AuthHandler{manager: manager}
+func NewAuthHandler(manager authApi.AuthManager, clientManager clientapi.ClientManager) AuthHandler {
+	return AuthHandler{manager: manager, clientManager: clientManager}
 }
@@ -15,13 +15,18 @@
 package auth
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	restful "github.com/emicklei/go-restful/v3"
+
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
 )
 
 func TestIntegrationHandler_Install(t *testing.T) {
-	iHandler := NewAuthHandler(nil)
+	iHandler := NewAuthHandler(nil, nil)
 	ws := new(restful.WebService)
 	iHandler.Install(ws)
 
@@ -29,3 +34,73 @@ func TestIntegrationHandler_Install(t *testing.T) {
 		t.Error("Failed to install routes.")
 	}
 }
+
+func TestAuthHandler_Logout(t *testing.T) {
+	cases := []struct {
+		info           string
+		revokeErr      error
+		expectedStatus int
+	}{
+		{"Revoked token should remove cookie", nil, http.StatusNoContent},
+		{"Invalid token should return error", errors.NewInvalid("Can not revoke token. No token provided."),
+			http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		authManager := NewAuthManager(&fakeClientManager{}, &fakeTokenManager{Error: c.revokeErr},
+			authApi.AuthenticationModes{authApi.Token: true}, false, nil)
+		ws := new(restful.WebService)
+		NewAuthHandler(authManager, &fakeClientManager{}).Install(ws)
+		container := restful.NewContainer()
+		container.Add(ws)
+
+		req := httptest.NewRequest(http.MethodPost, "/logout", nil)
+		req.Header.Set(jweTokenHeaderName, "token")
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, req)
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, got %d", c.info, c.expectedStatus, recorder.Code)
+		}
+
+		cookies := recorder.Result().Cookies()
+		removed := len(cookies) == 1 && cookies[0].Name == jweTokenCookieName && cookies[0].MaxAge < 0
+		if removed != (c.revokeErr == nil) {
+			t.Errorf("Test Case: %s. Expected cookie to be removed: %t, got %v", c.info, c.revokeErr == nil, cookies)
+		}
+	}
+}
+
+func TestAuthHandler_TokenKeyRotate(t *testing.T) {
+	cases := []struct {
+		info           string
+		authInfoErr    error
+		forbidden      bool
+		rotateErr      error
+		expectedStatus int
+	}{
+		{"Allowed user should rotate key", nil, false, nil, http.StatusNoContent},
+		{"Forbidden user should not rotate key", nil, true, nil, http.StatusForbidden},
+		{"User without credentials should not rotate key",
+			errors.NewUnauthorized(errors.MsgLoginUnauthorizedError), false, nil, http.StatusUnauthorized},
+		{"Should propagate rotation error", nil, false, errors.NewInvalid("Could not rotate key"),
+			http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		cManager := &fakeClientManager{AuthInfoError: c.authInfoErr, Forbidden: c.forbidden}
+		authManager := NewAuthManager(cManager, &fakeTokenManager{Error: c.rotateErr},
+			authApi.AuthenticationModes{authApi.Token: true}, false, nil)
+		ws := new(restful.WebService)
+		NewAuthHandler(authManager, cManager).Install(ws)
+		container := restful.NewContainer()
+		container.Add(ws)
+
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/token/key/rotate", nil))
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, got %d", c.info, c.expectedStatus, recorder.Code)
+		}
+	}
+}
@@ -15,16 +15,24 @@
 package jwe
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"log"
 	"sync"
+	"time"
 
 	jose "gopkg.in/square/go-jose.v2"
 	v1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/kubernetes/dashboard/src/app/backend/args"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
@@ -36,23 +44,54 @@ import (
 const (
 	holderMapKeyEntry  = "priv"
 	holderMapCertEntry = "pub"
+	// Key ring, newest key first. The newest key is kept in priv and pub entries too, so that it can be read by
+	// Dashboard versions that do not support key rotation.
+	holderMapRingEntry = "ring"
 )
 
-// KeyHolder is responsible for generating, storing and synchronizing encryption key used for token
-// generation/decryption.
+const (
+	// Maximum period in which the newest key is checked for scheduled rotation.
+	keyRotationCheckPeriod = time.Minute
+	// Maximum number of keys in the key ring. Oldest keys are removed on rotation even if they have not expired, so
+	// the secret does not grow when keys never expire.
+	maxKeyRingSize = 10
+)
+
+// KeyHolder is responsible for generating, storing and synchronizing encryption keys used for token
+// generation/decryption. New tokens are encrypted with the newest key. Replaced keys are kept until they expire, so
+// tokens encrypted with them can still be decrypted.
 type KeyHolder interface {
-	// Returns encrypter instance that can be used to encrypt data.
+	// Returns encrypter instance that can be used to encrypt data with the newest key.
 	Encrypter() jose.Encrypter
-	// Returns encryption key that can be used to decrypt data.
+	// Returns the newest encryption key that can be used to decrypt data.
 	Key() *rsa.PrivateKey
-	// Forces refresh of encryption key synchronized with kubernetes resource (secret).
+	// Returns non-expired key with given id. All non-expired keys, newest first, are returned if id is empty or
+	// unknown.
+	DecryptionKeys(id string) []*rsa.PrivateKey
+	// Forces refresh of encryption keys synchronized with kubernetes resource (secret).
 	Refresh()
+	// Generates new encryption key, that is used to encrypt new tokens, and removes expired keys.
+	Rotate() error
+}
+
+// Encryption key of the key ring.
+type ringKey struct {
+	// SHA-256 JWK thumbprint of the public key. It is set as "kid" header of encrypted tokens.
+	id      string
+	key     *rsa.PrivateKey
+	created time.Time
+}
+
+// Serialized ringKey stored in holderMapRingEntry.
+type ringKeyEntry struct {
+	Key     string    `json:"key"`
+	Created time.Time `json:"created"`
 }
 
 // Implements KeyHolder interface
 type rsaKeyHolder struct {
-	// 256-byte random RSA key pair. Synced with a key saved in a secret.
-	key          *rsa.PrivateKey
+	// Key ring of 256-byte random RSA key pairs, newest key first. Synced with keys saved in a secret.
+	keys         []ringKey
 	synchronizer syncApi.Synchronizer
 	mux          sync.Mutex
 }
@@ -62,8 +101,9 @@ type rsaKeyHolder struct {
 //    - Content encryption: AES-GCM (256)
 //    - Key management: RSA-OAEP-SHA256
 func (self *rsaKeyHolder) Encrypter() jose.Encrypter {
-	publicKey := &self.Key().PublicKey
-	encrypter, err := jose.NewEncrypter(jose.A256GCM, jose.Recipient{Algorithm: jose.RSA_OAEP_256, Key: publicKey}, nil)
+	newest := self.getKeys()[0]
+	recipient := jose.Recipient{Algorithm: jose.RSA_OAEP_256, Key: &newest.key.PublicKey, KeyID: newest.id}
+	encrypter, err := jose.NewEncrypter(jose.A256GCM, recipient, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -73,24 +113,90 @@ func (self *rsaKeyHolder) Encrypter() jose.Encrypter {
 
 // Key implements key holder interface. See KeyHolder for more information.
 func (self *rsaKeyHolder) Key() *rsa.PrivateKey {
-	self.mux.Lock()
-	defer self.mux.Unlock()
-	return self.key
+	return self.getKeys()[0].key
+}
+
+// DecryptionKeys implements key holder interface. See KeyHolder for more information.
+func (self *rsaKeyHolder) DecryptionKeys(id string) []*rsa.PrivateKey {
+	keys := self.getKeys()
+	now := time.Now()
+	result := make([]*rsa.PrivateKey, 0, len(keys))
+	for i, key := range keys {
+		expired := i > 0 && isKeyExpired(key, keys[i-1], now)
+		if len(id) > 0 && key.id == id {
+			if expired {
+				return []*rsa.PrivateKey{}
+			}
+
+			return []*rsa.PrivateKey{key.key}
+		}
+
+		if !expired {
+			result = append(result, key.key)
+		}
+	}
+
+	return result
 }
 
 // Refresh implements key holder interface. See KeyHolder for more information.
 func (self *rsaKeyHolder) Refresh() {
 	self.synchronizer.Refresh()
-	self.update(self.synchronizer.Get())
+	if obj := self.synchronizer.Get(); obj != nil {
+		self.update(obj)
+	}
+}
+
+// Rotate implements key holder interface. See KeyHolder for more information.
+func (self *rsaKeyHolder) Rotate() error {
+	return self.rotate(true)
+}
+
+// Generates new key if rotation is forced or the newest key is due to scheduled rotation. Update is retried in case
+// secret was changed by other replica in the meantime, i.e. when it already rotated the key.
+func (self *rsaKeyHolder) rotate(force bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		self.Refresh()
+		keys := self.getKeys()
+		now := time.Now()
+		if !force && !needsRotation(keys[0], now) {
+			return nil
+		}
+
+		key, err := generateKey(now)
+		if err != nil {
+			return err
+		}
+
+		self.setKeys(append([]ringKey{key}, pruneKeys(keys, now, maxKeyRingSize-1)...))
+		secret := self.getEncryptionKeyHolder().(*v1.Secret)
+		if current, ok := self.synchronizer.Get().(*v1.Secret); ok && current != nil {
+			updated := current.DeepCopy()
+			updated.Data = secret.Data
+			err = self.synchronizer.Update(updated)
+		} else {
+			err = self.synchronizer.Create(secret)
+		}
+
+		if err != nil {
+			self.setKeys(keys)
+			return err
+		}
+
+		// Make the new key visible to this replica immediately.
+		self.synchronizer.Refresh()
+		log.Printf("Rotated JWE encryption key, new key id: %s", key.id)
+		return nil
+	})
 }
 
-// Handler function executed by synchronizer used to store encryption key. It is called whenever watched object
+// Handler function executed by synchronizer used to store encryption keys. It is called whenever watched object
 // is created or updated.
 func (self *rsaKeyHolder) update(obj runtime.Object) {
 	secret := obj.(*v1.Secret)
-	priv, err := ParseRSAKey(string(secret.Data[holderMapKeyEntry]), string(secret.Data[holderMapCertEntry]))
+	keys, complete, err := parseKeyRing(secret.Data)
 	if err != nil {
-		// Secret was probably tampered with. Update it based on local key.
+		// Secret was probably tampered with. Update it based on local keys.
 		err := self.synchronizer.Update(self.getEncryptionKeyHolder())
 		if err != nil {
 			panic(err)
@@ -99,13 +205,25 @@ func (self *rsaKeyHolder) update(obj runtime.Object) {
 		return
 	}
 
-	self.mux.Lock()
-	defer self.mux.Unlock()
-	self.key = priv
+	self.setKeys(keys)
+	if !complete {
+		self.saveKeyRing(secret)
+	}
 }
 
-// Handler function executed by synchronizer used to store encryption key. It is called whenever watched object
-// gets deleted. It is then recreated based on local key.
+// Stores key ring in a secret written by Dashboard version that does not support key rotation. Otherwise creation
+// time of its newest key would be reset on every refresh and the key would never be rotated.
+func (self *rsaKeyHolder) saveKeyRing(secret *v1.Secret) {
+	updated := secret.DeepCopy()
+	updated.Data = self.getEncryptionKeyHolder().(*v1.Secret).Data
+	if err := self.synchronizer.Update(updated); err != nil {
+		// Other replica might have stored the key ring in the meantime.
+		log.Printf("Could not store JWE encryption key ring: %s", err.Error())
+	}
+}
+
+// Handler function executed by synchronizer used to store encryption keys. It is called whenever watched object
+// gets deleted. It is then recreated based on local keys.
 func (self *rsaKeyHolder) recreate(obj runtime.Object) {
 	secret := obj.(*v1.Secret)
 	log.Printf("Synchronized secret %s has been deleted. Recreating.", secret.Name)
@@ -121,7 +239,9 @@ func (self *rsaKeyHolder) init() {
 	self.synchronizer.RegisterActionHandler(self.update, watch.Added, watch.Modified)
 	self.synchronizer.RegisterActionHandler(self.recreate, watch.Deleted)
 
-	// Try to init key from synchronized object
+	self.initRotation()
+
+	// Try to init keys from synchronized object
 	if obj := self.synchronizer.Get(); obj != nil {
 		log.Print("Initializing JWE encryption key from synchronized object")
 		self.update(obj)
@@ -136,8 +256,51 @@ func (self *rsaKeyHolder) init() {
 	}
 }
 
+// Starts scheduled key rotation if it is enabled.
+func (self *rsaKeyHolder) initRotation() {
+	interval := time.Duration(args.Holder.GetTokenKeyRotationInterval()) * time.Second
+	maxAge := time.Duration(args.Holder.GetTokenKeyMaxAge()) * time.Second
+	if interval <= 0 && maxAge <= 0 {
+		return
+	}
+
+	// Key has to be replaced before it reaches its max age.
+	if interval <= 0 || (maxAge > 0 && maxAge < interval) {
+		interval = maxAge
+	}
+
+	ttl := time.Duration(args.Holder.GetTokenTTL()) * time.Second
+	if maxAge > 0 && (ttl == 0 || maxAge < interval+ttl) {
+		log.Printf("JWE encryption key max age %s is shorter than rotation interval %s and token TTL %s. "+
+			"Some tokens will expire before their TTL.", maxAge, interval, ttl)
+	}
+
+	period := keyRotationCheckPeriod
+	if interval < period {
+		period = interval
+	}
+
+	go wait.Forever(func() {
+		if err := self.rotate(false); err != nil {
+			log.Printf("Could not rotate JWE encryption key: %s", err.Error())
+		}
+	}, period)
+}
+
 func (self *rsaKeyHolder) getEncryptionKeyHolder() runtime.Object {
-	priv, pub := ExportRSAKeyOrDie(self.Key())
+	keys := self.getKeys()
+	priv, pub := ExportRSAKeyOrDie(keys[0].key)
+	entries := make([]ringKeyEntry, 0, len(keys))
+	for _, key := range keys {
+		keyPriv, _ := ExportRSAKeyOrDie(key.key)
+		entries = append(entries, ringKeyEntry{Key: keyPriv, Created: key.created})
+	}
+
+	ring, err := json.Marshal(entries)
+	if err != nil {
+		panic(err)
+	}
+
 	return &v1.Secret{
 		ObjectMeta: metaV1.ObjectMeta{
 			Namespace: args.Holder.GetNamespace(),
@@ -147,25 +310,147 @@ func (self *rsaKeyHolder) getEncryptionKeyHolder() runtime.Object {
 		Data: map[string][]byte{
 			holderMapKeyEntry:  []byte(priv),
 			holderMapCertEntry: []byte(pub),
+			holderMapRingEntry: ring,
 		},
 	}
 }
 
+func (self *rsaKeyHolder) getKeys() []ringKey {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	return self.keys
+}
+
+func (self *rsaKeyHolder) setKeys(keys []ringKey) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	self.keys = keys
+}
+
 // Generates encryption key used to encrypt token payload.
 func (self *rsaKeyHolder) initEncryptionKey() {
 	log.Print("Generating JWE encryption key")
-	self.mux.Lock()
-	defer self.mux.Unlock()
+	key, err := generateKey(time.Now())
+	if err != nil {
+		panic(err)
+	}
+
+	self.setKeys([]ringKey{key})
+}
+
+// Parses key ring saved in a secret. Secrets written by Dashboard versions that do not support key rotation contain
+// newest key only in priv and pub entries. It is handled as a key created now, and complete is false, so the key ring
+// with its creation time can be stored.
+func parseKeyRing(data map[string][]byte) (keys []ringKey, complete bool, err error) {
+	priv, err := ParseRSAKey(string(data[holderMapKeyEntry]), string(data[holderMapCertEntry]))
+	if err != nil {
+		return nil, false, err
+	}
+
+	newest := newRingKey(priv, time.Now())
+	if len(data[holderMapRingEntry]) == 0 {
+		return []ringKey{newest}, false, nil
+	}
+
+	entries := make([]ringKeyEntry, 0)
+	if err = json.Unmarshal(data[holderMapRingEntry], &entries); err != nil {
+		return nil, false, err
+	}
+
+	keys = make([]ringKey, 0, len(entries))
+	for _, entry := range entries {
+		key, err := parseRSAPrivateKey(entry.Key)
+		if err != nil {
+			return nil, false, err
+		}
+
+		keys = append(keys, newRingKey(key, entry.Created))
+	}
+
+	// Key was replaced by Dashboard version that does not support key rotation.
+	if len(keys) == 0 || keys[0].id != newest.id {
+		return append([]ringKey{newest}, keys...), false, nil
+	}
+
+	return keys, true, nil
+}
+
+// Returns true if the newest key is due to scheduled rotation.
+func needsRotation(newest ringKey, now time.Time) bool {
+	age := now.Sub(newest.created)
+	interval := args.Holder.GetTokenKeyRotationInterval()
+	maxAge := args.Holder.GetTokenKeyMaxAge()
+	return (interval > 0 && age >= time.Duration(interval)*time.Second) ||
+		(maxAge > 0 && age >= time.Duration(maxAge)*time.Second)
+}
+
+// Returns true if replaced key can not decrypt tokens anymore. Key expires after its max age or, if max age is not
+// set, when all tokens encrypted with it expired. Keys never expire if neither max age nor token TTL is set.
+func isKeyExpired(key, successor ringKey, now time.Time) bool {
+	if maxAge := args.Holder.GetTokenKeyMaxAge(); maxAge > 0 {
+		return now.After(key.created.Add(time.Duration(maxAge) * time.Second))
+	}
 
-	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if ttl := args.Holder.GetTokenTTL(); ttl > 0 {
+		return now.After(successor.created.Add(time.Duration(ttl) * time.Second))
+	}
+
+	return false
+}
+
+// Removes expired keys from the key ring, and oldest keys over size limit. The newest key is always kept.
+func pruneKeys(keys []ringKey, now time.Time, limit int) []ringKey {
+	result := make([]ringKey, 0, len(keys))
+	for i, key := range keys {
+		if len(result) == limit {
+			log.Printf("Removing JWE encryption key %s before it expired. Key ring is limited to %d keys", key.id,
+				limit)
+			continue
+		}
+
+		if i == 0 || !isKeyExpired(key, keys[i-1], now) {
+			result = append(result, key)
+		}
+	}
+
+	return result
+}
+
+func generateKey(created time.Time) (ringKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return ringKey{}, err
+	}
+
+	return newRingKey(key, created), nil
+}
+
+func newRingKey(key *rsa.PrivateKey, created time.Time) ringKey {
+	return ringKey{id: keyID(key), key: key, created: created}
+}
+
+// Returns SHA-256 JWK thumbprint of the public key. For more information check: https://tools.ietf.org/html/rfc7638
+func keyID(key *rsa.PrivateKey) string {
+	thumbprint, err := (&jose.JSONWebKey{Key: &key.PublicKey}).Thumbprint(crypto.SHA256)
 	if err != nil {
 		panic(err)
 	}
 
-	self.key = privateKey
+	return base64.RawURLEncoding.EncodeToString(thumbprint)
+}
+
+// Parses private key string and returns rsa key object or error.
+func parseRSAPrivateKey(privStr string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privStr))
+	if block == nil {
+		return nil, errors.NewInvalid("Failed to parse PEM block containing the key")
+	}
+
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
 }
 
-// NewRSAKeyHolder creates new KeyHolder instance.
+// NewRSAKeyHolder creates new KeyHolder instance. Keys are rotated periodically if rotation interval or max key age
+// is set.
 func NewRSAKeyHolder(synchronizer syncApi.Synchronizer) KeyHolder {
 	holder := &rsaKeyHolder{
 		synchronizer: synchronizer,
@@ -15,10 +15,20 @@
 package jwe
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
-	"github.com/kubernetes/dashboard/src/app/backend/sync"
+	jose "gopkg.in/square/go-jose.v2"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/sync"
 )
 
 func getKeyHolder() KeyHolder {
@@ -47,3 +57,118 @@ func TestRsaKeyHolder_Key(t *testing.T) {
 		t.Fatalf("Key(): Expected key not to be nil")
 	}
 }
+
+func TestRsaKeyHolder_Rotate(t *testing.T) {
+	c := fake.NewSimpleClientset()
+	synchronizer := sync.NewSynchronizerManager(c).Secret(args.Holder.GetNamespace(), authApi.EncryptionKeyHolderName)
+	holder := NewRSAKeyHolder(synchronizer)
+	tokenManager := NewJWETokenManager(holder)
+	oldKey := holder.Key()
+	oldToken, _ := tokenManager.Generate(api.AuthInfo{Token: "test-token"})
+
+	if err := holder.Rotate(); err != nil {
+		t.Fatalf("Rotate(): Unexpected error: %v", err)
+	}
+
+	if holder.Key() == oldKey {
+		t.Error("Rotate(): Expected new key to be used for encryption")
+	}
+
+	if _, err := tokenManager.Decrypt(oldToken); err != nil {
+		t.Errorf("Decrypt(): Expected token encrypted with replaced key to be valid, got %v", err)
+	}
+
+	token, _ := tokenManager.Generate(api.AuthInfo{Token: "test-token"})
+	jwe, _ := jose.ParseEncrypted(token)
+	if expected := keyID(holder.Key()); jwe.Header.KeyID != expected {
+		t.Errorf("Generate(): Expected token key id %s, got %s", expected, jwe.Header.KeyID)
+	}
+
+	entries := make([]ringKeyEntry, 0)
+	secret := synchronizer.Get().(*v1.Secret)
+	if err := json.Unmarshal(secret.Data[holderMapRingEntry], &entries); err != nil || len(entries) != 2 {
+		t.Errorf("Rotate(): Expected 2 keys to be synchronized, got %s", secret.Data[holderMapRingEntry])
+	}
+}
+
+func TestRsaKeyHolder_DecryptionKeys(t *testing.T) {
+	now := time.Now()
+	newest, _ := generateKey(now.Add(-time.Minute))
+	replaced, _ := generateKey(now.Add(-time.Hour))
+	expired, _ := generateKey(now.Add(-3 * time.Hour))
+	holder := &rsaKeyHolder{keys: []ringKey{newest, replaced, expired}}
+	args.GetHolderBuilder().SetTokenKeyMaxAge(2 * 60 * 60)
+	defer args.GetHolderBuilder().SetTokenKeyMaxAge(0)
+
+	cases := []struct {
+		info     string
+		id       string
+		expected int
+	}{
+		{"Should return key with given id", replaced.id, 1},
+		{"Should return all non-expired keys for empty id", "", 2},
+		{"Should return all non-expired keys for unknown id", "unknown", 2},
+		{"Should not return expired key", expired.id, 0},
+	}
+
+	for _, c := range cases {
+		if keys := holder.DecryptionKeys(c.id); len(keys) != c.expected {
+			t.Errorf("Test Case: %s. Expected %d keys, got %d", c.info, c.expected, len(keys))
+		}
+	}
+
+	if pruned := pruneKeys(holder.keys, now, maxKeyRingSize); len(pruned) != 2 || pruned[1].id != replaced.id {
+		t.Errorf("pruneKeys(): Expected expired key to be removed, got %d keys", len(pruned))
+	}
+
+	args.GetHolderBuilder().SetTokenKeyMaxAge(0)
+	if pruned := pruneKeys(holder.keys, now, 2); len(pruned) != 2 || pruned[1].id != replaced.id {
+		t.Errorf("pruneKeys(): Expected oldest key over limit to be removed, got %d keys", len(pruned))
+	}
+}
+
+func TestParseKeyRing(t *testing.T) {
+	key, _ := generateKey(time.Now())
+	priv, pub := ExportRSAKeyOrDie(key.key)
+	keys, complete, err := parseKeyRing(map[string][]byte{holderMapKeyEntry: []byte(priv),
+		holderMapCertEntry: []byte(pub)})
+	if err != nil {
+		t.Fatalf("parseKeyRing(): Unexpected error: %v", err)
+	}
+
+	if len(keys) != 1 || keys[0].id != key.id || complete {
+		t.Errorf("parseKeyRing(): Expected single key of incomplete key ring, got %d keys, complete: %t", len(keys),
+			complete)
+	}
+}
+
+func TestRsaKeyHolder_RotateLegacySecret(t *testing.T) {
+	key, _ := generateKey(time.Now())
+	priv, pub := ExportRSAKeyOrDie(key.key)
+	c := fake.NewSimpleClientset(&v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: args.Holder.GetNamespace(), Name: authApi.EncryptionKeyHolderName},
+		Data:       map[string][]byte{holderMapKeyEntry: []byte(priv), holderMapCertEntry: []byte(pub)},
+	})
+	synchronizer := sync.NewSynchronizerManager(c).Secret(args.Holder.GetNamespace(), authApi.EncryptionKeyHolderName)
+	holder := NewRSAKeyHolder(synchronizer).(*rsaKeyHolder)
+	if holder.Key().N.Cmp(key.key.N) != 0 {
+		t.Fatal("NewRSAKeyHolder(): Expected key of legacy secret to be used")
+	}
+
+	secret, _ := c.CoreV1().Secrets(args.Holder.GetNamespace()).Get(context.TODO(), authApi.EncryptionKeyHolderName,
+		metaV1.GetOptions{})
+	if len(secret.Data[holderMapRingEntry]) == 0 {
+		t.Fatal("NewRSAKeyHolder(): Expected key ring to be stored in legacy secret")
+	}
+
+	args.GetHolderBuilder().SetTokenKeyRotationInterval(1)
+	defer args.GetHolderBuilder().SetTokenKeyRotationInterval(0)
+	time.Sleep(1100 * time.Millisecond)
+	if err := holder.rotate(false); err != nil {
+		t.Fatalf("rotate(): Unexpected error: %v", err)
+	}
+
+	if holder.Key().N.Cmp(key.key.N) == 0 {
+		t.Error("rotate(): Expected key of legacy secret to be rotated after rotation interval")
+	}
+}
@@ -15,6 +15,9 @@
 package jwe
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"sync"
 	"time"
 
 	jose "gopkg.in/square/go-jose.v2"
@@ -29,6 +32,12 @@ import (
 type jweTokenManager struct {
 	keyHolder KeyHolder
 	tokenTTL  time.Duration
+	// mux guards tokenTTL, that can be changed when arguments are reloaded.
+	mux sync.RWMutex
+	// Refreshes upstream credentials embedded in tokens, i.e. ID token obtained during oidc login. Optional.
+	refresher authApi.TokenRefresher
+	// Keeps ids of revoked tokens. Tokens can not be revoked if it is nil.
+	revocationList authApi.RevocationList
 }
 
 // AdditionalAuthData contains information required to validate token. It is integrity protected.
@@ -46,6 +55,8 @@ const (
 	IAT Claim = "iat"
 	// EXP claim is part of token AAD header. It represents token expiration time.
 	EXP Claim = "exp"
+	// JTI claim is part of token AAD header. It represents unique token id used to revoke the token.
+	JTI Claim = "jti"
 )
 
 // Generate and encrypt JWE token based on provided AuthInfo structure. AuthInfo will be embedded in a token payload and
@@ -56,7 +67,12 @@ func (self *jweTokenManager) Generate(authInfo api.AuthInfo) (string, error) {
 		return "", err
 	}
 
-	jweObject, err := self.getEncrypter().EncryptWithAuthData(marshalledAuthInfo, self.generateAAD())
+	aad, err := self.generateAAD()
+	if err != nil {
+		return "", err
+	}
+
+	jweObject, err := self.getEncrypter().EncryptWithAuthData(marshalledAuthInfo, aad)
 	if err != nil {
 		return "", err
 	}
@@ -71,20 +87,18 @@ func (self *jweTokenManager) Decrypt(jweToken string) (*api.AuthInfo, error) {
 		return nil, err
 	}
 
-	decrypted, err := jweTokenObject.Decrypt(self.keyHolder.Key())
-	if err == jose.ErrCryptoFailure {
-		// Force key refresh and try to decrypt again
-		self.keyHolder.Refresh()
-		decrypted, err = jweTokenObject.Decrypt(self.keyHolder.Key())
-	}
-
+	decrypted, err := self.decrypt(jweTokenObject)
 	if err != nil {
 		return nil, err
 	}
 
 	authInfo := new(api.AuthInfo)
-	err = json.Unmarshal(decrypted, authInfo)
-	return authInfo, err
+	if err = json.Unmarshal(decrypted, authInfo); err != nil {
+		return nil, err
+	}
+
+	clientAuthInfo := authApi.ClientAuthInfo(*authInfo)
+	return &clientAuthInfo, nil
 }
 
 // Refresh implements token manager interface. See TokenManager for more information.
@@ -98,7 +112,7 @@ func (self *jweTokenManager) Refresh(jweToken string) (string, error) {
 		return "", err
 	}
 
-	decrypted, err := jweTokenObject.Decrypt(self.keyHolder.Key())
+	decrypted, err := self.decrypt(jweTokenObject)
 	if err != nil {
 		return "", err
 	}
@@ -109,7 +123,89 @@ func (self *jweTokenManager) Refresh(jweToken string) (string, error) {
 		return "", errors.NewInvalid("Token refresh error. Could not unmarshal token payload.")
 	}
 
-	return self.Generate(*authInfo)
+	if self.refresher != nil {
+		*authInfo, err = self.refresher.Refresh(*authInfo)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	refreshedToken, err := self.Generate(*authInfo)
+	if err != nil {
+		return "", err
+	}
+
+	// Replaced token is revoked, so that logout revokes the only valid token of the session. Tokens that never expire
+	// are not revoked, because their entries would never be pruned and would fill the revocation list.
+	if self.revocationList != nil {
+		aad, err := self.getAAD(jweToken)
+		if err != nil {
+			return "", err
+		}
+
+		if len(aad[EXP]) > 0 {
+			if err = self.revoke(aad); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return refreshedToken, nil
+}
+
+// Revoke implements token manager interface. See TokenManager for more information.
+func (self *jweTokenManager) Revoke(jweToken string) error {
+	if len(jweToken) == 0 {
+		return errors.NewInvalid("Can not revoke token. No token provided.")
+	}
+
+	if self.revocationList == nil {
+		return errors.NewInvalid("Can not revoke token. Token revocation is not enabled.")
+	}
+
+	// Token is decrypted first, because AAD can be trusted only after decryption verified its integrity.
+	if _, err := self.Decrypt(jweToken); err != nil {
+		if errors.IsTokenExpired(err) {
+			// Expired or already revoked token can not be used anyway.
+			return nil
+		}
+
+		return err
+	}
+
+	aad, err := self.getAAD(jweToken)
+	if err != nil {
+		return err
+	}
+
+	return self.revoke(aad)
+}
+
+// Adds token with given AAD to the revocation list. AAD can be trusted only if the token was decrypted.
+func (self *jweTokenManager) revoke(aad AdditionalAuthData) error {
+	if len(aad[JTI]) == 0 {
+		return errors.NewInvalid("Can not revoke token. Token does not have an id.")
+	}
+
+	expires := time.Time{}
+	if len(aad[EXP]) > 0 {
+		var err error
+		if expires, err = time.Parse(timeFormat, aad[EXP]); err != nil {
+			return errors.NewInvalid("Can not revoke token. Could not parse expiration time.")
+		}
+	}
+
+	return self.revocationList.Revoke(aad[JTI], expires)
+}
+
+// RotateKey implements token manager interface. See TokenManager for more information.
+func (self *jweTokenManager) RotateKey() error {
+	return self.keyHolder.Rotate()
+}
+
+// SetRevocationList implements token manager interface. See TokenManager for more information.
+func (self *jweTokenManager) SetRevocationList(revocationList authApi.RevocationList) {
+	self.revocationList = revocationList
 }
 
 // SetTokenTTL implements token manager interface. See TokenManager for more information.
@@ -118,13 +214,49 @@ func (self *jweTokenManager) SetTokenTTL(ttl time.Duration) {
 		ttl = 0
 	}
 
+	self.mux.Lock()
+	defer self.mux.Unlock()
 	self.tokenTTL = ttl * time.Second
 }
 
+// SetTokenRefresher implements token manager interface. See TokenManager for more information.
+func (self *jweTokenManager) SetTokenRefresher(refresher authApi.TokenRefresher) {
+	self.refresher = refresher
+}
+
+func (self *jweTokenManager) getTokenTTL() time.Duration {
+	self.mux.RLock()
+	defer self.mux.RUnlock()
+	return self.tokenTTL
+}
+
 func (self *jweTokenManager) getEncrypter() jose.Encrypter {
 	return self.keyHolder.Encrypter()
 }
 
+// Decrypts token with non-expired keys matching its key id. Tokens generated before key rotation was supported do not
+// have key id, so all non-expired keys are tried.
+func (self *jweTokenManager) decrypt(jwe *jose.JSONWebEncryption) ([]byte, error) {
+	decrypted, err := self.decryptWithKeys(jwe)
+	if err == jose.ErrCryptoFailure {
+		// Key might have been rotated by other replica. Force key refresh and try to decrypt again
+		self.keyHolder.Refresh()
+		decrypted, err = self.decryptWithKeys(jwe)
+	}
+
+	return decrypted, err
+}
+
+func (self *jweTokenManager) decryptWithKeys(jwe *jose.JSONWebEncryption) ([]byte, error) {
+	for _, key := range self.keyHolder.DecryptionKeys(jwe.Header.KeyID) {
+		if decrypted, err := jwe.Decrypt(key); err == nil {
+			return decrypted, nil
+		}
+	}
+
+	return nil, jose.ErrCryptoFailure
+}
+
 // Parses and validates provided token to check if it hasn't been manipulated with.
 func (self *jweTokenManager) validate(jweToken string) (*jose.JSONWebEncryption, error) {
 	jwe, err := jose.ParseEncrypted(jweToken)
@@ -132,14 +264,19 @@ func (self *jweTokenManager) validate(jweToken string) (*jose.JSONWebEncryption,
 		return nil, err
 	}
 
-	if self.tokenTTL > 0 {
+	if self.getTokenTTL() > 0 || self.revocationList != nil {
 		aad := AdditionalAuthData{}
 		err = json.Unmarshal(jwe.GetAuthData(), &aad)
 		if err != nil {
 			return nil, errors.NewInvalid("Token validation error. Could not unmarshal AAD.")
 		}
 
-		if self.isExpired(aad[IAT], aad[EXP]) {
+		if self.getTokenTTL() > 0 && self.isExpired(aad[IAT], aad[EXP]) {
+			return nil, errors.NewTokenExpired(errors.MsgTokenExpiredError)
+		}
+
+		// Revoked token is handled as expired one, so user is asked to log in again.
+		if self.revocationList != nil && len(aad[JTI]) > 0 && self.revocationList.IsRevoked(aad[JTI]) {
 			return nil, errors.NewTokenExpired(errors.MsgTokenExpiredError)
 		}
 	}
@@ -147,6 +284,21 @@ func (self *jweTokenManager) validate(jweToken string) (*jose.JSONWebEncryption,
 	return jwe, nil
 }
 
+// Returns AAD of provided token.
+func (self *jweTokenManager) getAAD(jweToken string) (AdditionalAuthData, error) {
+	jwe, err := jose.ParseEncrypted(jweToken)
+	if err != nil {
+		return nil, err
+	}
+
+	aad := AdditionalAuthData{}
+	if err = json.Unmarshal(jwe.GetAuthData(), &aad); err != nil {
+		return nil, errors.NewInvalid("Token validation error. Could not unmarshal AAD.")
+	}
+
+	return aad, nil
+}
+
 // Returns true if token has expired. In case time could not be parsed it might mean that token was tampered with and
 // token will be marked as expired. This will force user to log in again.
 func (self *jweTokenManager) isExpired(iatStr, expStr string) bool {
@@ -164,18 +316,33 @@ func (self *jweTokenManager) isExpired(iatStr, expStr string) bool {
 	return iat.Add(age).After(exp)
 }
 
-func (self *jweTokenManager) generateAAD() []byte {
+func (self *jweTokenManager) generateAAD() ([]byte, error) {
+	id, err := generateTokenID()
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	aad := AdditionalAuthData{
 		IAT: now.Format(timeFormat),
+		JTI: id,
 	}
 
-	if self.tokenTTL > 0 {
-		aad[EXP] = now.Add(self.tokenTTL).Format(timeFormat)
+	if ttl := self.getTokenTTL(); ttl > 0 {
+		aad[EXP] = now.Add(ttl).Format(timeFormat)
+	}
+
+	return json.Marshal(aad)
+}
+
+// Returns random id of generated token.
+func generateTokenID() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
 	}
 
-	rawAAD, _ := json.Marshal(aad)
-	return rawAAD
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
 }
 
 // Creates and returns default JWE token manager instance.
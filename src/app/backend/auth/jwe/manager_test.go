@@ -22,6 +22,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/clientcmd/api"
 
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/sync"
@@ -80,6 +81,15 @@ func TestJweTokenManager_Decrypt(t *testing.T) {
 			&api.AuthInfo{Token: "test-token"},
 			nil,
 		},
+		{
+			"Should remove oidc auth provider from decrypted token",
+			api.AuthInfo{Token: "test-token", AuthProvider: &api.AuthProviderConfig{
+				Name:   authApi.OIDCAuthProviderName,
+				Config: map[string]string{"refresh-token": "test-refresh-token"},
+			}},
+			&api.AuthInfo{Token: "test-token"},
+			nil,
+		},
 	}
 
 	for _, c := range cases {
@@ -154,3 +164,140 @@ func TestJweTokenManager_Refresh(t *testing.T) {
 		}
 	}
 }
+
+type fakeTokenRefresher struct {
+	err error
+}
+
+func (self *fakeTokenRefresher) Refresh(authInfo api.AuthInfo) (api.AuthInfo, error) {
+	authInfo.Token = "refreshed-" + authInfo.Token
+	return authInfo, self.err
+}
+
+func TestJweTokenManager_RefreshWithTokenRefresher(t *testing.T) {
+	cases := []struct {
+		info        string
+		refresher   *fakeTokenRefresher
+		expected    *api.AuthInfo
+		expectedErr error
+	}{
+		{
+			"Should refresh upstream credentials",
+			&fakeTokenRefresher{},
+			&api.AuthInfo{Token: "refreshed-test-token"},
+			nil,
+		},
+		{
+			"Should return error when upstream credentials could not be refreshed",
+			&fakeTokenRefresher{err: errors.NewTokenExpired(errors.MsgTokenExpiredError)},
+			nil,
+			errors.NewTokenExpired(errors.MsgTokenExpiredError),
+		},
+	}
+
+	for _, c := range cases {
+		tokenManager := getTokenManager()
+		tokenManager.SetTokenRefresher(c.refresher)
+		token, _ := tokenManager.Generate(api.AuthInfo{Token: "test-token"})
+
+		refreshedToken, err := tokenManager.Refresh(token)
+		if !areErrorsEqual(err, c.expectedErr) {
+			t.Errorf("Test Case: %s. Expected error to be: %v, but got %v.", c.info, c.expectedErr, err)
+		}
+
+		if c.expected == nil {
+			continue
+		}
+
+		authInfo, _ := tokenManager.Decrypt(refreshedToken)
+		if !reflect.DeepEqual(authInfo, c.expected) {
+			t.Errorf("Test Case: %s. Expected: %v, but got %v.", c.info, c.expected, authInfo)
+		}
+	}
+}
+
+func TestJweTokenManager_Revoke(t *testing.T) {
+	tokenManager := getTokenManager()
+	token, _ := tokenManager.Generate(api.AuthInfo{Token: "test-token"})
+	if err := tokenManager.Revoke(token); err == nil {
+		t.Fatal("Revoke(): Expected error when revocation list is not set")
+	}
+
+	tokenManager.SetRevocationList(NewSecretRevocationList(
+		sync.NewSynchronizerManager(fake.NewSimpleClientset()).Secret(args.Holder.GetNamespace(),
+			authApi.RevokedTokensHolderName)))
+	otherToken, _ := tokenManager.Generate(api.AuthInfo{Token: "test-token"})
+
+	cases := []struct {
+		info        string
+		token       string
+		expectedErr error
+	}{
+		{"Should revoke valid token", token, nil},
+		{"Should ignore already revoked token", token, nil},
+		{"Should return error when token is empty", "", errors.NewInvalid("Can not revoke token. No token provided.")},
+	}
+
+	for _, c := range cases {
+		if err := tokenManager.Revoke(c.token); !areErrorsEqual(err, c.expectedErr) {
+			t.Errorf("Test Case: %s. Expected error to be: %v, but got %v.", c.info, c.expectedErr, err)
+		}
+	}
+
+	expiredErr := errors.NewTokenExpired(errors.MsgTokenExpiredError)
+	if _, err := tokenManager.Decrypt(token); !areErrorsEqual(err, expiredErr) {
+		t.Errorf("Decrypt(): Expected revoked token to be rejected with %v, got %v", expiredErr, err)
+	}
+
+	if _, err := tokenManager.Refresh(token); !areErrorsEqual(err, expiredErr) {
+		t.Errorf("Refresh(): Expected revoked token to be rejected with %v, got %v", expiredErr, err)
+	}
+
+	if _, err := tokenManager.Decrypt(otherToken); err != nil {
+		t.Errorf("Decrypt(): Expected other token to be valid, got %v", err)
+	}
+}
+
+func TestJweTokenManager_RefreshShouldRevokeReplacedToken(t *testing.T) {
+	cases := []struct {
+		info            string
+		ttl             time.Duration
+		expectedRevoked bool
+	}{
+		{"Should revoke replaced token", authApi.DefaultTokenTTL, true},
+		{"Should not revoke replaced token that never expires", 0, false},
+	}
+
+	expiredErr := errors.NewTokenExpired(errors.MsgTokenExpiredError)
+	for _, c := range cases {
+		tokenManager := getTokenManager()
+		tokenManager.SetTokenTTL(c.ttl)
+		tokenManager.SetRevocationList(NewSecretRevocationList(
+			sync.NewSynchronizerManager(fake.NewSimpleClientset()).Secret(args.Holder.GetNamespace(),
+				authApi.RevokedTokensHolderName)))
+		token, _ := tokenManager.Generate(api.AuthInfo{Token: "test-token"})
+
+		refreshedToken, err := tokenManager.Refresh(token)
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %v", c.info, err)
+		}
+
+		_, err = tokenManager.Decrypt(token)
+		if revoked := areErrorsEqual(err, expiredErr); revoked != c.expectedRevoked {
+			t.Errorf("Test Case: %s. Expected replaced token to be revoked: %t, got %v", c.info,
+				c.expectedRevoked, err)
+		}
+
+		if _, err = tokenManager.Decrypt(refreshedToken); err != nil {
+			t.Errorf("Test Case: %s. Expected refreshed token to be valid, got %v", c.info, err)
+		}
+
+		// Logout revokes the refreshed token, so no token of the session is valid afterwards.
+		if err = tokenManager.Revoke(refreshedToken); err != nil {
+			t.Errorf("Test Case: %s. Unexpected error: %v", c.info, err)
+		}
+		if _, err = tokenManager.Decrypt(refreshedToken); !areErrorsEqual(err, expiredErr) {
+			t.Errorf("Test Case: %s. Expected refreshed token to be revoked, got %v", c.info, err)
+		}
+	}
+}
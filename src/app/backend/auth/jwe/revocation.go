@@ -0,0 +1,205 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwe
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	syncApi "github.com/kubernetes/dashboard/src/app/backend/sync/api"
+)
+
+// Period in which tokens revoked by other replicas are synchronized and entries of expired tokens are pruned.
+const revocationSyncPeriod = 30 * time.Second
+
+// Maximum number of entries in the revocation list. Entries of tokens that never expire are never pruned, so the list
+// is capped to keep the secret below the size limit of Kubernetes objects.
+const maxRevokedTokens = 10000
+
+// Implements RevocationList interface. Entries are kept in a secret, that maps ids of revoked tokens to their
+// expiration time. Tokens that never expire are mapped to empty value.
+type secretRevocationList struct {
+	synchronizer syncApi.Synchronizer
+}
+
+// Revoke implements revocation list interface. See RevocationList for more information. Tokens can not be revoked
+// once the list is full of entries, that did not expire yet.
+func (self *secretRevocationList) Revoke(id string, expires time.Time) error {
+	value := ""
+	if !expires.IsZero() {
+		value = expires.Format(timeFormat)
+	}
+
+	full := false
+	err := self.update(func(data map[string][]byte) bool {
+		_, exists := data[id]
+		pruned := pruneExpired(data, time.Now())
+		full = !exists && len(data) >= maxRevokedTokens
+		if full {
+			return pruned
+		}
+
+		data[id] = []byte(value)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	if full {
+		return errors.NewInternal(fmt.Sprintf("Can not revoke token. Revocation list is full, it already "+
+			"contains %d tokens. Tokens that never expire are kept on the list forever, set a non-zero token TTL "+
+			"so that their entries can be pruned.", maxRevokedTokens))
+	}
+
+	return nil
+}
+
+// IsRevoked implements revocation list interface. See RevocationList for more information.
+func (self *secretRevocationList) IsRevoked(id string) bool {
+	secret, ok := self.synchronizer.Get().(*v1.Secret)
+	if !ok || secret == nil {
+		return false
+	}
+
+	_, exists := secret.Data[id]
+	return exists
+}
+
+// Removes entries of expired tokens.
+func (self *secretRevocationList) prune() {
+	err := self.update(func(data map[string][]byte) bool {
+		return pruneExpired(data, time.Now())
+	})
+	if err != nil {
+		log.Printf("Could not prune revoked tokens: %s", err.Error())
+	}
+}
+
+// Applies change to the latest version of synchronized secret. Update is retried in case secret was changed by other
+// replica in the meantime. Change returns false if it did not modify the data, so secret does not need to be updated.
+func (self *secretRevocationList) update(change func(data map[string][]byte) bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		self.synchronizer.Refresh()
+		secret, ok := self.synchronizer.Get().(*v1.Secret)
+		if !ok || secret == nil {
+			secret = self.getRevokedTokensHolder(nil)
+			if !change(secret.Data) {
+				return nil
+			}
+
+			return self.synchronizer.Create(secret)
+		}
+
+		secret = secret.DeepCopy()
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+
+		if !change(secret.Data) {
+			return nil
+		}
+
+		if err := self.synchronizer.Update(secret); err != nil {
+			return err
+		}
+
+		// Make the change visible to this replica immediately.
+		self.synchronizer.Refresh()
+		return nil
+	})
+}
+
+// Handler function executed by synchronizer used to store revoked tokens. It is called whenever watched object
+// gets deleted. It is then recreated based on its last synchronized version.
+func (self *secretRevocationList) recreate(obj runtime.Object) {
+	data := make(map[string][]byte)
+	if secret, ok := self.synchronizer.Get().(*v1.Secret); ok && secret != nil {
+		data = secret.Data
+	}
+
+	log.Printf("Synchronized secret %s has been deleted. Recreating.", authApi.RevokedTokensHolderName)
+	if err := self.synchronizer.Create(self.getRevokedTokensHolder(data)); err != nil && !errors.IsAlreadyExists(err) {
+		log.Printf("Could not recreate secret %s: %s", authApi.RevokedTokensHolderName, err.Error())
+	}
+}
+
+func (self *secretRevocationList) init() {
+	self.synchronizer.RegisterActionHandler(self.recreate, watch.Deleted)
+
+	if obj := self.synchronizer.Get(); obj == nil {
+		log.Printf("Storing revoked tokens in a secret")
+		err := self.synchronizer.Create(self.getRevokedTokensHolder(nil))
+		if err != nil && !errors.IsAlreadyExists(err) {
+			panic(err)
+		}
+	}
+
+	go wait.Forever(self.prune, revocationSyncPeriod)
+}
+
+// Removes entries of tokens expired before given time from data of the revocation list. Entries with invalid
+// expiration time are removed too. Returns true if any entry was removed.
+func pruneExpired(data map[string][]byte, now time.Time) bool {
+	pruned := false
+	for id, value := range data {
+		if len(value) == 0 {
+			continue
+		}
+
+		if expires, err := time.Parse(timeFormat, string(value)); err != nil || now.After(expires) {
+			delete(data, id)
+			pruned = true
+		}
+	}
+
+	return pruned
+}
+
+func (self *secretRevocationList) getRevokedTokensHolder(data map[string][]byte) *v1.Secret {
+	if data == nil {
+		data = make(map[string][]byte)
+	}
+
+	return &v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{
+			Namespace: args.Holder.GetNamespace(),
+			Name:      authApi.RevokedTokensHolderName,
+		},
+		Data: data,
+	}
+}
+
+// NewSecretRevocationList creates new RevocationList instance, that keeps revoked tokens in a secret synchronized by
+// given synchronizer. Entries of expired tokens are pruned periodically.
+func NewSecretRevocationList(synchronizer syncApi.Synchronizer) authApi.RevocationList {
+	revocationList := &secretRevocationList{
+		synchronizer: synchronizer,
+	}
+
+	revocationList.init()
+	return revocationList
+}
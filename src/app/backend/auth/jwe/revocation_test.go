@@ -0,0 +1,138 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwe
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/sync"
+)
+
+func getRevocationList(client *fake.Clientset) *secretRevocationList {
+	synchronizer := sync.NewSynchronizerManager(client).Secret(args.Holder.GetNamespace(),
+		authApi.RevokedTokensHolderName)
+	return NewSecretRevocationList(synchronizer).(*secretRevocationList)
+}
+
+func TestSecretRevocationList_Revoke(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	revocationList := getRevocationList(client)
+
+	if revocationList.IsRevoked("token") {
+		t.Fatal("IsRevoked(): Expected token not to be revoked")
+	}
+
+	if err := revocationList.Revoke("token", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("Revoke(): Unexpected error: %v", err)
+	}
+
+	if !revocationList.IsRevoked("token") {
+		t.Fatal("IsRevoked(): Expected token to be revoked")
+	}
+
+	// Other replica sees token revoked, because it reads the same secret.
+	if !getRevocationList(client).IsRevoked("token") {
+		t.Fatal("IsRevoked(): Expected token to be revoked by other replica")
+	}
+}
+
+func TestSecretRevocationList_Prune(t *testing.T) {
+	cases := []struct {
+		id      string
+		expires time.Time
+		pruned  bool
+	}{
+		{"expired", time.Now().Add(-time.Minute), true},
+		{"valid", time.Now().Add(time.Hour), false},
+		{"never-expiring", time.Time{}, false},
+	}
+
+	revocationList := getRevocationList(fake.NewSimpleClientset())
+	for _, c := range cases {
+		if err := revocationList.Revoke(c.id, c.expires); err != nil {
+			t.Fatalf("Revoke(%s): Unexpected error: %v", c.id, err)
+		}
+	}
+
+	revocationList.prune()
+	for _, c := range cases {
+		if revoked := revocationList.IsRevoked(c.id); revoked == c.pruned {
+			t.Errorf("prune(): Expected entry %s to be pruned: %t", c.id, c.pruned)
+		}
+	}
+}
+
+func TestSecretRevocationList_Recreate(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	revocationList := getRevocationList(client)
+	if err := revocationList.Revoke("token", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("Revoke(): Unexpected error: %v", err)
+	}
+
+	secrets := client.CoreV1().Secrets(args.Holder.GetNamespace())
+	if err := secrets.Delete(context.TODO(), authApi.RevokedTokensHolderName, metaV1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	revocationList.recreate(nil)
+	secret, err := secrets.Get(context.TODO(), authApi.RevokedTokensHolderName, metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("recreate(): Expected secret to be recreated, got error: %v", err)
+	}
+
+	if _, exists := secret.Data["token"]; !exists {
+		t.Fatalf("recreate(): Expected revoked token to be kept, got %v", secret.Data)
+	}
+}
+
+func TestSecretRevocationList_RevokeFull(t *testing.T) {
+	data := map[string][]byte{"expired": []byte(time.Now().Add(-time.Minute).Format(timeFormat))}
+	for i := 1; i < maxRevokedTokens; i++ {
+		data[strconv.Itoa(i)] = []byte{}
+	}
+
+	client := fake.NewSimpleClientset(&v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: args.Holder.GetNamespace(), Name: authApi.RevokedTokensHolderName},
+		Data:       data,
+	})
+	revocationList := getRevocationList(client)
+
+	// Entry of expired token is pruned to make room for the new one.
+	if err := revocationList.Revoke("token", time.Time{}); err != nil {
+		t.Fatalf("Revoke(): Unexpected error: %v", err)
+	}
+
+	if err := revocationList.Revoke("other", time.Now().Add(time.Hour)); err == nil {
+		t.Fatal("Revoke(): Expected error when revocation list is full")
+	}
+
+	if revocationList.IsRevoked("other") {
+		t.Fatal("IsRevoked(): Expected token not to be revoked when revocation list is full")
+	}
+
+	// Already revoked token can be revoked again.
+	if err := revocationList.Revoke("token", time.Time{}); err != nil {
+		t.Fatalf("Revoke(): Unexpected error for already revoked token: %v", err)
+	}
+}
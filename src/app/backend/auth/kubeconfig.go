@@ -15,18 +15,43 @@
 package auth
 
 import (
-	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
-	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd/api"
+
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/auth/oidc"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
 )
 
+// Name of the kubeconfig auth provider, that keeps oidc id-token and refresh-token.
+const oidcAuthProviderName = "oidc"
+
 // Below structures represent structure of kubeconfig file. They only contain fields required to gather data needed
-// to log in user. It should support same auth options as defined in auth/api/types.go file. Currently: basic, token.
+// to log in user. It should support same auth options as defined in auth/api/types.go file. Currently: basic, token,
+// certificate. Token can be also obtained from oidc auth provider.
+
+type clusterInfo struct {
+	Server                   string `yaml:"server"`
+	CertificateAuthorityData string `yaml:"certificate-authority-data"`
+}
+
+type clusterEntry struct {
+	Name    string      `yaml:"name"`
+	Cluster clusterInfo `yaml:"cluster"`
+}
 
 type contextInfo struct {
-	User string `yaml:"user"`
+	Cluster string `yaml:"cluster"`
+	User    string `yaml:"user"`
 }
 
 type contextEntry struct {
@@ -40,21 +65,37 @@ type userEntry struct {
 }
 
 type authProviderConfig struct {
-	AccessToken string `yaml:"access-token"`
+	AccessToken  string `yaml:"access-token"`
+	IDToken      string `yaml:"id-token"`
+	RefreshToken string `yaml:"refresh-token"`
+	IssuerURL    string `yaml:"idp-issuer-url"`
+	ClientID     string `yaml:"client-id"`
 }
 
 type authProviderInfo struct {
+	Name   string             `yaml:"name"`
 	Config authProviderConfig `yaml:"config"`
 }
 
+type execInfo struct {
+	Command string `yaml:"command"`
+}
+
 type userInfo struct {
-	AuthProvider authProviderInfo `yaml:"auth-provider"`
-	Token        string           `yaml:"token"`
-	Username     string           `yaml:"username"`
-	Password     string           `yaml:"password"`
+	AuthProvider          authProviderInfo `yaml:"auth-provider"`
+	Exec                  *execInfo        `yaml:"exec"`
+	Token                 string           `yaml:"token"`
+	TokenFile             string           `yaml:"tokenFile"`
+	Username              string           `yaml:"username"`
+	Password              string           `yaml:"password"`
+	ClientCertificate     string           `yaml:"client-certificate"`
+	ClientCertificateData string           `yaml:"client-certificate-data"`
+	ClientKey             string           `yaml:"client-key"`
+	ClientKeyData         string           `yaml:"client-key-data"`
 }
 
 type kubeConfig struct {
+	Clusters       []clusterEntry `yaml:"clusters"`
 	Contexts       []contextEntry `yaml:"contexts"`
 	CurrentContext string         `yaml:"current-context"`
 	Users          []userEntry    `yaml:"users"`
@@ -64,6 +105,11 @@ type kubeConfig struct {
 type kubeConfigAuthenticator struct {
 	fileContent []byte
 	authModes   authApi.AuthenticationModes
+	// Config used by Dashboard to connect to the apiserver. Cluster of the current context has to point to the same
+	// apiserver. Cluster is not checked if it is nil.
+	apiserverConfig *rest.Config
+	// Refreshes oidc id-tokens issued by the issuer configured for oidc authentication mode. It can be nil.
+	oidcProvider *oidc.Provider
 }
 
 // GetAuthInfo implements Authenticator interface. See Authenticator for more information.
@@ -73,7 +119,16 @@ func (self *kubeConfigAuthenticator) GetAuthInfo() (api.AuthInfo, error) {
 		return api.AuthInfo{}, err
 	}
 
-	info, err := self.getCurrentUserInfo(*kubeConfig)
+	current, err := self.getCurrentContext(*kubeConfig)
+	if err != nil {
+		return api.AuthInfo{}, err
+	}
+
+	if err = self.checkCluster(*kubeConfig, current.Cluster); err != nil {
+		return api.AuthInfo{}, err
+	}
+
+	info, err := self.getUserInfo(*kubeConfig, current.User)
 	if err != nil {
 		return api.AuthInfo{}, err
 	}
@@ -91,19 +146,19 @@ func (self *kubeConfigAuthenticator) parseKubeConfig(bytes []byte) (*kubeConfig,
 	return kubeConfig, nil
 }
 
-// Returns user info based on defined current context. In case it is not found error is returned.
-func (self *kubeConfigAuthenticator) getCurrentUserInfo(config kubeConfig) (userInfo, error) {
-	userName := ""
+// Returns defined current context. In case it is not found error is returned.
+func (self *kubeConfigAuthenticator) getCurrentContext(config kubeConfig) (contextInfo, error) {
 	for _, context := range config.Contexts {
-		if context.Name == config.CurrentContext {
-			userName = context.Context.User
+		if context.Name == config.CurrentContext && len(context.Context.User) > 0 {
+			return context.Context, nil
 		}
 	}
 
-	if len(userName) == 0 {
-		return userInfo{}, errors.NewInvalid("Context matching current context not found. Check if your config file is valid.")
-	}
+	return contextInfo{}, errors.NewInvalid("Context matching current context not found. Check if your config file is valid.")
+}
 
+// Returns user info of given user. In case it is not found error is returned.
+func (self *kubeConfigAuthenticator) getUserInfo(config kubeConfig, userName string) (userInfo, error) {
 	for _, user := range config.Users {
 		if user.Name == userName {
 			return user.User, nil
@@ -113,34 +168,224 @@ func (self *kubeConfigAuthenticator) getCurrentUserInfo(config kubeConfig) (user
 	return userInfo{}, errors.NewInvalid("User matching current context user not found. Check if your config file is valid.")
 }
 
+// Checks that given cluster points to the apiserver used by Dashboard. Cluster matches if it has the same address or
+// the same certificate authority, because Dashboard running inside the cluster connects to the apiserver using its
+// internal address.
+func (self *kubeConfigAuthenticator) checkCluster(config kubeConfig, clusterName string) error {
+	if self.apiserverConfig == nil {
+		return nil
+	}
+
+	for _, cluster := range config.Clusters {
+		if cluster.Name != clusterName {
+			continue
+		}
+
+		if normalizeServer(cluster.Cluster.Server) == normalizeServer(self.apiserverConfig.Host) ||
+			self.hasCertificateAuthority(cluster.Cluster.CertificateAuthorityData) {
+			return nil
+		}
+
+		return errors.NewInvalid(fmt.Sprintf("Cluster %q of current context points to %s, but Dashboard is "+
+			"connected to %s. Change current context to the context of this cluster.", clusterName,
+			cluster.Cluster.Server, self.apiserverConfig.Host))
+	}
+
+	return errors.NewInvalid("Cluster matching current context cluster not found. Check if your config file is valid.")
+}
+
+// Returns true if base64 encoded certificate authority is the one used by Dashboard to verify the apiserver.
+func (self *kubeConfigAuthenticator) hasCertificateAuthority(data string) bool {
+	certificateAuthority, err := base64.StdEncoding.DecodeString(data)
+	if err != nil || len(certificateAuthority) == 0 {
+		return false
+	}
+
+	expected := self.apiserverConfig.TLSClientConfig.CAData
+	if len(expected) == 0 && len(self.apiserverConfig.TLSClientConfig.CAFile) > 0 {
+		if expected, err = ioutil.ReadFile(self.apiserverConfig.TLSClientConfig.CAFile); err != nil {
+			return false
+		}
+	}
+
+	return len(expected) > 0 && bytes.Equal(bytes.TrimSpace(certificateAuthority), bytes.TrimSpace(expected))
+}
+
 // Returns auth info structure based on provided user info or error in case not enough data has been provided.
 func (self *kubeConfigAuthenticator) getAuthInfo(info userInfo) (api.AuthInfo, error) {
-	// If "token" is empty for the current "user" entry, fallback to the value of "auth-provider.config.access-token".
-	if len(info.Token) == 0 {
-		info.Token = info.AuthProvider.Config.AccessToken
+	found, err := self.getCredentials(info)
+	if err != nil {
+		return api.AuthInfo{}, err
 	}
 
-	if len(info.Token) == 0 && (len(info.Password) == 0 || len(info.Username) == 0) {
+	hasCertificate := len(found.ClientCertificateData) > 0 && len(found.ClientKeyData) > 0
+	if len(found.Token) == 0 && (len(found.Password) == 0 || len(found.Username) == 0) && !hasCertificate {
+		if err = self.checkUnsupported(info); err != nil {
+			return api.AuthInfo{}, err
+		}
+
 		return api.AuthInfo{}, errors.NewInvalid("Not enough data to create auth info structure.")
 	}
 
 	result := api.AuthInfo{}
-	if self.authModes.IsEnabled(authApi.Token) {
-		result.Token = info.Token
+	disabled := make([]string, 0)
+	if len(found.Token) > 0 {
+		// Refreshable id-tokens issued by the issuer of oidc authentication mode are accepted also by that mode.
+		if self.authModes.IsEnabled(authApi.Token) ||
+			(found.AuthProvider != nil && self.authModes.IsEnabled(authApi.OIDC)) {
+			result.Token = found.Token
+			result.AuthProvider = found.AuthProvider
+		} else {
+			disabled = append(disabled, authApi.Token.String())
+		}
+	}
+
+	if len(found.Username) > 0 && len(found.Password) > 0 {
+		if self.authModes.IsEnabled(authApi.Basic) {
+			result.Username = found.Username
+			result.Password = found.Password
+		} else {
+			disabled = append(disabled, authApi.Basic.String())
+		}
 	}
 
-	if self.authModes.IsEnabled(authApi.Basic) {
-		result.Username = info.Username
-		result.Password = info.Password
+	if hasCertificate {
+		if self.authModes.IsEnabled(authApi.Certificate) {
+			certificateInfo, err := NewCertificateAuthenticator(&authApi.LoginSpec{
+				ClientCertificate: string(found.ClientCertificateData),
+				ClientKey:         string(found.ClientKeyData),
+			}).GetAuthInfo()
+			if err != nil {
+				return api.AuthInfo{}, err
+			}
+
+			result.ClientCertificateData = certificateInfo.ClientCertificateData
+			result.ClientKeyData = certificateInfo.ClientKeyData
+		} else {
+			disabled = append(disabled, authApi.Certificate.String())
+		}
+	}
+
+	if len(disabled) > 0 && len(result.Token) == 0 && len(result.Username) == 0 &&
+		len(result.ClientCertificateData) == 0 {
+		return api.AuthInfo{}, errors.NewInvalid(fmt.Sprintf("Credentials found in kubeconfig file require disabled "+
+			"authentication modes: %s. Check --authentication-mode argument for more information.",
+			strings.Join(disabled, ", ")))
 	}
 
 	return result, nil
 }
 
-// NewBasicAuthenticator returns Authenticator based on LoginSpec.
-func NewKubeConfigAuthenticator(spec *authApi.LoginSpec, authModes authApi.AuthenticationModes) authApi.Authenticator {
+// Returns all credentials found in user info.
+func (self *kubeConfigAuthenticator) getCredentials(info userInfo) (api.AuthInfo, error) {
+	found := api.AuthInfo{Token: info.Token, Username: info.Username, Password: info.Password}
+	// If "token" is empty for the current "user" entry, fallback to the value of "auth-provider.config.access-token".
+	if len(found.Token) == 0 {
+		found.Token = info.AuthProvider.Config.AccessToken
+	}
+
+	if len(found.Token) == 0 && info.AuthProvider.Name == oidcAuthProviderName &&
+		len(info.AuthProvider.Config.IDToken) > 0 {
+		oidcInfo, err := self.getOIDCAuthInfo(info.AuthProvider.Config)
+		if err != nil {
+			return api.AuthInfo{}, err
+		}
+
+		found.Token = oidcInfo.Token
+		found.AuthProvider = oidcInfo.AuthProvider
+	}
+
+	if len(info.ClientCertificateData) > 0 && len(info.ClientKeyData) > 0 {
+		certificate, err := base64.StdEncoding.DecodeString(info.ClientCertificateData)
+		if err != nil {
+			return api.AuthInfo{}, errors.NewInvalid("Could not decode client-certificate-data.")
+		}
+
+		key, err := base64.StdEncoding.DecodeString(info.ClientKeyData)
+		if err != nil {
+			return api.AuthInfo{}, errors.NewInvalid("Could not decode client-key-data.")
+		}
+
+		found.ClientCertificateData = certificate
+		found.ClientKeyData = key
+	}
+
+	return found, nil
+}
+
+// Returns auth info with oidc id-token. If id-token was issued by the issuer of oidc authentication mode, it is
+// refreshed by Dashboard together with its own token, otherwise it is used until it expires.
+func (self *kubeConfigAuthenticator) getOIDCAuthInfo(config authProviderConfig) (api.AuthInfo, error) {
+	token := &oidc.Token{IDToken: config.IDToken}
+	if self.oidcProvider == nil || len(config.RefreshToken) == 0 ||
+		!self.oidcProvider.Matches(config.IssuerURL, config.ClientID) {
+		return token.AuthInfo(), nil
+	}
+
+	token.RefreshToken = config.RefreshToken
+	return self.oidcProvider.Refresh(token.AuthInfo())
+}
+
+// Returns error explaining why credentials defined in user info can not be used by Dashboard, or nil if user info
+// does not contain any of them.
+func (self *kubeConfigAuthenticator) checkUnsupported(info userInfo) error {
+	switch {
+	case len(info.TokenFile) > 0:
+		return localFileError("tokenFile", info.TokenFile, "token")
+	case len(info.ClientCertificate) > 0:
+		return localFileError("client-certificate", info.ClientCertificate, "client-certificate-data")
+	case len(info.ClientKey) > 0:
+		return localFileError("client-key", info.ClientKey, "client-key-data")
+	case len(info.AuthProvider.Name) > 0 && info.AuthProvider.Name != oidcAuthProviderName:
+		return errors.NewInvalid(fmt.Sprintf("Auth provider %q is not supported. Only access-token stored in "+
+			"kubeconfig file and id-token of %q auth provider can be used.", info.AuthProvider.Name,
+			oidcAuthProviderName))
+	case info.Exec != nil:
+		// Plugin would run with Dashboard identity, so every user would get the same credentials.
+		return errors.NewInvalid(fmt.Sprintf("Exec credential plugins are not supported. Run %q locally and use "+
+			"the token it returns to log in.", info.Exec.Command))
+	}
+
+	return nil
+}
+
+// Returns error for kubeconfig field that refers to a file, which exists only on user machine.
+func localFileError(field, path, dataField string) error {
+	return errors.NewInvalid(fmt.Sprintf("Field %s refers to local file %s, that is not available to Dashboard. "+
+		"Embed its content in %s field instead.", field, path, dataField))
+}
+
+// Returns apiserver address with scheme and port, so addresses that differ only in default port or letter case are
+// equal.
+func normalizeServer(server string) string {
+	if !strings.Contains(server, "://") {
+		server = "https://" + server
+	}
+
+	parsed, err := url.Parse(server)
+	if err != nil {
+		return server
+	}
+
+	port := parsed.Port()
+	if len(port) == 0 {
+		port = "443"
+		if parsed.Scheme == "http" {
+			port = "80"
+		}
+	}
+
+	return strings.ToLower(parsed.Scheme+"://"+net.JoinHostPort(parsed.Hostname(), port)) +
+		strings.TrimSuffix(parsed.Path, "/")
+}
+
+// NewKubeConfigAuthenticator returns Authenticator based on LoginSpec.
+func NewKubeConfigAuthenticator(spec *authApi.LoginSpec, authModes authApi.AuthenticationModes,
+	apiserverConfig *rest.Config, oidcProvider *oidc.Provider) authApi.Authenticator {
 	return &kubeConfigAuthenticator{
-		fileContent: []byte(spec.KubeConfig),
-		authModes:   authModes,
+		fileContent:     []byte(spec.KubeConfig),
+		authModes:       authModes,
+		apiserverConfig: apiserverConfig,
+		oidcProvider:    oidcProvider,
 	}
 }
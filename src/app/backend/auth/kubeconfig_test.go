@@ -16,10 +16,14 @@ package auth
 
 import (
 	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"text/template"
 
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
@@ -33,6 +37,9 @@ clusters:
 - cluster:
     insecure-skip-tls-verify: true
     server: https://localhost:6443
+{{if .certificateAuthorityData}}
+    certificate-authority-data: {{.certificateAuthorityData}}
+{{end}}
   name: foo
 contexts:
 - context:
@@ -52,13 +59,41 @@ users:
 {{if .token}}
     token: {{.token}}
 {{end}}
-{{if .accessToken}}
+{{if .clientCertificateData}}
+    client-certificate-data: {{.clientCertificateData}}
+    client-key-data: {{.clientKeyData}}
+{{end}}
+{{if .tokenFile}}
+    tokenFile: {{.tokenFile}}
+{{end}}
+{{if .clientCertificate}}
+    client-certificate: {{.clientCertificate}}
+{{end}}
+{{if or .accessToken .idToken .authProvider}}
     auth-provider:
+      name: {{.authProvider}}
       config:
         access-token: {{.accessToken}}
+        id-token: {{.idToken}}
+{{end}}
+{{if .execCommand}}
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: {{.execCommand}}
 {{end}}
 `
 
+// renderKubeConfig returns kubeconfig file based on kubeconfigTemplate. Missing params are rendered as empty values.
+func renderKubeConfig(t *testing.T, params map[string]string) string {
+	kubeconfig := template.Must(template.New("kubeconfig").Option("missingkey=zero").Parse(kubeconfigTemplate))
+	kb := new(bytes.Buffer)
+	if err := kubeconfig.Execute(kb, params); err != nil {
+		t.Fatalf("Failed to render kubeconfig: %v.", err)
+	}
+
+	return kb.String()
+}
+
 func TestKubeConfigAuthenticator(t *testing.T) {
 	authModeBasic := map[authApi.AuthenticationMode]bool{
 		authApi.Basic: true,
@@ -70,6 +105,11 @@ func TestKubeConfigAuthenticator(t *testing.T) {
 	authModeToken := map[authApi.AuthenticationMode]bool{
 		authApi.Token: true,
 	}
+	authModeCertificate := map[authApi.AuthenticationMode]bool{
+		authApi.Certificate: true,
+	}
+	certificate, key := newTestCertificate(t, "foo")
+	_, otherKey := newTestCertificate(t, "bar")
 
 	cases := []struct {
 		info        string
@@ -99,6 +139,77 @@ func TestKubeConfigAuthenticator(t *testing.T) {
 			api.AuthInfo{Username: "foo", Password: "bar"},
 			nil,
 		},
+		{
+			`If the "certificate" auth mode is enabled, embedded client certificate and key are picked up.`,
+			authModeCertificate,
+			map[string]string{"clientCertificateData": base64.StdEncoding.EncodeToString(certificate),
+				"clientKeyData": base64.StdEncoding.EncodeToString(key)},
+			api.AuthInfo{ClientCertificateData: certificate, ClientKeyData: key},
+			nil,
+		},
+		{
+			`If the "certificate" auth mode is disabled, an error naming the disabled mode is returned.`,
+			authModeToken,
+			map[string]string{"clientCertificateData": base64.StdEncoding.EncodeToString(certificate),
+				"clientKeyData": base64.StdEncoding.EncodeToString(key)},
+			api.AuthInfo{},
+			errors.NewInvalid("Credentials found in kubeconfig file require disabled authentication modes: " +
+				"certificate. Check --authentication-mode argument for more information."),
+		},
+		{
+			`If "token" is empty and "auth-provider" is "oidc", the value of "auth-provider.config.id-token" is picked up.`,
+			authModeToken,
+			map[string]string{"authProvider": "oidc", "idToken": "foo"},
+			api.AuthInfo{Token: "foo"},
+			nil,
+		},
+		{
+			`If "tokenFile" refers to a local file, an error is returned.`,
+			authModeToken,
+			map[string]string{"tokenFile": "/home/foo/token"},
+			api.AuthInfo{},
+			errors.NewInvalid("Field tokenFile refers to local file /home/foo/token, that is not available to " +
+				"Dashboard. Embed its content in token field instead."),
+		},
+		{
+			`If "client-certificate" refers to a local file, an error is returned.`,
+			authModeCertificate,
+			map[string]string{"clientCertificate": "/home/foo/cert.pem"},
+			api.AuthInfo{},
+			errors.NewInvalid("Field client-certificate refers to local file /home/foo/cert.pem, that is not " +
+				"available to Dashboard. Embed its content in client-certificate-data field instead."),
+		},
+		{
+			`If "auth-provider" without "access-token" is not "oidc", an error is returned.`,
+			authModeToken,
+			map[string]string{"authProvider": "gcp"},
+			api.AuthInfo{},
+			errors.NewInvalid(`Auth provider "gcp" is not supported. Only access-token stored in kubeconfig file ` +
+				`and id-token of "oidc" auth provider can be used.`),
+		},
+		{
+			`If "exec" is used, an error is returned.`,
+			authModeToken,
+			map[string]string{"execCommand": "aws"},
+			api.AuthInfo{},
+			errors.NewInvalid(`Exec credential plugins are not supported. Run "aws" locally and use the token ` +
+				`it returns to log in.`),
+		},
+		{
+			`If "exec" is used together with "token", the token is picked up.`,
+			authModeToken,
+			map[string]string{"execCommand": "aws", "token": "foo"},
+			api.AuthInfo{Token: "foo"},
+			nil,
+		},
+		{
+			`If embedded client key does not match the certificate, an error is returned.`,
+			authModeCertificate,
+			map[string]string{"clientCertificateData": base64.StdEncoding.EncodeToString(certificate),
+				"clientKeyData": base64.StdEncoding.EncodeToString(otherKey)},
+			api.AuthInfo{},
+			errors.NewInvalid("Invalid client certificate or key: tls: private key does not match public key"),
+		},
 		{
 			`If no value for "token", "username" or "password" is provided or can be inferred, an error is returned.`,
 			authModeBoth,
@@ -108,13 +219,8 @@ func TestKubeConfigAuthenticator(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		kubeconfig := template.Must(template.New("kubeconfig").Parse(kubeconfigTemplate))
-		kb := new(bytes.Buffer)
-		if err := kubeconfig.Execute(kb, c.params); err != nil {
-			t.Errorf("Test Case: %s. Failed to render kubeconfig: %v.", c.info, err)
-		}
-
-		kubeConfigAuthenticator := NewKubeConfigAuthenticator(&authApi.LoginSpec{KubeConfig: kb.String()}, c.authModes)
+		kubeConfigAuthenticator := NewKubeConfigAuthenticator(&authApi.LoginSpec{KubeConfig: renderKubeConfig(t, c.params)},
+			c.authModes, nil, nil)
 		response, err := kubeConfigAuthenticator.GetAuthInfo()
 
 		if !areErrorsEqual(err, c.expectedErr) {
@@ -128,3 +234,42 @@ func TestKubeConfigAuthenticator(t *testing.T) {
 		}
 	}
 }
+
+func TestKubeConfigAuthenticatorCluster(t *testing.T) {
+	certificateAuthority, _ := newTestCertificate(t, "ca")
+	otherCertificateAuthority, _ := newTestCertificate(t, "other-ca")
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	if err := ioutil.WriteFile(caFile, certificateAuthority, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		info            string
+		apiserverConfig *rest.Config
+		params          map[string]string
+		expectedErr     error
+	}{
+		{"same apiserver address", &rest.Config{Host: "https://LOCALHOST:6443/"}, map[string]string{}, nil},
+		{"same certificate authority data", &rest.Config{Host: "https://10.96.0.1:443",
+			TLSClientConfig: rest.TLSClientConfig{CAData: certificateAuthority}},
+			map[string]string{"certificateAuthorityData": base64.StdEncoding.EncodeToString(certificateAuthority)}, nil},
+		{"same certificate authority file", &rest.Config{Host: "https://10.96.0.1:443",
+			TLSClientConfig: rest.TLSClientConfig{CAFile: caFile}},
+			map[string]string{"certificateAuthorityData": base64.StdEncoding.EncodeToString(certificateAuthority)}, nil},
+		{"different apiserver", &rest.Config{Host: "https://10.96.0.1:443",
+			TLSClientConfig: rest.TLSClientConfig{CAData: otherCertificateAuthority}},
+			map[string]string{"certificateAuthorityData": base64.StdEncoding.EncodeToString(certificateAuthority)},
+			errors.NewInvalid(`Cluster "foo" of current context points to https://localhost:6443, but Dashboard is ` +
+				`connected to https://10.96.0.1:443. Change current context to the context of this cluster.`)},
+	}
+
+	for _, c := range cases {
+		c.params["token"] = "bar"
+		authenticator := NewKubeConfigAuthenticator(&authApi.LoginSpec{KubeConfig: renderKubeConfig(t, c.params)},
+			authApi.AuthenticationModes{authApi.Token: true}, c.apiserverConfig, nil)
+		_, err := authenticator.GetAuthInfo()
+		if !areErrorsEqual(err, c.expectedErr) {
+			t.Errorf("Test Case: %s. Expected error to be: %v, but got %v.", c.info, c.expectedErr, err)
+		}
+	}
+}
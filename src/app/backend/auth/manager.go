@@ -15,23 +15,34 @@
 package auth
 
 import (
+	"context"
+	"fmt"
+	"net/url"
+
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/auth/oidc"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 )
 
+// maxTokenCookieSize is the maximum size of URL encoded token stored in the browser cookie. Browsers drop cookies
+// larger than 4096 bytes including the cookie name.
+const maxTokenCookieSize = 4000
+
 // Implements AuthManager interface
 type authManager struct {
 	tokenManager            authApi.TokenManager
 	clientManager           clientapi.ClientManager
 	authenticationModes     authApi.AuthenticationModes
 	authenticationSkippable bool
+	// Provider used by oidc authentication mode. It is nil if the mode is disabled.
+	oidcProvider *oidc.Provider
 }
 
 // Login implements auth manager. See AuthManager interface for more information.
-func (self authManager) Login(spec *authApi.LoginSpec) (*authApi.AuthResponse, error) {
+func (self authManager) Login(ctx context.Context, spec *authApi.LoginSpec) (*authApi.AuthResponse, error) {
 	authenticator, err := self.getAuthenticator(spec)
 	if err != nil {
 		return nil, err
@@ -42,7 +53,42 @@ func (self authManager) Login(spec *authApi.LoginSpec) (*authApi.AuthResponse, e
 		return nil, err
 	}
 
-	username, err := self.healthCheck(authInfo)
+	return self.login(ctx, authInfo)
+}
+
+// OIDCLoginURL implements auth manager. See AuthManager interface for more information.
+func (self authManager) OIDCLoginURL(ctx context.Context) (string, string, error) {
+	if err := self.checkOIDCEnabled(); err != nil {
+		return "", "", err
+	}
+
+	return self.oidcProvider.LoginURL(ctx)
+}
+
+// OIDCLogin implements auth manager. See AuthManager interface for more information.
+func (self authManager) OIDCLogin(ctx context.Context, code, state, loginState string) (*authApi.AuthResponse,
+	error) {
+	if err := self.checkOIDCEnabled(); err != nil {
+		return nil, err
+	}
+
+	token, err := self.oidcProvider.Exchange(ctx, code, state, loginState)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.login(ctx, token.AuthInfo())
+}
+
+// Checks if user is correctly authenticated with provided AuthInfo and generates token that contains it.
+func (self authManager) login(ctx context.Context, authInfo api.AuthInfo) (*authApi.AuthResponse, error) {
+	// Apiserver would treat requests without credentials as made by system:anonymous user.
+	if len(authInfo.Token) == 0 && (len(authInfo.Username) == 0 || len(authInfo.Password) == 0) &&
+		len(authInfo.ClientCertificateData) == 0 {
+		return nil, errors.NewInvalid("Not enough data to create auth info structure.")
+	}
+
+	username, err := self.healthCheck(ctx, authInfo)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil || len(nonCriticalErrors) > 0 {
 		return &authApi.AuthResponse{Errors: nonCriticalErrors}, criticalError
@@ -53,6 +99,12 @@ func (self authManager) Login(spec *authApi.LoginSpec) (*authApi.AuthResponse, e
 		return nil, err
 	}
 
+	if size := len(url.QueryEscape(token)); size > maxTokenCookieSize {
+		return nil, errors.NewInvalid(fmt.Sprintf("Token is %d bytes long and can not be stored in a browser "+
+			"cookie, which allows at most %d bytes. Log in with smaller credentials, i.e. a client certificate "+
+			"with an elliptic curve key instead of an RSA key.", size, maxTokenCookieSize))
+	}
+
 	return &authApi.AuthResponse{JWEToken: token, Errors: nonCriticalErrors, Name: username}, nil
 }
 
@@ -61,6 +113,16 @@ func (self authManager) Refresh(jweToken string) (string, error) {
 	return self.tokenManager.Refresh(jweToken)
 }
 
+// Logout implements auth manager. See AuthManager interface for more information.
+func (self authManager) Logout(jweToken string) error {
+	return self.tokenManager.Revoke(jweToken)
+}
+
+// RotateKey implements auth manager. See AuthManager interface for more information.
+func (self authManager) RotateKey() error {
+	return self.tokenManager.RotateKey()
+}
+
 func (self authManager) AuthenticationModes() []authApi.AuthenticationMode {
 	return self.authenticationModes.Array()
 }
@@ -80,8 +142,12 @@ func (self authManager) getAuthenticator(spec *authApi.LoginSpec) (authApi.Authe
 		return NewTokenAuthenticator(spec), nil
 	case len(spec.Username) > 0 && len(spec.Password) > 0 && self.authenticationModes.IsEnabled(authApi.Basic):
 		return NewBasicAuthenticator(spec), nil
+	case len(spec.ClientCertificate) > 0 && len(spec.ClientKey) > 0 &&
+		self.authenticationModes.IsEnabled(authApi.Certificate):
+		return NewCertificateAuthenticator(spec), nil
 	case len(spec.KubeConfig) > 0:
-		return NewKubeConfigAuthenticator(spec, self.authenticationModes), nil
+		return NewKubeConfigAuthenticator(spec, self.authenticationModes, self.clientManager.InsecureConfig(),
+			self.oidcProvider), nil
 	}
 
 	return nil, errors.NewInvalid("Not enough data to create authenticator.")
@@ -89,17 +155,27 @@ func (self authManager) getAuthenticator(spec *authApi.LoginSpec) (authApi.Authe
 
 // Checks if user data extracted from provided AuthInfo structure is valid and user is correctly authenticated
 // by K8S apiserver.
-func (self authManager) healthCheck(authInfo api.AuthInfo) (string, error) {
-	return self.clientManager.HasAccess(authInfo)
+func (self authManager) healthCheck(ctx context.Context, authInfo api.AuthInfo) (string, error) {
+	return self.clientManager.HasAccess(ctx, authApi.ClientAuthInfo(authInfo))
+}
+
+func (self authManager) checkOIDCEnabled() error {
+	if self.oidcProvider == nil || !self.authenticationModes.IsEnabled(authApi.OIDC) {
+		return errors.NewInvalid("OIDC authentication mode is disabled. Check --authentication-mode argument for more information.")
+	}
+
+	return nil
 }
 
-// NewAuthManager creates auth manager.
+// NewAuthManager creates auth manager. OIDC provider is required only if oidc authentication mode is enabled.
 func NewAuthManager(clientManager clientapi.ClientManager, tokenManager authApi.TokenManager,
-	authenticationModes authApi.AuthenticationModes, authenticationSkippable bool) authApi.AuthManager {
+	authenticationModes authApi.AuthenticationModes, authenticationSkippable bool,
+	oidcProvider *oidc.Provider) authApi.AuthManager {
 	return &authManager{
 		tokenManager:            tokenManager,
 		clientManager:           clientManager,
 		authenticationModes:     authenticationModes,
 		authenticationSkippable: authenticationSkippable,
+		oidcProvider:            oidcProvider,
 	}
 }
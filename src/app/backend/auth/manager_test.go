@@ -15,13 +15,17 @@
 package auth
 
 import (
+	"context"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	restful "github.com/emicklei/go-restful/v3"
 
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/auth/oidc"
 	"github.com/kubernetes/dashboard/src/app/backend/client"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
@@ -42,6 +46,8 @@ func areErrorsEqual(err1, err2 error) bool {
 
 type fakeClientManager struct {
 	HasAccessError error
+	AuthInfoError  error
+	Forbidden      bool
 }
 
 func (self *fakeClientManager) Client(req *restful.Request) (kubernetes.Interface, error) {
@@ -68,6 +74,10 @@ func (self *fakeClientManager) InsecurePluginClient() pluginclientset.Interface
 	return nil
 }
 
+func (self *fakeClientManager) InsecureConfig() *rest.Config {
+	return nil
+}
+
 func (self *fakeClientManager) SetTokenManager(manager authApi.TokenManager) {}
 
 func (self *fakeClientManager) Config(req *restful.Request) (*rest.Config, error) {
@@ -78,11 +88,15 @@ func (self *fakeClientManager) ClientCmdConfig(req *restful.Request) (clientcmd.
 	return clientcmd.NewDefaultClientConfig(api.Config{}, &clientcmd.ConfigOverrides{}), nil
 }
 
+func (self *fakeClientManager) AuthInfo(req *restful.Request) (*api.AuthInfo, error) {
+	return nil, self.AuthInfoError
+}
+
 func (self *fakeClientManager) CSRFKey() string {
 	return ""
 }
 
-func (self *fakeClientManager) HasAccess(authInfo api.AuthInfo) (string, error) {
+func (self *fakeClientManager) HasAccess(ctx context.Context, authInfo api.AuthInfo) (string, error) {
 	return "", self.HasAccessError
 }
 
@@ -91,7 +105,7 @@ func (self *fakeClientManager) VerberClient(req *restful.Request, config *rest.C
 }
 
 func (self *fakeClientManager) CanI(req *restful.Request, ssar *v1.SelfSubjectAccessReview) bool {
-	return true
+	return !self.Forbidden
 }
 
 type fakeTokenManager struct {
@@ -105,6 +119,18 @@ func (self *fakeTokenManager) Refresh(string) (string, error) {
 
 func (self *fakeTokenManager) SetTokenTTL(time.Duration) {}
 
+func (self *fakeTokenManager) SetTokenRefresher(authApi.TokenRefresher) {}
+
+func (self *fakeTokenManager) Revoke(string) error {
+	return self.Error
+}
+
+func (self *fakeTokenManager) SetRevocationList(authApi.RevocationList) {}
+
+func (self *fakeTokenManager) RotateKey() error {
+	return self.Error
+}
+
 func (self *fakeTokenManager) Generate(authInfo api.AuthInfo) (string, error) {
 	return self.GeneratedToken, self.Error
 }
@@ -152,12 +178,21 @@ func TestAuthManager_Login(t *testing.T) {
 			&fakeTokenManager{},
 			&authApi.AuthResponse{Errors: make([]error, 0)},
 			errors.NewInvalid("Unexpected error"),
+		}, {
+			"Token that does not fit in a cookie should be rejected",
+			&authApi.LoginSpec{Token: "existing-token"},
+			&fakeClientManager{HasAccessError: nil},
+			&fakeTokenManager{GeneratedToken: strings.Repeat("t", maxTokenCookieSize+1)},
+			nil,
+			errors.NewInvalid(fmt.Sprintf("Token is %d bytes long and can not be stored in a browser cookie, "+
+				"which allows at most %d bytes. Log in with smaller credentials, i.e. a client certificate with an "+
+				"elliptic curve key instead of an RSA key.", maxTokenCookieSize+1, maxTokenCookieSize)),
 		},
 	}
 
 	for _, c := range cases {
-		authManager := NewAuthManager(c.cManager, c.tManager, authApi.AuthenticationModes{authApi.Token: true}, true)
-		response, err := authManager.Login(c.spec)
+		authManager := NewAuthManager(c.cManager, c.tManager, authApi.AuthenticationModes{authApi.Token: true}, true, nil)
+		response, err := authManager.Login(context.TODO(), c.spec)
 
 		if !areErrorsEqual(err, c.expectedErr) {
 			t.Errorf("Test Case: %s. Expected error to be: %v, but got %v.",
@@ -183,7 +218,7 @@ func TestAuthManager_AuthenticationModes(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		authManager := NewAuthManager(cManager, tManager, c.modes, true)
+		authManager := NewAuthManager(cManager, tManager, c.modes, true, nil)
 		got := authManager.AuthenticationModes()
 
 		if !reflect.DeepEqual(got, c.expected) {
@@ -198,10 +233,32 @@ func TestAuthManager_AuthenticationSkippable(t *testing.T) {
 	cModes := authApi.AuthenticationModes{}
 
 	for _, flag := range []bool{true, false} {
-		authManager := NewAuthManager(cManager, tManager, cModes, flag)
+		authManager := NewAuthManager(cManager, tManager, cModes, flag, nil)
 		got := authManager.AuthenticationSkippable()
 		if got != flag {
 			t.Errorf("Expected %v, but got %v.", flag, got)
 		}
 	}
 }
+
+func TestAuthManager_OIDCDisabled(t *testing.T) {
+	provider := oidc.NewProvider(oidc.Config{IssuerURL: "http://localhost:1"})
+	cases := []struct {
+		info     string
+		modes    authApi.AuthenticationModes
+		provider *oidc.Provider
+	}{
+		{"oidc mode disabled", authApi.AuthenticationModes{authApi.Token: true}, provider},
+		{"oidc provider not configured", authApi.AuthenticationModes{authApi.OIDC: true}, nil},
+	}
+
+	for _, c := range cases {
+		authManager := NewAuthManager(&fakeClientManager{}, &fakeTokenManager{}, c.modes, false, c.provider)
+		if _, _, err := authManager.OIDCLoginURL(context.TODO()); err == nil {
+			t.Errorf("Test Case: %s. Expected OIDCLoginURL() to return error", c.info)
+		}
+		if _, err := authManager.OIDCLogin(context.TODO(), "code", "state", ""); err == nil {
+			t.Errorf("Test Case: %s. Expected OIDCLogin() to return error", c.info)
+		}
+	}
+}
@@ -0,0 +1,514 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+const (
+	// Path of the issuer discovery document relative to the issuer URL.
+	discoveryPath = "/.well-known/openid-configuration"
+	// LoginTimeout is the time in which login has to be finished after user was redirected to the issuer.
+	LoginTimeout = 10 * time.Minute
+	// ID token is refreshed when it expires in less than refreshThreshold.
+	refreshThreshold = 5 * time.Minute
+	// Timeout of requests sent to the issuer.
+	requestTimeout = 30 * time.Second
+	// Key of the auth provider config that keeps refresh token.
+	refreshTokenKey = "refresh-token"
+)
+
+// Config holds details of the client registered with the oidc issuer.
+type Config struct {
+	// IssuerURL is the URL of the issuer. Its discovery document is read from IssuerURL + discoveryPath.
+	IssuerURL string
+	// ClientID is the id of the client. ID tokens have to be issued for it.
+	ClientID string
+	// ClientSecret is the secret of confidential client. Leave it empty for public clients, that rely on PKCE.
+	ClientSecret string
+	// RedirectURL is the URL of the callback route, to which issuer redirects user after login.
+	RedirectURL string
+	// Scopes requested during login. Refresh token is usually issued only if 'offline_access' scope is requested.
+	Scopes []string
+}
+
+// Token holds tokens obtained from the issuer.
+type Token struct {
+	// IDToken is used as a bearer token to authenticate against K8S apiserver.
+	IDToken string
+	// RefreshToken is used to obtain new ID token when it expires. It is empty if issuer did not issue it.
+	RefreshToken string
+}
+
+// AuthInfo returns AuthInfo that uses ID token as a bearer token and keeps refresh token in the auth provider config.
+func (self *Token) AuthInfo() api.AuthInfo {
+	authInfo := api.AuthInfo{Token: self.IDToken}
+	if len(self.RefreshToken) > 0 {
+		authInfo.AuthProvider = &api.AuthProviderConfig{
+			Name:   authApi.OIDCAuthProviderName,
+			Config: map[string]string{refreshTokenKey: self.RefreshToken},
+		}
+	}
+
+	return authInfo
+}
+
+// Endpoints of the issuer read from its discovery document.
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Response of the issuer token endpoint.
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	RefreshToken     string `json:"refresh_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Login that was started, but was not finished yet. It is kept by the browser in an encrypted form, so that login
+// started by one replica can be finished by another.
+type pendingLogin struct {
+	State        string    `json:"state"`
+	Nonce        string    `json:"nonce"`
+	CodeVerifier string    `json:"codeVerifier"`
+	Expires      time.Time `json:"expires"`
+}
+
+// Provider implements authorization code flow with PKCE against oidc issuer. It also implements TokenRefresher, so
+// ID tokens embedded in tokens generated by Dashboard are refreshed together with them.
+type Provider struct {
+	config Config
+	client *http.Client
+
+	// Issuer metadata and signing keys are read lazily and cached.
+	metadata *metadata
+	keys     *jose.JSONWebKeySet
+	// Key used to encrypt state of started logins.
+	loginKey []byte
+
+	mux sync.Mutex
+}
+
+// SetLoginKey sets secret from which the key encrypting state of started logins is derived. It has to be the same on
+// all replicas, so that login started by one of them can be finished by another.
+func (self *Provider) SetLoginKey(secret string) {
+	key := sha256.Sum256([]byte("oidc-login:" + secret))
+	self.mux.Lock()
+	self.loginKey = key[:]
+	self.mux.Unlock()
+}
+
+// LoginURL starts new login and returns URL of the issuer authorization endpoint, to which user should be
+// redirected. State, nonce and PKCE code verifier of the login are returned encrypted as login state, that has to be
+// passed to Exchange. It is valid for LoginTimeout.
+func (self *Provider) LoginURL(ctx context.Context) (loginURL, loginState string, err error) {
+	meta, err := self.getMetadata(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	login := pendingLogin{Expires: time.Now().Add(LoginTimeout)}
+	if login.State, err = randomString(); err != nil {
+		return "", "", err
+	}
+	if login.Nonce, err = randomString(); err != nil {
+		return "", "", err
+	}
+	if login.CodeVerifier, err = randomString(); err != nil {
+		return "", "", err
+	}
+
+	if loginState, err = self.sealLogin(login); err != nil {
+		return "", "", err
+	}
+
+	challenge := sha256.Sum256([]byte(login.CodeVerifier))
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {self.config.ClientID},
+		"redirect_uri":          {self.config.RedirectURL},
+		"scope":                 {strings.Join(self.config.Scopes, " ")},
+		"state":                 {login.State},
+		"nonce":                 {login.Nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+
+	separator := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return meta.AuthorizationEndpoint + separator + query.Encode(), loginState, nil
+}
+
+// Exchange finishes login started by LoginURL. It exchanges authorization code for tokens and validates returned
+// ID token. State returned by the issuer has to match the login state returned by LoginURL.
+func (self *Provider) Exchange(ctx context.Context, code, state, loginState string) (*Token, error) {
+	login, err := self.openLogin(loginState)
+	if err != nil || subtle.ConstantTimeCompare([]byte(login.State), []byte(state)) != 1 ||
+		time.Now().After(login.Expires) {
+		return nil, errors.NewBadRequest("Unknown or expired oidc login state.")
+	}
+
+	return self.requestToken(ctx, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {self.config.RedirectURL},
+		"code_verifier": {login.CodeVerifier},
+	}, login.Nonce)
+}
+
+// Refresh implements TokenRefresher interface. ID token of AuthInfo created from oidc Token is refreshed when it is
+// about to expire.
+func (self *Provider) Refresh(authInfo api.AuthInfo) (api.AuthInfo, error) {
+	if authInfo.AuthProvider == nil || authInfo.AuthProvider.Name != authApi.OIDCAuthProviderName {
+		return authInfo, nil
+	}
+
+	refreshToken := authInfo.AuthProvider.Config[refreshTokenKey]
+	if len(refreshToken) == 0 || !expiresSoon(authInfo.Token) {
+		return authInfo, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	token, err := self.requestToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	}, "")
+	if err != nil {
+		log.Printf("Could not refresh oidc ID token: %s", err)
+		return authInfo, errors.NewTokenExpired(errors.MsgTokenExpiredError)
+	}
+
+	// Issuers that do not rotate refresh tokens do not return them on refresh.
+	if len(token.RefreshToken) == 0 {
+		token.RefreshToken = refreshToken
+	}
+
+	return token.AuthInfo(), nil
+}
+
+// Matches returns true if ID tokens issued by issuerURL for clientID can be refreshed by the provider.
+func (self *Provider) Matches(issuerURL, clientID string) bool {
+	return strings.TrimSuffix(issuerURL, "/") == strings.TrimSuffix(self.config.IssuerURL, "/") &&
+		clientID == self.config.ClientID
+}
+
+// Sends token request to the issuer and validates returned ID token. Nonce is not validated if it is empty.
+func (self *Provider) requestToken(ctx context.Context, form url.Values, nonce string) (*Token, error) {
+	meta, err := self.getMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form.Set("client_id", self.config.ClientID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if len(self.config.ClientSecret) > 0 {
+		req.SetBasicAuth(url.QueryEscape(self.config.ClientID), url.QueryEscape(self.config.ClientSecret))
+	}
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	response := new(tokenResponse)
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(body, response); err != nil {
+		return nil, fmt.Errorf("invalid response of oidc token endpoint: %s", err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.NewUnauthorized(fmt.Sprintf("oidc token request failed: %s %s", response.Error,
+			response.ErrorDescription))
+	}
+
+	if len(response.IDToken) == 0 {
+		return nil, errors.NewUnauthorized("oidc issuer did not return ID token")
+	}
+
+	if err = self.verify(ctx, response.IDToken, nonce); err != nil {
+		return nil, err
+	}
+
+	return &Token{IDToken: response.IDToken, RefreshToken: response.RefreshToken}, nil
+}
+
+// Verifies signature, issuer, audience and expiration of ID token. Nonce is not verified if it is empty.
+func (self *Provider) verify(ctx context.Context, rawIDToken, nonce string) error {
+	token, err := jwt.ParseSigned(rawIDToken)
+	if err != nil {
+		return errors.NewUnauthorized(fmt.Sprintf("invalid ID token: %s", err.Error()))
+	}
+
+	if len(token.Headers) != 1 {
+		return errors.NewUnauthorized("invalid ID token: expected exactly one signature")
+	}
+
+	key, err := self.getKey(ctx, token.Headers[0].KeyID)
+	if err != nil {
+		return err
+	}
+
+	claims := jwt.Claims{}
+	extraClaims := struct {
+		Nonce string `json:"nonce"`
+	}{}
+	if err = token.Claims(key, &claims, &extraClaims); err != nil {
+		return errors.NewUnauthorized(fmt.Sprintf("invalid ID token signature: %s", err.Error()))
+	}
+
+	meta, err := self.getMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	if claims.Expiry == nil {
+		return errors.NewUnauthorized("invalid ID token: missing expiration time")
+	}
+
+	err = claims.Validate(jwt.Expected{Issuer: meta.Issuer, Audience: jwt.Audience{self.config.ClientID},
+		Time: time.Now()})
+	if err != nil {
+		return errors.NewUnauthorized(fmt.Sprintf("invalid ID token: %s", err.Error()))
+	}
+
+	if len(nonce) > 0 && extraClaims.Nonce != nonce {
+		return errors.NewUnauthorized("invalid ID token: nonce does not match")
+	}
+
+	return nil
+}
+
+// Returns issuer key with given id. Keys are read again when the key is not known, because issuer could rotate them.
+func (self *Provider) getKey(ctx context.Context, keyID string) (*jose.JSONWebKey, error) {
+	self.mux.Lock()
+	keys := self.keys
+	self.mux.Unlock()
+
+	if key := findKey(keys, keyID); key != nil {
+		return key, nil
+	}
+
+	meta, err := self.getMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys = new(jose.JSONWebKeySet)
+	if err = self.getJSON(ctx, meta.JWKSURI, keys); err != nil {
+		return nil, err
+	}
+
+	self.mux.Lock()
+	self.keys = keys
+	self.mux.Unlock()
+
+	if key := findKey(keys, keyID); key != nil {
+		return key, nil
+	}
+
+	return nil, errors.NewUnauthorized(fmt.Sprintf("invalid ID token: unknown signing key %q", keyID))
+}
+
+// Returns issuer metadata read from its discovery document.
+func (self *Provider) getMetadata(ctx context.Context) (*metadata, error) {
+	self.mux.Lock()
+	meta := self.metadata
+	self.mux.Unlock()
+
+	if meta != nil {
+		return meta, nil
+	}
+
+	meta = new(metadata)
+	if err := self.getJSON(ctx, strings.TrimSuffix(self.config.IssuerURL, "/")+discoveryPath, meta); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSuffix(meta.Issuer, "/") != strings.TrimSuffix(self.config.IssuerURL, "/") {
+		return nil, fmt.Errorf("oidc issuer %s does not match issuer URL %s", meta.Issuer, self.config.IssuerURL)
+	}
+
+	self.mux.Lock()
+	self.metadata = meta
+	self.mux.Unlock()
+	return meta, nil
+}
+
+func (self *Provider) getJSON(ctx context.Context, url string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request to oidc issuer %s failed with status %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// Returns key with given id. If key id is empty, the only key of the set is returned.
+func findKey(keys *jose.JSONWebKeySet, keyID string) *jose.JSONWebKey {
+	if keys == nil {
+		return nil
+	}
+
+	if len(keyID) == 0 && len(keys.Keys) == 1 {
+		return &keys.Keys[0]
+	}
+
+	if found := keys.Key(keyID); len(found) > 0 && len(keyID) > 0 {
+		return &found[0]
+	}
+
+	return nil
+}
+
+// Encrypts started login with the login key.
+func (self *Provider) sealLogin(login pendingLogin) (string, error) {
+	key, err := self.getLoginKey()
+	if err != nil {
+		return "", err
+	}
+
+	encrypter, err := jose.NewEncrypter(jose.A256GCM, jose.Recipient{Algorithm: jose.DIRECT, Key: key}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(login)
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := encrypter.Encrypt(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return encrypted.CompactSerialize()
+}
+
+// Decrypts login encrypted by sealLogin.
+func (self *Provider) openLogin(loginState string) (*pendingLogin, error) {
+	key, err := self.getLoginKey()
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := jose.ParseEncrypted(loginState)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := encrypted.Decrypt(key)
+	if err != nil {
+		return nil, err
+	}
+
+	login := new(pendingLogin)
+	return login, json.Unmarshal(payload, login)
+}
+
+func (self *Provider) getLoginKey() ([]byte, error) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	if self.loginKey == nil {
+		return nil, errors.NewInternal("oidc login key is not set")
+	}
+
+	return self.loginKey, nil
+}
+
+// Returns true if ID token expires in less than refreshThreshold. Signature is not verified, because the token
+// is read from the encrypted token generated by Dashboard.
+func expiresSoon(rawIDToken string) bool {
+	token, err := jwt.ParseSigned(rawIDToken)
+	if err != nil {
+		return true
+	}
+
+	claims := jwt.Claims{}
+	if err = token.UnsafeClaimsWithoutVerification(&claims); err != nil || claims.Expiry == nil {
+		return true
+	}
+
+	return time.Now().Add(refreshThreshold).After(claims.Expiry.Time())
+}
+
+// Returns random string used as state, nonce and PKCE code verifier.
+func randomString() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// NewProvider creates oidc provider. Issuer discovery document is read when it is used for the first time, so
+// Dashboard can start when the issuer is not available.
+func NewProvider(config Config) *Provider {
+	return &Provider{
+		config: config,
+		client: &http.Client{Timeout: requestTimeout},
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+const (
+	testClientID     = "dashboard"
+	testClientSecret = "secret"
+	testRedirectURL  = "https://dashboard/api/v1/login/oidc/callback"
+)
+
+// fakeIssuer is a stand-in oidc issuer, that issues ID tokens for authorization codes and refresh tokens.
+type fakeIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	// Nonces and PKCE code challenges of issued authorization codes.
+	codes map[string][2]string
+	// claims modifies claims of issued ID tokens.
+	claims func(*jwt.Claims)
+	// signingKey signs issued ID tokens. The published key is used when it is nil.
+	signingKey *rsa.PrivateKey
+	// refreshError is returned by the token endpoint for refresh token grant if it is not empty.
+	refreshError string
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := &fakeIssuer{key: key, codes: make(map[string][2]string)}
+	mux := http.NewServeMux()
+	mux.HandleFunc(discoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(metadata{
+			Issuer:                issuer.server.URL,
+			AuthorizationEndpoint: issuer.server.URL + "/authorize",
+			TokenEndpoint:         issuer.server.URL + "/token",
+			JWKSURI:               issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "test", Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/token", issuer.handleToken)
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+// authorize simulates user login at the issuer. It returns authorization code for given authorization request.
+func (self *fakeIssuer) authorize(t *testing.T, loginURL string) (code, state string) {
+	parsed, err := url.Parse(loginURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := parsed.Query()
+	code = "code-" + query.Get("state")
+	self.codes[code] = [2]string{query.Get("nonce"), query.Get("code_challenge")}
+	return code, query.Get("state")
+}
+
+func (self *fakeIssuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if id, secret, _ := r.BasicAuth(); id != testClientID || secret != testClientSecret {
+		self.writeError(w, "invalid_client")
+		return
+	}
+
+	nonce := ""
+	switch r.PostFormValue("grant_type") {
+	case "authorization_code":
+		issued, exists := self.codes[r.PostFormValue("code")]
+		delete(self.codes, r.PostFormValue("code"))
+		challenge := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		if !exists || issued[1] != base64.RawURLEncoding.EncodeToString(challenge[:]) ||
+			r.PostFormValue("redirect_uri") != testRedirectURL {
+			self.writeError(w, "invalid_grant")
+			return
+		}
+		nonce = issued[0]
+	case "refresh_token":
+		if len(self.refreshError) > 0 || r.PostFormValue("refresh_token") != "refresh-token" {
+			self.writeError(w, "invalid_grant")
+			return
+		}
+	default:
+		self.writeError(w, "unsupported_grant_type")
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(tokenResponse{IDToken: self.idToken(nonce, time.Hour), RefreshToken: "refresh-token"})
+}
+
+func (self *fakeIssuer) writeError(w http.ResponseWriter, reason string) {
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(tokenResponse{Error: reason})
+}
+
+func (self *fakeIssuer) idToken(nonce string, expiresIn time.Duration) string {
+	key := self.signingKey
+	if key == nil {
+		key = self.key
+	}
+
+	signer, _ := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithHeader("kid", "test"))
+	claims := jwt.Claims{
+		Issuer:   self.server.URL,
+		Subject:  "user",
+		Audience: jwt.Audience{testClientID},
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+		Expiry:   jwt.NewNumericDate(time.Now().Add(expiresIn)),
+	}
+	if self.claims != nil {
+		self.claims(&claims)
+	}
+
+	token, _ := jwt.Signed(signer).Claims(claims).Claims(map[string]interface{}{"nonce": nonce}).CompactSerialize()
+	return token
+}
+
+func (self *fakeIssuer) provider() *Provider {
+	provider := NewProvider(Config{
+		IssuerURL:    self.server.URL,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+		Scopes:       []string{"openid", "email"},
+	})
+	provider.SetLoginKey("login-key")
+	return provider
+}
+
+func TestProviderLogin(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	provider := issuer.provider()
+
+	loginURL, loginState, err := provider.LoginURL(context.TODO())
+	if err != nil {
+		t.Fatalf("LoginURL(): unexpected error: %s", err)
+	}
+
+	parsed, _ := url.Parse(loginURL)
+	for param, expected := range map[string]string{
+		"response_type":         "code",
+		"client_id":             testClientID,
+		"redirect_uri":          testRedirectURL,
+		"scope":                 "openid email",
+		"code_challenge_method": "S256",
+	} {
+		if actual := parsed.Query().Get(param); actual != expected {
+			t.Errorf("LoginURL(): expected %s parameter %q, got %q", param, expected, actual)
+		}
+	}
+
+	// Login can be finished by other replica that shares the login key.
+	code, state := issuer.authorize(t, loginURL)
+	token, err := issuer.provider().Exchange(context.TODO(), code, state, loginState)
+	if err != nil {
+		t.Fatalf("Exchange(): unexpected error: %s", err)
+	}
+
+	if len(token.IDToken) == 0 || token.RefreshToken != "refresh-token" {
+		t.Errorf("Exchange(): expected ID token and refresh token, got %#v", token)
+	}
+
+	if _, err = provider.Exchange(context.TODO(), code, state, loginState); err == nil {
+		t.Error("Exchange(): expected error when authorization code is used again")
+	}
+}
+
+func TestProviderExchangeInvalidLoginState(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	provider := issuer.provider()
+	otherProvider := issuer.provider()
+	otherProvider.SetLoginKey("other-login-key")
+	expired, _ := provider.sealLogin(pendingLogin{State: "state", Expires: time.Now().Add(-time.Minute)})
+	valid, _ := provider.sealLogin(pendingLogin{State: "state", Expires: time.Now().Add(time.Minute)})
+
+	cases := []struct {
+		info       string
+		provider   *Provider
+		state      string
+		loginState string
+	}{
+		{"missing login state", provider, "state", ""},
+		{"malformed login state", provider, "state", "login-state"},
+		{"tampered login state", provider, "state", valid[:len(valid)-4] + "AAAA"},
+		{"login state encrypted with other key", otherProvider, "state", valid},
+		{"expired login state", provider, "state", expired},
+		{"state does not match login state", provider, "other-state", valid},
+		{"login key is not set", NewProvider(Config{IssuerURL: issuer.server.URL}), "state", valid},
+	}
+
+	for _, c := range cases {
+		_, err := c.provider.Exchange(context.TODO(), "code", c.state, c.loginState)
+		if err == nil {
+			t.Errorf("Test Case: %s. Expected error", c.info)
+		}
+	}
+}
+
+func TestProviderExchangeInvalidIDToken(t *testing.T) {
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	cases := []struct {
+		info       string
+		claims     func(*jwt.Claims)
+		signingKey *rsa.PrivateKey
+	}{
+		{"wrong audience", func(c *jwt.Claims) { c.Audience = jwt.Audience{"other"} }, nil},
+		{"wrong issuer", func(c *jwt.Claims) { c.Issuer = "https://other" }, nil},
+		{"expired", func(c *jwt.Claims) { c.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour)) }, nil},
+		{"missing expiration", func(c *jwt.Claims) { c.Expiry = nil }, nil},
+		{"wrong signature", nil, otherKey},
+	}
+
+	for _, c := range cases {
+		issuer := newFakeIssuer(t)
+		issuer.claims = c.claims
+		issuer.signingKey = c.signingKey
+		provider := issuer.provider()
+
+		loginURL, loginState, _ := provider.LoginURL(context.TODO())
+		code, state := issuer.authorize(t, loginURL)
+		if _, err := provider.Exchange(context.TODO(), code, state, loginState); err == nil {
+			t.Errorf("Test Case: %s. Expected error", c.info)
+		}
+	}
+}
+
+func TestProviderRefresh(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	expiringToken := issuer.idToken("", time.Minute)
+	validToken := issuer.idToken("", time.Hour)
+	oidcAuthInfo := func(idToken, refreshToken string) api.AuthInfo {
+		return (&Token{IDToken: idToken, RefreshToken: refreshToken}).AuthInfo()
+	}
+
+	cases := []struct {
+		info         string
+		authInfo     api.AuthInfo
+		refreshError string
+		refreshed    bool
+		expectedErr  error
+	}{
+		{"token without auth provider", api.AuthInfo{Token: expiringToken}, "", false, nil},
+		{"valid ID token", oidcAuthInfo(validToken, "refresh-token"), "", false, nil},
+		{"expiring ID token", oidcAuthInfo(expiringToken, "refresh-token"), "", true, nil},
+		{"issuer rejects refresh token", oidcAuthInfo(expiringToken, "refresh-token"), "invalid_grant", false,
+			errors.NewTokenExpired(errors.MsgTokenExpiredError)},
+	}
+
+	for _, c := range cases {
+		issuer.refreshError = c.refreshError
+		authInfo, err := issuer.provider().Refresh(c.authInfo)
+		if !reflect.DeepEqual(err, c.expectedErr) {
+			t.Errorf("Test Case: %s. Expected error %v, got %v", c.info, c.expectedErr, err)
+		}
+
+		if refreshed := authInfo.Token != c.authInfo.Token; refreshed != c.refreshed {
+			t.Errorf("Test Case: %s. Expected ID token to be refreshed: %t", c.info, c.refreshed)
+		}
+
+		if c.refreshed && (authInfo.AuthProvider == nil || authInfo.AuthProvider.Name != authApi.OIDCAuthProviderName ||
+			authInfo.AuthProvider.Config[refreshTokenKey] != "refresh-token") {
+			t.Errorf("Test Case: %s. Expected refresh token to be kept, got %#v", c.info, authInfo.AuthProvider)
+		}
+	}
+}
+
+func TestProviderMatches(t *testing.T) {
+	provider := NewProvider(Config{IssuerURL: "https://issuer/", ClientID: testClientID})
+	cases := []struct {
+		issuerURL, clientID string
+		expected            bool
+	}{
+		{"https://issuer/", testClientID, true},
+		{"https://issuer", testClientID, true},
+		{"https://other-issuer", testClientID, false},
+		{"https://issuer", "kubectl", false},
+	}
+
+	for _, c := range cases {
+		if actual := provider.Matches(c.issuerURL, c.clientID); actual != c.expected {
+			t.Errorf("Matches(%s, %s) returns %t, expected %t", c.issuerURL, c.clientID, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	authorizationV1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// cachedResources are resources served from the cache. Their informers are started when the cache is enabled.
+// Secrets are left out, so their content is never kept in dashboard memory.
+var cachedResources = []schema.GroupVersionResource{
+	{Group: "", Version: "v1", Resource: "configmaps"},
+	{Group: "", Version: "v1", Resource: "endpoints"},
+	{Group: "", Version: "v1", Resource: "events"},
+	{Group: "", Version: "v1", Resource: "limitranges"},
+	{Group: "", Version: "v1", Resource: "namespaces"},
+	{Group: "", Version: "v1", Resource: "nodes"},
+	{Group: "", Version: "v1", Resource: "persistentvolumeclaims"},
+	{Group: "", Version: "v1", Resource: "persistentvolumes"},
+	{Group: "", Version: "v1", Resource: "pods"},
+	{Group: "", Version: "v1", Resource: "replicationcontrollers"},
+	{Group: "", Version: "v1", Resource: "resourcequotas"},
+	{Group: "", Version: "v1", Resource: "services"},
+	{Group: "apps", Version: "v1", Resource: "daemonsets"},
+	{Group: "apps", Version: "v1", Resource: "deployments"},
+	{Group: "apps", Version: "v1", Resource: "replicasets"},
+	{Group: "apps", Version: "v1", Resource: "statefulsets"},
+	{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"},
+	{Group: "batch", Version: "v1", Resource: "cronjobs"},
+	{Group: "batch", Version: "v1", Resource: "jobs"},
+	{Group: "networking.k8s.io", Version: "v1", Resource: "ingressclasses"},
+	{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"},
+	{Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"},
+}
+
+// AccessChecker is implemented by clients that are able to tell if their user is allowed to list given resource.
+// Only such clients can be served from the cache, as informers run with dashboard's own privileges.
+type AccessChecker interface {
+	// CanList returns true if user is allowed to list given resource in given namespace. Empty namespace means all
+	// namespaces.
+	CanList(resource schema.GroupVersionResource, namespace string) bool
+}
+
+// Cache serves resource lists from shared informers started when the cache is enabled.
+type Cache struct {
+	factory   informers.SharedInformerFactory
+	stopCh    chan struct{}
+	informers map[schema.GroupVersionResource]informers.GenericInformer
+	// failed holds resources that can not be cached, i.e. because dashboard is not allowed to list and watch them
+	// in all namespaces. Lists of such resources are always read directly from the apiserver.
+	failed map[schema.GroupVersionResource]bool
+	mux    sync.RWMutex
+}
+
+var resourceCache *Cache
+
+// Enable starts serving resource lists from shared informers created with given client. It should use privileges
+// that allow to list and watch all resources served by dashboard in all namespaces. Informers are started in the
+// background and lists are read from the apiserver until they sync.
+func Enable(client kubernetes.Interface) {
+	log.Print("Enabling resource cache")
+	resourceCache = &Cache{
+		factory:   informers.NewSharedInformerFactory(client, 0),
+		stopCh:    make(chan struct{}),
+		informers: make(map[schema.GroupVersionResource]informers.GenericInformer),
+		failed:    make(map[schema.GroupVersionResource]bool),
+	}
+
+	go resourceCache.start(client)
+}
+
+// Disable stops all running informers and makes lists to be read directly from the apiserver again.
+func Disable() {
+	if resourceCache != nil {
+		close(resourceCache.stopCh)
+		resourceCache = nil
+	}
+}
+
+// Enabled returns true if resource cache is enabled.
+func Enabled() bool {
+	return resourceCache != nil
+}
+
+// List returns objects of given resource from the cache. The second returned value is false when the objects
+// could not be served from the cache and have to be listed directly from the apiserver, i.e. when the cache
+// is disabled, the resource is not cached or has not synced yet, user is not allowed to list it or options can
+// not be applied to the cached objects.
+func List(client kubernetes.Interface, resource schema.GroupVersionResource, namespace string,
+	options metaV1.ListOptions) ([]runtime.Object, bool) {
+	c := resourceCache
+	if c == nil {
+		return nil, false
+	}
+
+	checker, ok := client.(AccessChecker)
+	if !ok || !isCacheable(options) {
+		trackRequest(resource, resultBypass)
+		return nil, false
+	}
+
+	selector, err := labels.Parse(options.LabelSelector)
+	if err != nil {
+		trackRequest(resource, resultBypass)
+		return nil, false
+	}
+
+	// Availability of the informer is checked first, so no access review is made when the list has to be read
+	// from the apiserver anyway.
+	informer, ok := c.informer(resource)
+	if !ok {
+		trackRequest(resource, resultMiss)
+		return nil, false
+	}
+
+	if !checker.CanList(resource, namespace) {
+		// Let the apiserver respond with the proper forbidden error.
+		trackRequest(resource, resultDenied)
+		return nil, false
+	}
+
+	var objects []runtime.Object
+	if len(namespace) > 0 {
+		objects, err = informer.Lister().ByNamespace(namespace).List(selector)
+	} else {
+		objects, err = informer.Lister().List(selector)
+	}
+
+	if err != nil {
+		trackRequest(resource, resultMiss)
+		return nil, false
+	}
+
+	trackRequest(resource, resultHit)
+	return objects, true
+}
+
+// start creates and starts informers of all cached resources that dashboard is allowed to list and watch.
+func (self *Cache) start(client kubernetes.Interface) {
+	// Access reviews are cancelled when the cache is disabled before they finish.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-self.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for _, resource := range cachedResources {
+		if !canListAndWatch(ctx, client, resource) {
+			log.Printf("Resource %s can not be cached, dashboard is not allowed to list and watch it in all "+
+				"namespaces", resource.String())
+			self.markFailed(resource)
+			continue
+		}
+
+		informer, err := self.factory.ForResource(resource)
+		if err != nil {
+			log.Printf("Resource %s can not be cached: %s", resource.String(), err.Error())
+			self.markFailed(resource)
+			continue
+		}
+
+		resource := resource
+		err = informer.Informer().SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+			cache.DefaultWatchErrorHandler(r, err)
+			if k8serrors.IsForbidden(err) || k8serrors.IsNotFound(err) {
+				log.Printf("Resource %s can not be cached: %s", resource.String(), err.Error())
+				self.markFailed(resource)
+			}
+		})
+		if err != nil {
+			log.Printf("Could not set watch error handler of %s resource: %s", resource.String(), err.Error())
+		}
+
+		self.mux.Lock()
+		self.informers[resource] = informer
+		self.mux.Unlock()
+	}
+
+	self.factory.Start(self.stopCh)
+}
+
+func (self *Cache) markFailed(resource schema.GroupVersionResource) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	self.failed[resource] = true
+}
+
+// informer returns informer of given resource if it has synced. It never waits for the informer, so lists of
+// resources that are not cached yet are read directly from the apiserver.
+func (self *Cache) informer(resource schema.GroupVersionResource) (informers.GenericInformer, bool) {
+	self.mux.RLock()
+	defer self.mux.RUnlock()
+
+	informer, ok := self.informers[resource]
+	if !ok || self.failed[resource] || !informer.Informer().HasSynced() {
+		return nil, false
+	}
+
+	return informer, true
+}
+
+// canListAndWatch returns true if given client is allowed to list and watch given resource in all namespaces.
+func canListAndWatch(ctx context.Context, client kubernetes.Interface, resource schema.GroupVersionResource) bool {
+	for _, verb := range []string{"list", "watch"} {
+		review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx,
+			&authorizationV1.SelfSubjectAccessReview{
+				Spec: authorizationV1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationV1.ResourceAttributes{
+						Verb:     verb,
+						Group:    resource.Group,
+						Resource: resource.Resource,
+					},
+				},
+			}, metaV1.CreateOptions{})
+		if err != nil {
+			log.Printf("Could not check access to %s resource: %s", resource.String(), err.Error())
+			return false
+		}
+
+		if !review.Status.Allowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isCacheable returns true if given list options can be applied to the objects kept in the cache.
+func isCacheable(options metaV1.ListOptions) bool {
+	return (len(options.FieldSelector) == 0 || options.FieldSelector == fields.Everything().String()) &&
+		len(options.ResourceVersion) == 0 && options.Limit == 0 && len(options.Continue) == 0
+}
@@ -0,0 +1,109 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	authorizationV1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	clientTesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/cache"
+)
+
+type fakeAccessChecker struct {
+	kubernetes.Interface
+	allowed bool
+}
+
+func (self *fakeAccessChecker) CanList(resource schema.GroupVersionResource, namespace string) bool {
+	return self.allowed
+}
+
+func TestList(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Name: "pod-1", Namespace: "ns-1", Labels: map[string]string{"app": "a"}}},
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Name: "pod-2", Namespace: "ns-1", Labels: map[string]string{"app": "b"}}},
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Name: "pod-3", Namespace: "ns-2", Labels: map[string]string{"app": "a"}}},
+	)
+	// Dashboard is allowed to list and watch everything except services.
+	client.PrependReactor("create", "selfsubjectaccessreviews",
+		func(action clientTesting.Action) (bool, runtime.Object, error) {
+			review := action.(clientTesting.CreateAction).GetObject().(*authorizationV1.SelfSubjectAccessReview)
+			review.Status.Allowed = review.Spec.ResourceAttributes.Resource != "services"
+			return true, review, nil
+		})
+	pods := v1.SchemeGroupVersion.WithResource("pods")
+
+	if _, ok := cache.List(&fakeAccessChecker{client, true}, pods, "", api.ListEverything); ok {
+		t.Fatal("List() served objects while the cache is disabled")
+	}
+
+	cache.Enable(client)
+	defer cache.Disable()
+
+	// Lists are read from the apiserver until the informer syncs.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, ok := cache.List(&fakeAccessChecker{client, true}, pods, "", api.ListEverything); ok {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("Informer of pods has not synced")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cases := []struct {
+		info      string
+		client    kubernetes.Interface
+		namespace string
+		resource  schema.GroupVersionResource
+		options   metaV1.ListOptions
+		expected  int
+		ok        bool
+	}{
+		{"all namespaces", &fakeAccessChecker{client, true}, "", pods, api.ListEverything, 3, true},
+		{"single namespace", &fakeAccessChecker{client, true}, "ns-1", pods, api.ListEverything, 2, true},
+		{"label selector", &fakeAccessChecker{client, true}, "", pods, metaV1.ListOptions{LabelSelector: "app=a"}, 2, true},
+		{"field selector", &fakeAccessChecker{client, true}, "", pods, metaV1.ListOptions{FieldSelector: "spec.nodeName=n"}, 0, false},
+		{"access denied", &fakeAccessChecker{client, false}, "", pods, api.ListEverything, 0, false},
+		{"client without access checks", client, "", pods, api.ListEverything, 0, false},
+		{"secrets are not cached", &fakeAccessChecker{client, true}, "", v1.SchemeGroupVersion.WithResource("secrets"),
+			api.ListEverything, 0, false},
+		{"dashboard not allowed to watch", &fakeAccessChecker{client, true}, "",
+			v1.SchemeGroupVersion.WithResource("services"), api.ListEverything, 0, false},
+	}
+
+	for _, c := range cases {
+		objects, ok := cache.List(c.client, c.resource, c.namespace, c.options)
+		if ok != c.ok {
+			t.Errorf("%s: List() returned ok %t, expected %t", c.info, ok, c.ok)
+		}
+
+		if len(objects) != c.expected {
+			t.Errorf("%s: List() returned %d objects, expected %d", c.info, len(objects), c.expected)
+		}
+	}
+}
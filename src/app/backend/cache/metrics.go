@@ -0,0 +1,48 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// Request was served from the cache.
+	resultHit = "hit"
+	// Request could not be served from the cache, i.e. because the informer has not synced yet.
+	resultMiss = "miss"
+	// User is not allowed to list requested resource.
+	resultDenied = "denied"
+	// Request options or client do not allow serving it from the cache.
+	resultBypass = "bypass"
+)
+
+var requestCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "dashboard_resource_cache_requests_total",
+		Help: "Counter of resource list requests made to the resource cache broken out for each resource and result.",
+	},
+	[]string{"resource", "result"},
+)
+
+// Initialize all metrics in prometheus
+func init() {
+	prometheus.MustRegister(requestCounter)
+}
+
+func trackRequest(resource schema.GroupVersionResource, result string) {
+	requestCounter.WithLabelValues(resource.GroupResource().String(), result).Inc()
+}
@@ -15,10 +15,14 @@
 package api
 
 import (
+	"context"
+
 	"github.com/emicklei/go-restful/v3"
 	v1 "k8s.io/api/authorization/v1"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -30,10 +34,14 @@ import (
 
 const (
 	// CsrfTokenSecretName is the resource information that are used as csrf token storage. Can be accessible by multiple dashboard replicas.
-	CsrfTokenSecretName = "kubernetes-dashboard-csrf"
+	CsrfTokenSecretName = authApi.CsrfTokenHolderName
 
 	// CsrfTokenSecretData is the name of the data var that holds the csrf token inside the secret.
 	CsrfTokenSecretData = "csrf"
+
+	// DashboardFieldManager is the name of the field manager used for changes made through Dashboard, unless
+	// the request sets a different one.
+	DashboardFieldManager = "kubernetes-dashboard"
 )
 
 // ClientManager is responsible for initializing and creating clients to communicate with
@@ -45,21 +53,63 @@ type ClientManager interface {
 	PluginClient(req *restful.Request) (pluginclientset.Interface, error)
 	InsecureAPIExtensionsClient() apiextensionsclientset.Interface
 	InsecurePluginClient() pluginclientset.Interface
+	InsecureConfig() *rest.Config
 	CanI(req *restful.Request, ssar *v1.SelfSubjectAccessReview) bool
 	Config(req *restful.Request) (*rest.Config, error)
 	ClientCmdConfig(req *restful.Request) (clientcmd.ClientConfig, error)
+	AuthInfo(req *restful.Request) (*api.AuthInfo, error)
 	CSRFKey() string
-	HasAccess(authInfo api.AuthInfo) (string, error)
+	HasAccess(ctx context.Context, authInfo api.AuthInfo) (string, error)
 	VerberClient(req *restful.Request, config *rest.Config) (ResourceVerber, error)
 	SetTokenManager(manager authApi.TokenManager)
 }
 
+// Cluster describes how Dashboard connects to one of the Kubernetes clusters it serves.
+type Cluster struct {
+	// Name identifies the cluster in API requests. It has to be unique among served clusters.
+	Name string `yaml:"name"`
+	// KubeConfigPath is a path to kubeconfig file with authorization and master location information.
+	KubeConfigPath string `yaml:"kubeconfig"`
+	// Context of the kubeconfig file used to connect to the cluster. Current context is used if it is empty.
+	Context string `yaml:"context"`
+	// ApiserverHost is an address of the apiserver in format 'protocol://address:port'. If both KubeConfigPath
+	// and ApiserverHost are empty in-cluster config is used.
+	ApiserverHost string `yaml:"apiserver-host"`
+}
+
 // ResourceVerber is responsible for performing generic CRUD operations on all supported resources.
 type ResourceVerber interface {
-	Put(kind string, namespaceSet bool, namespace string, name string,
-		object *runtime.Unknown) error
-	Get(kind string, namespaceSet bool, namespace string, name string) (runtime.Object, error)
-	Delete(kind string, namespaceSet bool, namespace string, name string) error
+	Put(ctx context.Context, kind string, namespaceSet bool, namespace string, name string,
+		object *runtime.Unknown, options PutOptions) (runtime.Object, error)
+	Get(ctx context.Context, kind string, namespaceSet bool, namespace string, name string) (runtime.Object, error)
+	List(ctx context.Context, kind string, namespaceSet bool, namespace string) (runtime.Object, error)
+	Delete(ctx context.Context, kind string, namespaceSet bool, namespace string, name string, options DeleteOptions) error
+	Patch(ctx context.Context, kind string, namespaceSet bool, namespace string, name string, patchType types.PatchType,
+		data []byte, options PatchOptions) (runtime.Object, error)
+}
+
+// PutOptions holds optional parameters of a put request.
+type PutOptions struct {
+	// DryRun sends the request to the apiserver without persisting the object.
+	DryRun bool
+}
+
+// DeleteOptions holds optional parameters of a delete request.
+type DeleteOptions struct {
+	// PropagationPolicy decides how dependents are garbage collected. Foreground deletion is used when nil.
+	PropagationPolicy *metaV1.DeletionPropagation
+}
+
+// PatchOptions holds optional parameters of a patch request.
+type PatchOptions struct {
+	// FieldManager is the name of the actor that makes the change. DashboardFieldManager is used when empty.
+	FieldManager string
+
+	// Force takes over fields owned by other field managers. Only valid for server-side apply.
+	Force bool
+
+	// DryRun sends the request to the apiserver without persisting the object.
+	DryRun bool
 }
 
 // CanIResponse is used to as response to check whether or not user is allowed to access given endpoint.
@@ -0,0 +1,130 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	v1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+// accessCheckingClient is a Kubernetes client that is able to tell if its user can list given resources, so they
+// can be served from the resource cache. It implements cache.AccessChecker interface.
+type accessCheckingClient struct {
+	kubernetes.Interface
+
+	manager *clientManager
+	request *restful.Request
+}
+
+// CanList returns true if user that made the request is allowed to list given resource in given namespace.
+func (self *accessCheckingClient) CanList(resource schema.GroupVersionResource, namespace string) bool {
+	return self.manager.cachedCanI(self.request, &v1.SelfSubjectAccessReview{
+		Spec: v1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &v1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "list",
+				Group:     resource.Group,
+				Resource:  resource.Resource,
+			},
+		},
+	})
+}
+
+type accessReviewResult struct {
+	allowed bool
+	expires time.Time
+}
+
+// accessReviewCache keeps results of self subject access reviews for a limited amount of time, so that checking
+// access before every cached list does not cost an apiserver call.
+type accessReviewCache struct {
+	ttl     time.Duration
+	results map[string]accessReviewResult
+	mux     sync.Mutex
+}
+
+func newAccessReviewCache(ttl time.Duration) *accessReviewCache {
+	return &accessReviewCache{ttl: ttl, results: make(map[string]accessReviewResult)}
+}
+
+func (self *accessReviewCache) get(key string) (allowed, ok bool) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	result, ok := self.results[key]
+	if !ok || time.Now().After(result.expires) {
+		delete(self.results, key)
+		return false, false
+	}
+
+	return result.allowed, true
+}
+
+func (self *accessReviewCache) set(key string, allowed bool) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	now := time.Now()
+	for k, result := range self.results {
+		if now.After(result.expires) {
+			delete(self.results, k)
+		}
+	}
+
+	self.results[key] = accessReviewResult{allowed: allowed, expires: now.Add(self.ttl)}
+}
+
+// cachedCanI works the same way as CanI but reuses results of recent reviews made with the same credentials.
+func (self *clientManager) cachedCanI(req *restful.Request, ssar *v1.SelfSubjectAccessReview) bool {
+	key := accessReviewKey(req, ssar.Spec.ResourceAttributes)
+	if allowed, ok := self.accessReviews.get(key); ok {
+		return allowed
+	}
+
+	allowed := self.CanI(req, ssar)
+	self.accessReviews.set(key, allowed)
+	return allowed
+}
+
+// accessReviewKey identifies the user by a hash of all request headers that are used to authenticate it.
+func accessReviewKey(req *restful.Request, attributes *v1.ResourceAttributes) string {
+	headers := []string{"Authorization", JWETokenHeader, "Impersonate-User", "Impersonate-Group"}
+	extraHeaders := make([]string, 0)
+	for header := range req.Request.Header {
+		if strings.HasPrefix(header, ImpersonateUserExtraHeader) {
+			extraHeaders = append(extraHeaders, header)
+		}
+	}
+	sort.Strings(extraHeaders)
+
+	hash := sha256.New()
+	for _, header := range append(headers, extraHeaders...) {
+		hash.Write([]byte(header))
+		hash.Write([]byte(strings.Join(req.Request.Header.Values(header), ",")))
+		hash.Write([]byte{0})
+	}
+
+	return strings.Join([]string{hex.EncodeToString(hash.Sum(nil)), attributes.Verb, attributes.Group,
+		attributes.Resource, attributes.Namespace}, "/")
+}
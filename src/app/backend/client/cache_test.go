@@ -0,0 +1,77 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	v1 "k8s.io/api/authorization/v1"
+)
+
+func TestAccessReviewKey(t *testing.T) {
+	newRequest := func(headers map[string]string) *restful.Request {
+		req := &http.Request{Header: http.Header{}}
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		return restful.NewRequest(req)
+	}
+	attributes := &v1.ResourceAttributes{Verb: "list", Resource: "pods", Namespace: "default"}
+
+	cases := []struct {
+		info  string
+		a, b  map[string]string
+		equal bool
+	}{
+		{"same token", map[string]string{"Authorization": "Bearer a"}, map[string]string{"Authorization": "Bearer a"}, true},
+		{"different tokens", map[string]string{"Authorization": "Bearer a"}, map[string]string{"Authorization": "Bearer b"}, false},
+		{"different jwe tokens", map[string]string{JWETokenHeader: "a"}, map[string]string{JWETokenHeader: "b"}, false},
+		{"impersonated user", map[string]string{"Authorization": "Bearer a"},
+			map[string]string{"Authorization": "Bearer a", "Impersonate-User": "admin"}, false},
+		{"impersonated extras", map[string]string{"Impersonate-User": "a", ImpersonateUserExtraHeader + "Scopes": "view"},
+			map[string]string{"Impersonate-User": "a", ImpersonateUserExtraHeader + "Scopes": "edit"}, false},
+		{"unrelated header", map[string]string{"Authorization": "Bearer a"},
+			map[string]string{"Authorization": "Bearer a", "Accept": "application/json"}, true},
+	}
+
+	for _, c := range cases {
+		a := accessReviewKey(newRequest(c.a), attributes)
+		b := accessReviewKey(newRequest(c.b), attributes)
+		if (a == b) != c.equal {
+			t.Errorf("%s: expected keys to be equal: %t, got %s and %s", c.info, c.equal, a, b)
+		}
+	}
+}
+
+func TestAccessReviewCache(t *testing.T) {
+	reviews := newAccessReviewCache(time.Minute)
+	if _, ok := reviews.get("key"); ok {
+		t.Fatal("get() returned result that was never set")
+	}
+
+	reviews.set("key", true)
+	if allowed, ok := reviews.get("key"); !ok || !allowed {
+		t.Fatalf("get() returned (%t, %t), expected (true, true)", allowed, ok)
+	}
+
+	expired := newAccessReviewCache(-time.Second)
+	expired.set("key", true)
+	if _, ok := expired.get("key"); ok {
+		t.Fatal("get() returned expired result")
+	}
+}
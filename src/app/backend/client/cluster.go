@@ -0,0 +1,123 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+	"k8s.io/client-go/tools/clientcmd"
+
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+)
+
+// DefaultClusterName is the name of the cluster served when Dashboard is not configured to serve multiple clusters.
+const DefaultClusterName = "default"
+
+// clusterRegistry is the content of cluster registry file.
+type clusterRegistry struct {
+	Clusters []clientapi.Cluster `yaml:"clusters"`
+}
+
+// LoadClusters returns clusters served by Dashboard. They are read from the cluster registry file if it is set,
+// otherwise every given context of the kubeconfig file is served as a separate cluster named after the context.
+// If neither is set, single cluster configured by kubeConfigPath and apiserverHost is returned. The first cluster
+// is the default one.
+func LoadClusters(kubeConfigPath, apiserverHost string, contexts []string, registryFile string) (
+	[]clientapi.Cluster, error) {
+	var clusters []clientapi.Cluster
+	var err error
+	switch {
+	case len(registryFile) > 0:
+		clusters, err = readClusterRegistry(registryFile)
+	case len(contexts) > 0:
+		clusters, err = clustersFromContexts(kubeConfigPath, contexts)
+	default:
+		clusters = []clientapi.Cluster{{
+			Name:           DefaultClusterName,
+			KubeConfigPath: kubeConfigPath,
+			ApiserverHost:  apiserverHost,
+		}}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return clusters, validateClusters(clusters)
+}
+
+func readClusterRegistry(path string) ([]clientapi.Cluster, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := new(clusterRegistry)
+	if err = yaml.UnmarshalStrict(content, registry); err != nil {
+		return nil, fmt.Errorf("invalid cluster registry %s: %s", path, err.Error())
+	}
+
+	return registry.Clusters, nil
+}
+
+func clustersFromContexts(kubeConfigPath string, contexts []string) ([]clientapi.Cluster, error) {
+	if len(kubeConfigPath) == 0 {
+		return nil, fmt.Errorf("kubeconfig file is required to serve clusters of its contexts")
+	}
+
+	config, err := clientcmd.LoadFromFile(kubeConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]clientapi.Cluster, 0, len(contexts))
+	for _, context := range contexts {
+		if _, exists := config.Contexts[context]; !exists {
+			return nil, fmt.Errorf("context %s does not exist in kubeconfig file %s", context, kubeConfigPath)
+		}
+
+		clusters = append(clusters, clientapi.Cluster{
+			Name:           context,
+			KubeConfigPath: kubeConfigPath,
+			Context:        context,
+		})
+	}
+
+	return clusters, nil
+}
+
+func validateClusters(clusters []clientapi.Cluster) error {
+	if len(clusters) == 0 {
+		return fmt.Errorf("no clusters to serve")
+	}
+
+	names := make(map[string]bool, len(clusters))
+	for _, cluster := range clusters {
+		if len(cluster.Name) == 0 || strings.Contains(cluster.Name, "/") {
+			return fmt.Errorf("invalid cluster name %q", cluster.Name)
+		}
+
+		if names[cluster.Name] {
+			return fmt.Errorf("cluster %s is defined more than once", cluster.Name)
+		}
+
+		names[cluster.Name] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: a
+  cluster:
+    server: https://a:6443
+- name: b
+  cluster:
+    server: https://b:6443
+users:
+- name: admin
+  user:
+    token: secret
+contexts:
+- name: production
+  context:
+    cluster: a
+    user: admin
+- name: staging
+  context:
+    cluster: b
+    user: admin
+current-context: production
+`
+
+func TestLoadClusters(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	kubeConfig := write("kubeconfig", testKubeConfig)
+	registry := write("registry.yaml", `
+clusters:
+- name: production
+  kubeconfig: `+kubeConfig+`
+  context: production
+- name: local
+  apiserver-host: http://localhost:8080
+`)
+	duplicateRegistry := write("duplicate.yaml", "clusters:\n- name: a\n- name: a\n")
+	invalidNameRegistry := write("invalid.yaml", "clusters:\n- name: a/b\n")
+	unknownFieldRegistry := write("unknown.yaml", "clusters:\n- name: a\n  server: http://localhost:8080\n")
+
+	cases := []struct {
+		info           string
+		kubeConfigPath string
+		apiserverHost  string
+		contexts       []string
+		registryFile   string
+		expected       []clientapi.Cluster
+		expectedErr    bool
+	}{
+		{
+			"single cluster", "", "http://localhost:8080", nil, "",
+			[]clientapi.Cluster{{Name: DefaultClusterName, ApiserverHost: "http://localhost:8080"}}, false,
+		},
+		{
+			"kubeconfig contexts", kubeConfig, "", []string{"staging", "production"}, "",
+			[]clientapi.Cluster{
+				{Name: "staging", KubeConfigPath: kubeConfig, Context: "staging"},
+				{Name: "production", KubeConfigPath: kubeConfig, Context: "production"},
+			}, false,
+		},
+		{"unknown context", kubeConfig, "", []string{"test"}, "", nil, true},
+		{"contexts without kubeconfig", "", "", []string{"production"}, "", nil, true},
+		{
+			"cluster registry", kubeConfig, "", []string{"staging"}, registry,
+			[]clientapi.Cluster{
+				{Name: "production", KubeConfigPath: kubeConfig, Context: "production"},
+				{Name: "local", ApiserverHost: "http://localhost:8080"},
+			}, false,
+		},
+		{"duplicate cluster names", "", "", nil, duplicateRegistry, nil, true},
+		{"invalid cluster name", "", "", nil, invalidNameRegistry, nil, true},
+		{"unknown registry field", "", "", nil, unknownFieldRegistry, nil, true},
+		{"missing registry", "", "", nil, filepath.Join(dir, "missing.yaml"), nil, true},
+	}
+
+	for _, c := range cases {
+		clusters, err := LoadClusters(c.kubeConfigPath, c.apiserverHost, c.contexts, c.registryFile)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, got %v", c.info, c.expectedErr, err)
+			continue
+		}
+
+		if !c.expectedErr && !reflect.DeepEqual(clusters, c.expected) {
+			t.Errorf("Test Case: %s. Expected clusters %#v, got %#v", c.info, c.expected, clusters)
+		}
+	}
+}
+
+func TestNewClusterClientManager(t *testing.T) {
+	kubeConfig := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := ioutil.WriteFile(kubeConfig, []byte(testKubeConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		cluster  clientapi.Cluster
+		expected string
+	}{
+		{clientapi.Cluster{Name: "production", KubeConfigPath: kubeConfig}, "https://a:6443"},
+		{clientapi.Cluster{Name: "staging", KubeConfigPath: kubeConfig, Context: "staging"}, "https://b:6443"},
+		{clientapi.Cluster{Name: "local", KubeConfigPath: kubeConfig, Context: "staging",
+			ApiserverHost: "http://localhost:8080"}, "http://localhost:8080"},
+	}
+
+	for _, c := range cases {
+		manager := NewClusterClientManager(c.cluster).(*clientManager)
+		if host := manager.InsecureConfig().Host; host != c.expected {
+			t.Errorf("NewClusterClientManager(%#v): Expected apiserver host %s, got %s", c.cluster, c.expected, host)
+		}
+	}
+}
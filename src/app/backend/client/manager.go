@@ -16,9 +16,12 @@ package client
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	v12 "k8s.io/api/authentication/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -37,6 +40,7 @@ import (
 
 	"github.com/kubernetes/dashboard/src/app/backend/args"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/cache"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/client/csrf"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
@@ -72,6 +76,8 @@ type clientManager struct {
 	// Path to kubeconfig file. If both kubeConfigPath and apiserverHost are empty
 	// inClusterConfig will be used
 	kubeConfigPath string
+	// Context of kubeconfig file used to connect to the apiserver. Current context is used if it is empty.
+	context string
 	// Address of apiserver host in format 'protocol://address:port'
 	apiserverHost string
 	// Initialized on clientManager creation and used if kubeconfigPath and apiserverHost are
@@ -92,6 +98,8 @@ type clientManager struct {
 	// to service account used by dashboard or kubeconfig file if it was passed during dashboard
 	// init.
 	insecureConfig *rest.Config
+	// Results of recent access reviews used to authorize lists served from the resource cache.
+	accessReviews *accessReviewCache
 }
 
 // Client returns a kubernetes client. In case dashboard login is enabled and option to skip
@@ -102,11 +110,22 @@ func (self *clientManager) Client(req *restful.Request) (kubernetes.Interface, e
 		return nil, errors.NewBadRequest("request can not be nil")
 	}
 
+	client := self.InsecureClient()
 	if self.isSecureModeEnabled(req) {
-		return self.secureClient(req)
+		secureClient, err := self.secureClient(req)
+		if err != nil {
+			return nil, err
+		}
+
+		client = secureClient
 	}
 
-	return self.InsecureClient(), nil
+	if cache.Enabled() {
+		// Lists served from cache are read with dashboard privileges, so client has to check user access first.
+		return &accessCheckingClient{Interface: client, manager: self, request: req}, nil
+	}
+
+	return client, nil
 }
 
 // APIExtensionsClient returns an API Extensions client. In case dashboard login is enabled and
@@ -195,7 +214,7 @@ func (self *clientManager) CanI(req *restful.Request, ssar *v1.SelfSubjectAccess
 		return false
 	}
 
-	response, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(context.TODO(), ssar, metaV1.CreateOptions{})
+	response, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(req.Request.Context(), ssar, metaV1.CreateOptions{})
 	if err != nil {
 		log.Println(err)
 		return false
@@ -220,6 +239,11 @@ func (self *clientManager) ClientCmdConfig(req *restful.Request) (clientcmd.Clie
 	return self.buildCmdConfig(authInfo, cfg), nil
 }
 
+// AuthInfo returns authentication information extracted from the request headers.
+func (self *clientManager) AuthInfo(req *restful.Request) (*api.AuthInfo, error) {
+	return self.extractAuthInfo(req)
+}
+
 // CSRFKey returns key that is generated upon client manager creation
 func (self *clientManager) CSRFKey() string {
 	return self.csrfKey
@@ -227,7 +251,7 @@ func (self *clientManager) CSRFKey() string {
 
 // HasAccess configures K8S api client with provided auth info and executes a basic check against apiserver to see
 // if it is valid.
-func (self *clientManager) HasAccess(authInfo api.AuthInfo) (string, error) {
+func (self *clientManager) HasAccess(ctx context.Context, authInfo api.AuthInfo) (string, error) {
 	cfg, err := self.buildConfigFromFlags(self.apiserverHost, self.kubeConfigPath)
 	if err != nil {
 		return "", err
@@ -253,7 +277,13 @@ func (self *clientManager) HasAccess(authInfo api.AuthInfo) (string, error) {
 		return "", err
 	}
 
-	result, err := client.AuthenticationV1().TokenReviews().Create(context.TODO(), &v12.TokenReview{
+	// Token review can not be used to resolve user identity when authenticating with client certificate. Apiserver
+	// uses certificate common name as a username.
+	if len(authInfo.Token) == 0 && len(authInfo.ClientCertificateData) > 0 {
+		return self.getUsernameFromCertificate(authInfo.ClientCertificateData), nil
+	}
+
+	result, err := client.AuthenticationV1().TokenReviews().Create(ctx, &v12.TokenReview{
 		Spec: v12.TokenReviewSpec{
 			Token: authInfo.Token,
 		},
@@ -326,7 +356,8 @@ func (self *clientManager) buildConfigFromFlags(apiserverHost, kubeConfigPath st
 	if len(kubeConfigPath) > 0 || len(apiserverHost) > 0 {
 		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
 			&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeConfigPath},
-			&clientcmd.ConfigOverrides{ClusterInfo: api.Cluster{Server: apiserverHost}}).ClientConfig()
+			&clientcmd.ConfigOverrides{CurrentContext: self.context, ClusterInfo: api.Cluster{Server: apiserverHost}}).
+			ClientConfig()
 	}
 
 	if self.isRunningInCluster() {
@@ -570,6 +601,20 @@ func (self *clientManager) getUsernameFromError(err error) string {
 	return re.ReplaceAllString(err.Error(), "$1")
 }
 
+func (self *clientManager) getUsernameFromCertificate(data []byte) string {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return ""
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return ""
+	}
+
+	return certificate.Subject.CommonName
+}
+
 func (self *clientManager) getUsername(name string) string {
 	const groups = 5
 	const nameGroupIdx = 4
@@ -586,9 +631,21 @@ func (self *clientManager) getUsername(name string) string {
 // NewClientManager creates client manager based on kubeConfigPath and apiserverHost parameters.
 // If both are empty then in-cluster config is used.
 func NewClientManager(kubeConfigPath, apiserverHost string) clientapi.ClientManager {
+	return NewClusterClientManager(clientapi.Cluster{
+		Name:           DefaultClusterName,
+		KubeConfigPath: kubeConfigPath,
+		ApiserverHost:  apiserverHost,
+	})
+}
+
+// NewClusterClientManager creates client manager that connects to given cluster. Every cluster gets its own
+// csrf key.
+func NewClusterClientManager(cluster clientapi.Cluster) clientapi.ClientManager {
 	result := &clientManager{
-		kubeConfigPath: kubeConfigPath,
-		apiserverHost:  apiserverHost,
+		kubeConfigPath: cluster.KubeConfigPath,
+		context:        cluster.Context,
+		apiserverHost:  cluster.ApiserverHost,
+		accessReviews:  newAccessReviewCache(time.Duration(args.Holder.GetResourceCacheAccessReviewTTL()) * time.Second),
 	}
 
 	result.init()
@@ -15,14 +15,24 @@
 package client
 
 import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"net/http"
 	"testing"
+	"time"
 
 	restful "github.com/emicklei/go-restful/v3"
 	"github.com/kubernetes/dashboard/src/app/backend/args"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd/api"
 )
 
 func TestNewClientManager(t *testing.T) {
@@ -283,6 +293,56 @@ func TestClientCmdConfig(t *testing.T) {
 	}
 }
 
+func TestClientCertificateConfig(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "jane"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %v", err)
+	}
+	certificateData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyData := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	manager := &clientManager{}
+	authInfo := &api.AuthInfo{ClientCertificateData: certificateData, ClientKeyData: keyData}
+	cfg, err := manager.buildCmdConfig(authInfo, &rest.Config{Host: "https://localhost:8080"}).ClientConfig()
+	if err != nil {
+		t.Fatalf("buildCmdConfig(): Expected config to be created but error was thrown: %s", err.Error())
+	}
+
+	if !bytes.Equal(cfg.TLSClientConfig.CertData, certificateData) ||
+		!bytes.Equal(cfg.TLSClientConfig.KeyData, keyData) {
+		t.Fatalf("buildCmdConfig(): Expected client certificate and key to be set in TLS config, got %v",
+			cfg.TLSClientConfig)
+	}
+
+	cases := []struct {
+		data     []byte
+		expected string
+	}{
+		{certificateData, "jane"},
+		{keyData, ""},
+		{[]byte("foo"), ""},
+	}
+	for _, c := range cases {
+		if actual := manager.getUsernameFromCertificate(c.data); actual != c.expected {
+			t.Errorf("getUsernameFromCertificate(%s): Expected %q but got %q", c.data, c.expected, actual)
+		}
+	}
+}
+
 func TestVerberClient(t *testing.T) {
 	// TODO: client manager needs a way of mocking created client to be able to test the logic inside
 	t.Skip("Skipping verber client test.")
@@ -22,6 +22,7 @@ import (
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	restclient "k8s.io/client-go/rest"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -78,13 +79,32 @@ func (verber *resourceVerber) getRESTClientByType(clientType api.ClientType) RES
 	}
 }
 
-func (verber *resourceVerber) getResourceSpecFromKind(kind string, namespaceSet bool) (client RESTClient, resourceSpec api.APIMapping, err error) {
+func (verber *resourceVerber) getResourceSpecFromKind(ctx context.Context, kind string, namespaceSet bool) (client RESTClient, resourceSpec api.APIMapping, err error) {
+	client, resourceSpec, err = verber.getResourceSpec(ctx, kind)
+	if err != nil {
+		return
+	}
+
+	if namespaceSet != resourceSpec.Namespaced {
+		if namespaceSet {
+			err = errors.NewInvalid(fmt.Sprintf("Set namespace for not-namespaced resource kind: %s", kind))
+			return
+		}
+		err = errors.NewInvalid(fmt.Sprintf("Set no namespace for namespaced resource kind: %s", kind))
+		return
+	}
+	return
+}
+
+// getResourceSpec returns the client and API mapping of the given kind. Kinds that are not built-in are looked
+// up among custom resource definitions.
+func (verber *resourceVerber) getResourceSpec(ctx context.Context, kind string) (client RESTClient, resourceSpec api.APIMapping, err error) {
 	resourceSpec, ok := api.KindToAPIMapping[kind]
 	if !ok {
 		var crdInfo crdInfo
 
 		// check if kind is CRD
-		crdInfo, err = verber.getCRDGroupAndVersion(kind)
+		crdInfo, err = verber.getCRDGroupAndVersion(ctx, kind)
 		if err != nil {
 			return
 		}
@@ -100,25 +120,16 @@ func (verber *resourceVerber) getResourceSpecFromKind(kind string, namespaceSet
 		}
 	}
 
-	if namespaceSet != resourceSpec.Namespaced {
-		if namespaceSet {
-			err = errors.NewInvalid(fmt.Sprintf("Set namespace for not-namespaced resource kind: %s", kind))
-			return
-		}
-		err = errors.NewInvalid(fmt.Sprintf("Set no namespace for namespaced resource kind: %s", kind))
-		return
-	}
-
 	if client == nil {
 		client = verber.getRESTClientByType(resourceSpec.ClientType)
 	}
 	return
 }
 
-func (verber *resourceVerber) getCRDGroupAndVersion(kind string) (info crdInfo, err error) {
+func (verber *resourceVerber) getCRDGroupAndVersion(ctx context.Context, kind string) (info crdInfo, err error) {
 	var crdv1 apiextensionsv1.CustomResourceDefinition
 
-	err = verber.apiExtensionsClient.Get().Resource("customresourcedefinitions").Name(kind).Do(context.TODO()).Into(&crdv1)
+	err = verber.apiExtensionsClient.Get().Resource("customresourcedefinitions").Name(kind).Do(ctx).Into(&crdv1)
 	if err != nil {
 		if errors.IsNotFoundError(err) {
 			return info, errors.NewInvalid(fmt.Sprintf("Unknown resource kind: %s", kind))
@@ -144,6 +155,7 @@ type RESTClient interface {
 	Delete() *restclient.Request
 	Put() *restclient.Request
 	Get() *restclient.Request
+	Patch(pt types.PatchType) *restclient.Request
 }
 
 // NewResourceVerber creates a new resource verber that uses the given client for performing operations.
@@ -153,52 +165,64 @@ func NewResourceVerber(client, appsClient, batchClient, betaBatchClient, autosca
 }
 
 // Delete deletes the resource of the given kind in the given namespace with the given name.
-func (verber *resourceVerber) Delete(kind string, namespaceSet bool, namespace string, name string) error {
-	client, resourceSpec, err := verber.getResourceSpecFromKind(kind, namespaceSet)
+func (verber *resourceVerber) Delete(ctx context.Context, kind string, namespaceSet bool, namespace string, name string,
+	options clientapi.DeleteOptions) error {
+	client, resourceSpec, err := verber.getResourceSpecFromKind(ctx, kind, namespaceSet)
 	if err != nil {
 		return err
 	}
 
 	// Do cascade delete by default, as this is what users typically expect.
-	defaultPropagationPolicy := v1.DeletePropagationForeground
-	defaultDeleteOptions := &v1.DeleteOptions{
-		PropagationPolicy: &defaultPropagationPolicy,
+	propagationPolicy := v1.DeletePropagationForeground
+	if options.PropagationPolicy != nil {
+		propagationPolicy = *options.PropagationPolicy
+	}
+	deleteOptions := &v1.DeleteOptions{
+		PropagationPolicy: &propagationPolicy,
 	}
 
-	req := client.Delete().Resource(resourceSpec.Resource).Name(name).Body(defaultDeleteOptions)
+	req := client.Delete().Resource(resourceSpec.Resource).Name(name).Body(deleteOptions)
 
 	if resourceSpec.Namespaced {
 		req.Namespace(namespace)
 	}
 
-	return req.Do(context.TODO()).Error()
+	return req.Do(ctx).Error()
 }
 
-// Put puts new resource version of the given kind in the given namespace with the given name.
-func (verber *resourceVerber) Put(kind string, namespaceSet bool, namespace string, name string,
-	object *runtime.Unknown) error {
+// Put puts new resource version of the given kind in the given namespace with the given name and returns the
+// stored resource.
+func (verber *resourceVerber) Put(ctx context.Context, kind string, namespaceSet bool, namespace string, name string,
+	object *runtime.Unknown, options clientapi.PutOptions) (runtime.Object, error) {
 
-	client, resourceSpec, err := verber.getResourceSpecFromKind(kind, namespaceSet)
+	client, resourceSpec, err := verber.getResourceSpecFromKind(ctx, kind, namespaceSet)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	result := &runtime.Unknown{}
 	req := client.Put().
 		Resource(resourceSpec.Resource).
 		Name(name).
 		SetHeader("Content-Type", "application/json").
+		SetHeader("Accept", "application/json").
 		Body([]byte(object.Raw))
 
+	if options.DryRun {
+		req.Param("dryRun", v1.DryRunAll)
+	}
+
 	if resourceSpec.Namespaced {
 		req.Namespace(namespace)
 	}
 
-	return req.Do(context.TODO()).Error()
+	err = req.Do(ctx).Into(result)
+	return result, err
 }
 
 // Get gets the resource of the given kind in the given namespace with the given name.
-func (verber *resourceVerber) Get(kind string, namespaceSet bool, namespace string, name string) (runtime.Object, error) {
-	client, resourceSpec, err := verber.getResourceSpecFromKind(kind, namespaceSet)
+func (verber *resourceVerber) Get(ctx context.Context, kind string, namespaceSet bool, namespace string, name string) (runtime.Object, error) {
+	client, resourceSpec, err := verber.getResourceSpecFromKind(ctx, kind, namespaceSet)
 	if err != nil {
 		return nil, err
 	}
@@ -210,6 +234,88 @@ func (verber *resourceVerber) Get(kind string, namespaceSet bool, namespace stri
 		req.Namespace(namespace)
 	}
 
-	err = req.Do(context.TODO()).Into(result)
+	err = req.Do(ctx).Into(result)
 	return result, err
 }
+
+// List lists resources of the given kind. Namespaced kinds are listed in the given namespace, or in all
+// namespaces if no namespace is set.
+func (verber *resourceVerber) List(ctx context.Context, kind string, namespaceSet bool, namespace string) (runtime.Object, error) {
+	client, resourceSpec, err := verber.getResourceSpec(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if namespaceSet && !resourceSpec.Namespaced {
+		return nil, errors.NewInvalid(fmt.Sprintf("Set namespace for not-namespaced resource kind: %s", kind))
+	}
+
+	result := &runtime.Unknown{}
+	req := client.Get().Resource(resourceSpec.Resource).SetHeader("Accept", "application/json")
+
+	if namespaceSet {
+		req.Namespace(namespace)
+	}
+
+	err = req.Do(ctx).Into(result)
+	return result, err
+}
+
+// Patch applies the patch of the given type to the resource of the given kind in the given namespace with the
+// given name and returns the patched resource.
+func (verber *resourceVerber) Patch(ctx context.Context, kind string, namespaceSet bool, namespace string, name string,
+	patchType types.PatchType, data []byte, options clientapi.PatchOptions) (runtime.Object, error) {
+	if !isSupportedPatchType(patchType) {
+		return nil, errors.NewInvalid(fmt.Sprintf("Unsupported patch type: %s", patchType))
+	}
+
+	if options.Force && patchType != types.ApplyPatchType {
+		return nil, errors.NewInvalid("Force is only supported for server-side apply")
+	}
+
+	client, resourceSpec, err := verber.getResourceSpecFromKind(ctx, kind, namespaceSet)
+	if err != nil {
+		return nil, err
+	}
+
+	// Custom resources have no patch strategies, so the apiserver can't apply strategic merge patches to them.
+	if _, ok := api.KindToAPIMapping[kind]; !ok && patchType == types.StrategicMergePatchType {
+		return nil, errors.NewInvalid(fmt.Sprintf("Strategic merge patch is not supported for custom resource kind: %s", kind))
+	}
+
+	fieldManager := options.FieldManager
+	if len(fieldManager) == 0 {
+		fieldManager = clientapi.DashboardFieldManager
+	}
+
+	result := &runtime.Unknown{}
+	req := client.Patch(patchType).
+		Resource(resourceSpec.Resource).
+		Name(name).
+		Param("fieldManager", fieldManager).
+		SetHeader("Accept", "application/json").
+		Body(data)
+
+	if options.Force {
+		req.Param("force", "true")
+	}
+
+	if options.DryRun {
+		req.Param("dryRun", v1.DryRunAll)
+	}
+
+	if resourceSpec.Namespaced {
+		req.Namespace(namespace)
+	}
+
+	err = req.Do(ctx).Into(result)
+	return result, err
+}
+
+func isSupportedPatchType(patchType types.PatchType) bool {
+	switch patchType {
+	case types.JSONPatchType, types.MergePatchType, types.StrategicMergePatchType, types.ApplyPatchType:
+		return true
+	}
+	return false
+}
@@ -15,6 +15,8 @@
 package client
 
 import (
+	"context"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -24,10 +26,12 @@ import (
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/rest/fake"
 
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 )
 
@@ -40,10 +44,12 @@ func (f clientFunc) Do(req *http.Request) (*http.Response, error) {
 type FakeRESTClient struct {
 	response *http.Response
 	err      error
+	request  *http.Request
 }
 
 func NewFakeClientFunc(c *FakeRESTClient) clientFunc {
 	return clientFunc(func(req *http.Request) (*http.Response, error) {
+		c.request = req
 		return c.response, c.err
 	})
 }
@@ -72,6 +78,17 @@ func (c *FakeRESTClient) Get() *restclient.Request {
 	return restclient.NewRequestWithClient(&url.URL{Path: "/api/v1/"}, "", restclient.ClientContentConfig{}, fake.CreateHTTPClient(NewFakeClientFunc(c))).Verb("GET")
 }
 
+func (c *FakeRESTClient) Patch(pt types.PatchType) *restclient.Request {
+	groupVersion := schema.GroupVersion{Group: "apps", Version: "v1"}
+	contentConfig := restclient.ClientContentConfig{
+		ContentType: "application/json",
+		Negotiator:  runtime.NewClientNegotiator(scheme.Codecs.WithoutConversion(), groupVersion),
+	}
+
+	return restclient.NewRequestWithClient(&url.URL{Path: "/api/v1/"}, "", contentConfig,
+		fake.CreateHTTPClient(NewFakeClientFunc(c))).Verb("PATCH").SetHeader("Content-Type", string(pt))
+}
+
 // Removes all quote signs that might have been added to the message.
 // Might depend on dependencies version how they are constructed.
 func normalize(msg string) string {
@@ -84,19 +101,19 @@ func TestDeleteShouldPropagateErrorsAndChooseClient(t *testing.T) {
 		appsClient: &FakeRESTClient{err: errors.NewInvalid("err from apps")},
 	}
 
-	err := verber.Delete("replicaset", true, "bar", "baz")
+	err := verber.Delete(context.TODO(), "replicaset", true, "bar", "baz", clientapi.DeleteOptions{})
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Delete /api/v1/namespaces/bar/replicasets/baz: err from apps") {
 		t.Fatalf("Expected error on verber delete but got %#v", err.Error())
 	}
 
-	err = verber.Delete("service", true, "bar", "baz")
+	err = verber.Delete(context.TODO(), "service", true, "bar", "baz", clientapi.DeleteOptions{})
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Delete /api/v1/namespaces/bar/services/baz: err") {
 		t.Fatalf("Expected error on verber delete but got %#v", err.Error())
 	}
 
-	err = verber.Delete("statefulset", true, "bar", "baz")
+	err = verber.Delete(context.TODO(), "statefulset", true, "bar", "baz", clientapi.DeleteOptions{})
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Delete /api/v1/namespaces/bar/statefulsets/baz: err from apps") {
 		t.Fatalf("Expected error on verber delete but got %#v", err.Error())
@@ -109,19 +126,19 @@ func TestGetShouldPropagateErrorsAndChoseClient(t *testing.T) {
 		appsClient: &FakeRESTClient{err: errors.NewInvalid("err from apps")},
 	}
 
-	_, err := verber.Get("replicaset", true, "bar", "baz")
+	_, err := verber.Get(context.TODO(), "replicaset", true, "bar", "baz")
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Get /api/v1/namespaces/bar/replicasets/baz: err from apps") {
 		t.Fatalf("Expected error on verber delete but got %#v", err.Error())
 	}
 
-	_, err = verber.Get("service", true, "bar", "baz")
+	_, err = verber.Get(context.TODO(), "service", true, "bar", "baz")
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Get /api/v1/namespaces/bar/services/baz: err") {
 		t.Fatalf("Expected error on verber delete but got %#v", err.Error())
 	}
 
-	_, err = verber.Get("statefulset", true, "bar", "baz")
+	_, err = verber.Get(context.TODO(), "statefulset", true, "bar", "baz")
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Get /api/v1/namespaces/bar/statefulsets/baz: err from apps") {
 		t.Fatalf("Expected error on verber delete but got %#v", err.Error())
@@ -134,7 +151,7 @@ func TestDeleteShouldThrowErrorOnUnknownResourceKind(t *testing.T) {
 		apiExtensionsClient: &FakeRESTClient{err: errors.NewNotFound("err")},
 	}
 
-	err := verber.Delete("foo", true, "bar", "baz")
+	err := verber.Delete(context.TODO(), "foo", true, "bar", "baz", clientapi.DeleteOptions{})
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Get /api/v1/customresourcedefinitions/foo: err") {
 		t.Fatalf("Expected error on verber delete but got %#v", err.Error())
@@ -147,7 +164,7 @@ func TestGetShouldThrowErrorOnUnknownResourceKind(t *testing.T) {
 		apiExtensionsClient: &FakeRESTClient{err: errors.NewNotFound("err")},
 	}
 
-	_, err := verber.Get("foo", true, "bar", "baz")
+	_, err := verber.Get(context.TODO(), "foo", true, "bar", "baz")
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Get /api/v1/customresourcedefinitions/foo: err") {
 		t.Fatalf("Expected error on verber get but got %#v", err.Error())
@@ -160,7 +177,7 @@ func TestPutShouldThrowErrorOnUnknownResourceKind(t *testing.T) {
 		apiExtensionsClient: &FakeRESTClient{err: errors.NewNotFound("err")},
 	}
 
-	err := verber.Put("foo", false, "", "baz", nil)
+	_, err := verber.Put(context.TODO(), "foo", false, "", "baz", nil, clientapi.PutOptions{})
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Get /api/v1/customresourcedefinitions/foo: err") {
 		t.Fatalf("Expected error on verber put but got %#v", err.Error())
@@ -170,7 +187,7 @@ func TestPutShouldThrowErrorOnUnknownResourceKind(t *testing.T) {
 func TestGetShouldRespectNamespacednessOfResourceKind(t *testing.T) {
 	verber := resourceVerber{client: &FakeRESTClient{}}
 
-	_, err := verber.Get("service", false, "", "baz")
+	_, err := verber.Get(context.TODO(), "service", false, "", "baz")
 
 	if !reflect.DeepEqual(err, errors.NewInvalid("Set no namespace for namespaced resource kind: service")) {
 		t.Fatalf("Expected error on verber get but got %#v", err)
@@ -180,7 +197,7 @@ func TestGetShouldRespectNamespacednessOfResourceKind(t *testing.T) {
 func TestPutShouldRespectNamespacednessOfResourceKind(t *testing.T) {
 	verber := resourceVerber{client: &FakeRESTClient{}}
 
-	err := verber.Put("service", false, "", "baz", nil)
+	_, err := verber.Put(context.TODO(), "service", false, "", "baz", nil, clientapi.PutOptions{})
 
 	if !reflect.DeepEqual(err, errors.NewInvalid("Set no namespace for namespaced resource kind: service")) {
 		t.Fatalf("Expected error on verber put but got %#v", err)
@@ -190,7 +207,7 @@ func TestPutShouldRespectNamespacednessOfResourceKind(t *testing.T) {
 func TestDeleteShouldRespectNamespacednessOfResourceKind(t *testing.T) {
 	verber := resourceVerber{client: &FakeRESTClient{}}
 
-	err := verber.Delete("service", false, "", "baz")
+	err := verber.Delete(context.TODO(), "service", false, "", "baz", clientapi.DeleteOptions{})
 
 	if !reflect.DeepEqual(err, errors.NewInvalid("Set no namespace for namespaced resource kind: service")) {
 		t.Fatalf("Expected error on verber delete but got %#v", err)
@@ -200,7 +217,7 @@ func TestDeleteShouldRespectNamespacednessOfResourceKind(t *testing.T) {
 func TestGetShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
 	verber := resourceVerber{client: &FakeRESTClient{}}
 
-	_, err := verber.Get("namespace", true, "bar", "baz")
+	_, err := verber.Get(context.TODO(), "namespace", true, "bar", "baz")
 
 	if !reflect.DeepEqual(err, errors.NewInvalid("Set namespace for not-namespaced resource kind: namespace")) {
 		t.Fatalf("Expected error on verber get but got %#v", err)
@@ -210,7 +227,7 @@ func TestGetShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
 func TestPutShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
 	verber := resourceVerber{client: &FakeRESTClient{}}
 
-	err := verber.Put("namespace", true, "bar", "baz", nil)
+	_, err := verber.Put(context.TODO(), "namespace", true, "bar", "baz", nil, clientapi.PutOptions{})
 
 	if !reflect.DeepEqual(err, errors.NewInvalid("Set namespace for not-namespaced resource kind: namespace")) {
 		t.Fatalf("Expected error on verber put but got %#v", err)
@@ -220,9 +237,150 @@ func TestPutShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
 func TestDeleteShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
 	verber := resourceVerber{client: &FakeRESTClient{}}
 
-	err := verber.Delete("namespace", true, "bar", "baz")
+	err := verber.Delete(context.TODO(), "namespace", true, "bar", "baz", clientapi.DeleteOptions{})
 
 	if !reflect.DeepEqual(err, errors.NewInvalid("Set namespace for not-namespaced resource kind: namespace")) {
 		t.Fatalf("Expected error on verber delete but got %#v", err)
 	}
 }
+
+func TestDeleteShouldSetPropagationPolicy(t *testing.T) {
+	background := metaV1.DeletePropagationBackground
+	cases := []struct {
+		options  clientapi.DeleteOptions
+		expected string
+	}{
+		{clientapi.DeleteOptions{}, `"propagationPolicy":"Foreground"`},
+		{clientapi.DeleteOptions{PropagationPolicy: &background}, `"propagationPolicy":"Background"`},
+	}
+
+	for _, c := range cases {
+		client := &FakeRESTClient{response: &http.Response{StatusCode: http.StatusOK,
+			Body: ioutil.NopCloser(strings.NewReader(""))}}
+		verber := resourceVerber{client: client}
+
+		if err := verber.Delete(context.TODO(), "service", true, "bar", "baz", c.options); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		body, _ := ioutil.ReadAll(client.request.Body)
+		if !strings.Contains(string(body), c.expected) {
+			t.Errorf("Delete(%#v) sent body %s, expected %s", c.options, body, c.expected)
+		}
+	}
+}
+
+func TestPatchShouldSetPatchTypeAndFieldManager(t *testing.T) {
+	cases := []struct {
+		patchType     types.PatchType
+		options       clientapi.PatchOptions
+		expectedQuery string
+	}{
+		{types.MergePatchType, clientapi.PatchOptions{}, "fieldManager=kubernetes-dashboard"},
+		{types.StrategicMergePatchType, clientapi.PatchOptions{FieldManager: "admin"}, "fieldManager=admin"},
+		{types.JSONPatchType, clientapi.PatchOptions{}, "fieldManager=kubernetes-dashboard"},
+		{types.ApplyPatchType, clientapi.PatchOptions{Force: true}, "fieldManager=kubernetes-dashboard&force=true"},
+	}
+
+	for _, c := range cases {
+		appsClient := &FakeRESTClient{response: &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"kind":"Deployment"}`)),
+		}}
+		verber := resourceVerber{appsClient: appsClient}
+
+		result, err := verber.Patch(context.TODO(), "deployment", true, "bar", "baz", c.patchType, []byte(`{}`), c.options)
+		if err != nil {
+			t.Fatalf("Patch(%s) returned error: %s", c.patchType, err)
+		}
+
+		request := appsClient.request
+		if request.Method != http.MethodPatch || request.URL.Path != "/api/v1/namespaces/bar/deployments/baz" {
+			t.Errorf("Patch(%s) sent %s %s, expected PATCH /api/v1/namespaces/bar/deployments/baz",
+				c.patchType, request.Method, request.URL.Path)
+		}
+		if contentType := request.Header.Get("Content-Type"); contentType != string(c.patchType) {
+			t.Errorf("Patch(%s) sent content type %s", c.patchType, contentType)
+		}
+		if request.URL.RawQuery != c.expectedQuery {
+			t.Errorf("Patch(%s) sent query %s, expected %s", c.patchType, request.URL.RawQuery, c.expectedQuery)
+		}
+		if raw := string(result.(*runtime.Unknown).Raw); raw != `{"kind":"Deployment"}` {
+			t.Errorf("Patch(%s) returned %s", c.patchType, raw)
+		}
+	}
+}
+
+func TestPatchShouldRejectInvalidOptions(t *testing.T) {
+	verber := resourceVerber{client: &FakeRESTClient{}}
+
+	cases := []struct {
+		patchType types.PatchType
+		options   clientapi.PatchOptions
+		expected  error
+	}{
+		{"application/xml", clientapi.PatchOptions{}, errors.NewInvalid("Unsupported patch type: application/xml")},
+		{types.MergePatchType, clientapi.PatchOptions{Force: true},
+			errors.NewInvalid("Force is only supported for server-side apply")},
+	}
+
+	for _, c := range cases {
+		_, err := verber.Patch(context.TODO(), "service", true, "bar", "baz", c.patchType, nil, c.options)
+		if !reflect.DeepEqual(err, c.expected) {
+			t.Errorf("Patch(%s) == %#v, expected %#v", c.patchType, err, c.expected)
+		}
+	}
+}
+
+func TestPatchShouldThrowErrorOnUnknownResourceKind(t *testing.T) {
+	verber := resourceVerber{
+		client:              &FakeRESTClient{},
+		apiExtensionsClient: &FakeRESTClient{err: errors.NewNotFound("err")},
+	}
+
+	_, err := verber.Patch(context.TODO(), "foo", true, "bar", "baz", types.MergePatchType, nil, clientapi.PatchOptions{})
+
+	if !reflect.DeepEqual(normalize(err.Error()), "Get /api/v1/customresourcedefinitions/foo: err") {
+		t.Fatalf("Expected error on verber patch but got %#v", err.Error())
+	}
+}
+
+func TestPatchShouldRespectNamespacednessOfResourceKind(t *testing.T) {
+	verber := resourceVerber{client: &FakeRESTClient{}}
+
+	_, err := verber.Patch(context.TODO(), "namespace", true, "bar", "baz", types.MergePatchType, nil, clientapi.PatchOptions{})
+
+	if !reflect.DeepEqual(err, errors.NewInvalid("Set namespace for not-namespaced resource kind: namespace")) {
+		t.Fatalf("Expected error on verber patch but got %#v", err)
+	}
+}
+
+func TestListShouldPropagateErrorsAndChooseClient(t *testing.T) {
+	verber := resourceVerber{
+		client:     &FakeRESTClient{err: errors.NewInvalid("err")},
+		appsClient: &FakeRESTClient{err: errors.NewInvalid("err from apps")},
+	}
+
+	_, err := verber.List(context.TODO(), "deployment", true, "bar")
+
+	if !reflect.DeepEqual(normalize(err.Error()), "Get /api/v1/namespaces/bar/deployments: err from apps") {
+		t.Fatalf("Expected error on verber list but got %#v", err.Error())
+	}
+
+	_, err = verber.List(context.TODO(), "service", false, "")
+
+	if !reflect.DeepEqual(normalize(err.Error()), "Get /api/v1/services: err") {
+		t.Fatalf("Expected error on verber list but got %#v", err.Error())
+	}
+}
+
+func TestListShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
+	verber := resourceVerber{client: &FakeRESTClient{}}
+
+	_, err := verber.List(context.TODO(), "namespace", true, "bar")
+
+	if !reflect.DeepEqual(err, errors.NewInvalid("Set namespace for not-namespaced resource kind: namespace")) {
+		t.Fatalf("Expected error on verber list but got %#v", err)
+	}
+}
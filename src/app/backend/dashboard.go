@@ -15,6 +15,7 @@
 package main
 
 import (
+	"context"
 	"crypto/elliptic"
 	"crypto/tls"
 	"flag"
@@ -23,28 +24,42 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/audit"
+	auditApi "github.com/kubernetes/dashboard/src/app/backend/audit/api"
 	"github.com/kubernetes/dashboard/src/app/backend/auth"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	"github.com/kubernetes/dashboard/src/app/backend/auth/jwe"
+	"github.com/kubernetes/dashboard/src/app/backend/auth/oidc"
+	"github.com/kubernetes/dashboard/src/app/backend/cache"
 	"github.com/kubernetes/dashboard/src/app/backend/cert"
 	"github.com/kubernetes/dashboard/src/app/backend/cert/ecdsa"
 	"github.com/kubernetes/dashboard/src/app/backend/client"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/handler"
+	"github.com/kubernetes/dashboard/src/app/backend/health"
 	"github.com/kubernetes/dashboard/src/app/backend/integration"
 	integrationapi "github.com/kubernetes/dashboard/src/app/backend/integration/api"
+	"github.com/kubernetes/dashboard/src/app/backend/logging"
 	"github.com/kubernetes/dashboard/src/app/backend/settings"
 	"github.com/kubernetes/dashboard/src/app/backend/sync"
 	"github.com/kubernetes/dashboard/src/app/backend/systembanner"
 )
 
+// configReloadPeriod is the time between checks of the config file for changes.
+const configReloadPeriod = 10 * time.Second
+
 var (
+	argConfigFile                = pflag.String("config", "", "path to YAML file that maps flag names to values of Dashboard arguments, flags set on the command line take precedence over it. Changes of 'system-banner', 'system-banner-severity', 'api-log-level', 'token-ttl' and 'metric-client-check-period' are applied without restart")
 	argInsecurePort              = pflag.Int("insecure-port", 9090, "port to listen to for incoming HTTP requests")
 	argPort                      = pflag.Int("port", 8443, "secure port to listen to for incoming HTTPS requests")
 	argInsecureBindAddress       = pflag.IP("insecure-bind-address", net.IPv4(127, 0, 0, 1), "IP address on which to serve the --insecure-port, set to 127.0.0.1 for all interfaces")
@@ -57,8 +72,17 @@ var (
 	argHeapsterHost              = pflag.String("heapster-host", "", "address of the Heapster API server to connect to in the format of protocol://address:port, leave it empty if the binary runs inside cluster for service proxy usage")
 	argSidecarHost               = pflag.String("sidecar-host", "", "address of the Sidecar API server to connect to in the format of protocol://address:port, leave it empty if the binary runs inside cluster for service proxy usage")
 	argKubeConfigFile            = pflag.String("kubeconfig", "", "path to kubeconfig file with authorization and master location information")
+	argKubeConfigContexts        = pflag.StringSlice("kubeconfig-contexts", []string{}, "comma separated list of --kubeconfig contexts served as separate clusters, the first one is the default cluster")
+	argClusterRegistry           = pflag.String("cluster-registry", "", "path to YAML file with the list of served clusters, each with 'name' and optional 'kubeconfig', 'context' and 'apiserver-host' fields, the first one is the default cluster. It takes precedence over --kubeconfig-contexts")
 	argTokenTTL                  = pflag.Int("token-ttl", authApi.DefaultTokenTTL, "expiration time in seconds of JWE tokens generated by dashboard, set to 0 to avoid expiration")
-	argAuthenticationMode        = pflag.StringSlice("authentication-mode", []string{authApi.Token.String()}, "enabled authentication options, supports 'token' and 'basic' that should only be used if Kubernetes API server has --authorization-mode=ABAC and --basic-auth-file flags set")
+	argTokenKeyRotationInterval  = pflag.Int("token-key-rotation-interval", 0, "time in seconds after which new key used to encrypt JWE tokens is generated, set to 0 to disable scheduled rotation")
+	argTokenKeyMaxAge            = pflag.Int("token-key-max-age", 0, "time in seconds after which key used to encrypt JWE tokens can not decrypt them anymore, set to 0 to keep replaced keys until tokens encrypted with them expire")
+	argAuthenticationMode        = pflag.StringSlice("authentication-mode", []string{authApi.Token.String()}, "enabled authentication options, supports 'token', 'oidc', 'certificate' and 'basic' that should only be used if Kubernetes API server has --authorization-mode=ABAC and --basic-auth-file flags set")
+	argOIDCIssuerURL             = pflag.String("oidc-issuer-url", "", "URL of the OpenID Connect issuer used by 'oidc' authentication mode, Kubernetes API server has to accept its ID tokens")
+	argOIDCClientID              = pflag.String("oidc-client-id", "", "id of the client registered with the OpenID Connect issuer")
+	argOIDCClientSecret          = pflag.String("oidc-client-secret", "", "secret of the client registered with the OpenID Connect issuer, leave it empty for public clients")
+	argOIDCRedirectURL           = pflag.String("oidc-redirect-url", "", "URL of the /api/v1/login/oidc/callback route of Dashboard registered as redirect URL of the OpenID Connect client")
+	argOIDCScopes                = pflag.StringSlice("oidc-scopes", []string{"openid", "email", "profile"}, "scopes requested from the OpenID Connect issuer, add 'offline_access' if the issuer issues refresh tokens only with it")
 	argMetricClientCheckPeriod   = pflag.Int("metric-client-check-period", 30, "time interval between separate metric client health checks in seconds")
 	argAutoGenerateCertificates  = pflag.Bool("auto-generate-certificates", false, "enables automatic certificates generation used to serve HTTPS")
 	argEnableInsecureLogin       = pflag.Bool("enable-insecure-login", false, "enables login view when the app is not served over HTTPS")
@@ -66,8 +90,25 @@ var (
 	argSystemBanner              = pflag.String("system-banner", "", "system banner message displayed in the app if non-empty, it accepts simple HTML")
 	argSystemBannerSeverity      = pflag.String("system-banner-severity", "INFO", "severity of system banner, should be one of 'INFO', 'WARNING' or 'ERROR'")
 	argAPILogLevel               = pflag.String("api-log-level", "INFO", "level of API request logging, should be one of 'NONE', 'INFO' or 'DEBUG'")
+	argLogFormat                 = pflag.String("log-format", string(logging.FormatText), "format of log entries, should be one of 'text' or 'json'")
 	argDisableSettingsAuthorizer = pflag.Bool("disable-settings-authorizer", false, "disables settings page user authorizer so anyone can access settings page")
 	argNamespace                 = pflag.String("namespace", getEnv("POD_NAMESPACE", "kube-system"), "if non-default namespace is used encryption key will be created in the specified namespace")
+	argEnableResourceCache       = pflag.Bool("enable-resource-cache", false, "serves resource lists from shared informer cache instead of listing them from the apiserver on every request")
+	argResourceCacheReviewTTL    = pflag.Int("resource-cache-access-review-ttl", 30, "time in seconds for which results of access reviews done before serving resources from cache are reused")
+	argAuditLogFile              = pflag.String("audit-log-file", "", "path to file to which audit entries of mutating requests are appended as JSON lines, leave it empty to disable the file audit log")
+	argAuditLogMaxSize           = pflag.Int("audit-log-max-size", 100, "maximum size in megabytes of the audit log file before it is rotated")
+	argAuditLogMaxBackups        = pflag.Int("audit-log-max-backups", 5, "maximum number of rotated audit log files to keep")
+	argAuditLogStdout            = pflag.Bool("audit-log-stdout", false, "writes audit entries of mutating requests as JSON lines to standard output")
+	argAuditWebhookURL           = pflag.String("audit-webhook-url", "", "URL to which audit entries of mutating requests are posted as JSON, leave it empty to disable the audit webhook")
+	argRateLimitRead             = pflag.Int("rate-limit-read", 0, "number of read requests per second allowed for every user or client address, 0 disables the limit")
+	argRateLimitWrite            = pflag.Int("rate-limit-write", 0, "number of write requests per second allowed for every user or client address, 0 disables the limit")
+	argRateLimitExec             = pflag.Int("rate-limit-exec", 0, "number of exec requests per second allowed for every user or client address, 0 disables the limit")
+	argRateLimitLogs             = pflag.Int("rate-limit-logs", 0, "number of log requests per second allowed for every user or client address, 0 disables the limit")
+	argRateLimitBurst            = pflag.Int("rate-limit-burst", 2, "multiplier of the rate limits that gives the number of requests allowed in a burst")
+	argRateLimitTrustedProxies   = pflag.StringSlice("rate-limit-trusted-proxies", []string{}, "comma separated list of addresses or CIDRs of proxies in front of Dashboard, whose X-Forwarded-For and X-Real-Ip headers are used to get client address for rate limits")
+	argRequestTimeout            = pflag.Int("request-timeout", 60, "time in seconds after which API requests and the apiserver calls made to serve them are cancelled, set to 0 to disable the timeout")
+	argRouteTimeouts             = pflag.StringToInt("route-timeouts", map[string]int{}, "comma separated list of route prefix=seconds pairs that override the request timeout of matching routes, e.g. '/api/v1/search=120'")
+	argShutdownTimeout           = pflag.Int("shutdown-timeout", 30, "time in seconds given to in-flight requests to finish when the server is shutting down")
 	localeConfig                 = pflag.String("locale-config", "./locale_conf.json", "path to file containing the locale configuration")
 )
 
@@ -79,9 +120,21 @@ func main() {
 	pflag.Parse()
 	_ = flag.CommandLine.Parse(make([]string, 0)) // Init for glog calls in kubernetes packages
 
+	var configWatcher *args.ConfigWatcher
+	if len(*argConfigFile) > 0 {
+		configWatcher = args.NewConfigWatcher(*argConfigFile, pflag.CommandLine)
+		if err := configWatcher.Load(); err != nil {
+			log.Fatalf("Error while loading config file. Reason: %s", err)
+		}
+	}
+
 	// Initializes dashboard arguments holder so we can read them in other packages
 	initArgHolder()
 
+	if err := logging.Init(logging.Format(args.Holder.GetLogFormat()), os.Stdout); err != nil {
+		log.Fatalf("Error while initializing logging. Reason: %s", err)
+	}
+
 	if args.Holder.GetApiServerHost() != "" {
 		log.Printf("Using apiserver-host location: %s", args.Holder.GetApiServerHost())
 	}
@@ -91,52 +144,61 @@ func main() {
 	if args.Holder.GetNamespace() != "" {
 		log.Printf("Using namespace: %s", args.Holder.GetNamespace())
 	}
+	if len(args.Holder.GetKubeConfigContexts()) > 0 {
+		log.Printf("Using kubeconfig contexts: %s", strings.Join(args.Holder.GetKubeConfigContexts(), ", "))
+	}
+	if args.Holder.GetClusterRegistryFile() != "" {
+		log.Printf("Using cluster registry file: %s", args.Holder.GetClusterRegistryFile())
+	}
+	if args.Holder.GetConfigFile() != "" {
+		log.Printf("Using config file: %s", args.Holder.GetConfigFile())
+	}
+	if _, err := handler.ParseTrustedProxies(args.Holder.GetRateLimitTrustedProxies()); err != nil {
+		log.Fatalf("Error while parsing trusted proxies. Reason: %s", err)
+	}
 
-	clientManager := client.NewClientManager(args.Holder.GetKubeConfigFile(), args.Holder.GetApiServerHost())
-	versionInfo, err := clientManager.InsecureClient().Discovery().ServerVersion()
+	clusters, err := client.LoadClusters(args.Holder.GetKubeConfigFile(), args.Holder.GetApiServerHost(),
+		args.Holder.GetKubeConfigContexts(), args.Holder.GetClusterRegistryFile())
 	if err != nil {
-		handleFatalInitError(err)
+		log.Fatalf("Error while loading clusters. Reason: %s", err)
 	}
 
-	log.Printf("Successful initial request to the apiserver, version: %s", versionInfo.String())
-
-	// Init auth manager
-	authManager := initAuthManager(clientManager)
-
-	// Init settings manager
-	settingsManager := settings.NewSettingsManager()
-
 	// Init system banner manager
 	systemBannerManager := systembanner.NewSystemBannerManager(args.Holder.GetSystemBanner(),
 		args.Holder.GetSystemBannerSeverity())
 
-	// Init integrations
-	integrationManager := integration.NewIntegrationManager(clientManager)
+	// Init audit manager
+	auditManager := initAuditManager()
 
-	switch metricsProvider := args.Holder.GetMetricsProvider(); metricsProvider {
-	case "sidecar":
-		integrationManager.Metric().ConfigureSidecar(args.Holder.GetSidecarHost()).
-			EnableWithRetry(integrationapi.SidecarIntegrationID, time.Duration(args.Holder.GetMetricClientCheckPeriod()))
-	case "heapster":
-		integrationManager.Metric().ConfigureHeapster(args.Holder.GetHeapsterHost()).
-			EnableWithRetry(integrationapi.HeapsterIntegrationID, time.Duration(args.Holder.GetMetricClientCheckPeriod()))
-	case "none":
-		log.Print("no metrics provider selected, will not check metrics.")
-	default:
-		log.Printf("Invalid metrics provider selected: %s", metricsProvider)
-		log.Print("Defaulting to use the Sidecar provider.")
-		integrationManager.Metric().ConfigureSidecar(args.Holder.GetSidecarHost()).
-			EnableWithRetry(integrationapi.SidecarIntegrationID, time.Duration(args.Holder.GetMetricClientCheckPeriod()))
+	// Init oidc provider shared by all clusters
+	oidcProvider := initOIDCProvider()
+
+	// Init managers of every cluster and route API requests to them
+	clusterRouter := handler.NewClusterRouter()
+	clusterServers := make([]*clusterServer, 0, len(clusters))
+	for _, cluster := range clusters {
+		clusterServer := initClusterServer(cluster, len(clusters) > 1, systemBannerManager, auditManager, oidcProvider)
+		clusterRouter.AddCluster(cluster.Name, clusterServer.apiHandler)
+		clusterServers = append(clusterServers, clusterServer)
 	}
 
-	apiHandler, err := handler.CreateHTTPAPIHandler(
-		integrationManager,
-		clientManager,
-		authManager,
-		settingsManager,
-		systemBannerManager)
-	if err != nil {
-		handleFatalInitError(err)
+	// State of oidc logins is encrypted with a key shared by all replicas, so that any of them can finish the login
+	if oidcProvider != nil {
+		oidcProvider.SetLoginKey(clusterServers[0].clientManager.CSRFKey())
+	}
+
+	if args.Holder.GetEnableResourceCache() {
+		if len(clusterServers) > 1 {
+			log.Print("Resource cache is not supported when serving multiple clusters, skipping it")
+		} else {
+			cache.Enable(clusterServers[0].clientManager.InsecureClient())
+		}
+	}
+
+	if configWatcher != nil {
+		go configWatcher.Watch(configReloadPeriod, wait.NeverStop, func(changed []string) {
+			reloadArgs(changed, systemBannerManager, clusterServers)
+		})
 	}
 
 	var servingCerts []tls.Certificate
@@ -161,37 +223,156 @@ func main() {
 
 	// Run a HTTP server that serves static public files from './public' and handles API calls.
 	http.Handle("/", handler.MakeGzipHandler(handler.CreateLocaleHandler()))
-	http.Handle("/api/", apiHandler)
+	http.Handle("/api/", clusterRouter)
 	http.Handle("/config", handler.AppHandler(handler.ConfigHandler))
 	http.Handle("/api/sockjs/", handler.CreateAttachHandler("/api/sockjs"))
 	http.Handle("/metrics", promhttp.Handler())
+	http.Handle("/healthz", health.NewHandler("healthz", health.PingCheck()))
+	http.Handle("/readyz", initReadinessHandler(clusterServers))
 
 	// Listen for http or https
+	server := &http.Server{Handler: http.DefaultServeMux}
 	if servingCerts != nil {
 		log.Printf("Serving securely on HTTPS port: %d", args.Holder.GetPort())
-		secureAddr := fmt.Sprintf("%s:%d", args.Holder.GetBindAddress(), args.Holder.GetPort())
-		server := &http.Server{
-			Addr:    secureAddr,
-			Handler: http.DefaultServeMux,
-			TLSConfig: &tls.Config{
-				Certificates: servingCerts,
-				MinVersion:   tls.VersionTLS12,
-			},
+		server.Addr = fmt.Sprintf("%s:%d", args.Holder.GetBindAddress(), args.Holder.GetPort())
+		server.TLSConfig = &tls.Config{
+			Certificates: servingCerts,
+			MinVersion:   tls.VersionTLS12,
 		}
-		go func() { log.Fatal(server.ListenAndServeTLS("", "")) }()
+		go serve(func() error { return server.ListenAndServeTLS("", "") })
 	} else {
 		log.Printf("Serving insecurely on HTTP port: %d", args.Holder.GetInsecurePort())
-		addr := fmt.Sprintf("%s:%d", args.Holder.GetInsecureBindAddress(), args.Holder.GetInsecurePort())
-		go func() { log.Fatal(http.ListenAndServe(addr, nil)) }()
+		server.Addr = fmt.Sprintf("%s:%d", args.Holder.GetInsecureBindAddress(), args.Holder.GetInsecurePort())
+		go serve(server.ListenAndServe)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	log.Printf("Received %s signal, shutting down", <-signals)
+	shutdown(server, auditManager)
+}
+
+// serve runs the server until it is shut down. Any other error is fatal.
+func serve(listenAndServe func() error) {
+	if err := listenAndServe(); err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+}
+
+// shutdown stops accepting new connections, ends long-lived streams and waits for in-flight requests to finish
+// for at most the shutdown timeout.
+func shutdown(server *http.Server, auditManager auditApi.AuditManager) {
+	handler.CloseStreams()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(args.Holder.GetShutdownTimeout())*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("In-flight requests did not finish before shutdown timeout: %s", err)
+		_ = server.Close()
+	}
+
+	if err := auditManager.Close(); err != nil {
+		log.Printf("Error while closing audit log: %s", err)
+	}
+
+	log.Print("Server stopped")
+}
+
+// clusterServer holds managers, that serve API requests routed to a single cluster.
+type clusterServer struct {
+	clientManager      clientapi.ClientManager
+	tokenManager       authApi.TokenManager
+	integrationManager integration.IntegrationManager
+	apiHandler         http.Handler
+	// name is added to names of readiness checks and synchronizers when Dashboard serves multiple clusters.
+	name string
+}
+
+// initClusterServer creates managers of given cluster. Every cluster has its own csrf key, encryption key, settings
+// and metric integration.
+func initClusterServer(cluster clientapi.Cluster, multiCluster bool,
+	systemBannerManager *systembanner.SystemBannerManager, auditManager auditApi.AuditManager,
+	oidcProvider *oidc.Provider) *clusterServer {
+	server := &clusterServer{clientManager: client.NewClusterClientManager(cluster)}
+	if multiCluster {
+		server.name = cluster.Name
+	}
+
+	versionInfo, err := server.clientManager.InsecureClient().Discovery().ServerVersion()
+	if err != nil {
+		handleFatalInitError(err)
+	}
+
+	log.Printf("Successful initial request to the apiserver of cluster %s, version: %s", cluster.Name,
+		versionInfo.String())
+
+	// Init auth manager
+	authManager, tokenManager := initAuthManager(server.clientManager, server.name, oidcProvider)
+	server.tokenManager = tokenManager
+
+	// Init settings manager
+	settingsManager := settings.NewSettingsManager()
+
+	// Init integrations
+	server.integrationManager = integration.NewIntegrationManager(server.clientManager)
+
+	switch metricsProvider := args.Holder.GetMetricsProvider(); metricsProvider {
+	case "sidecar":
+		server.integrationManager.Metric().ConfigureSidecar(args.Holder.GetSidecarHost()).
+			EnableWithRetry(integrationapi.SidecarIntegrationID, time.Duration(args.Holder.GetMetricClientCheckPeriod()))
+	case "heapster":
+		server.integrationManager.Metric().ConfigureHeapster(args.Holder.GetHeapsterHost()).
+			EnableWithRetry(integrationapi.HeapsterIntegrationID, time.Duration(args.Holder.GetMetricClientCheckPeriod()))
+	case "none":
+		log.Print("no metrics provider selected, will not check metrics.")
+	default:
+		log.Printf("Invalid metrics provider selected: %s", metricsProvider)
+		log.Print("Defaulting to use the Sidecar provider.")
+		server.integrationManager.Metric().ConfigureSidecar(args.Holder.GetSidecarHost()).
+			EnableWithRetry(integrationapi.SidecarIntegrationID, time.Duration(args.Holder.GetMetricClientCheckPeriod()))
+	}
+
+	server.apiHandler, err = handler.CreateHTTPAPIHandler(
+		server.integrationManager,
+		server.clientManager,
+		authManager,
+		settingsManager,
+		systemBannerManager,
+		auditManager)
+	if err != nil {
+		handleFatalInitError(err)
+	}
+
+	return server
+}
+
+// initReadinessHandler creates handler of readiness checks, that verify the apiserver and metric integration of
+// every cluster and encryption key synchronizers.
+func initReadinessHandler(clusterServers []*clusterServer) http.Handler {
+	checks := []health.Check{health.PingCheck(), health.SynchronizerCheck()}
+	for _, server := range clusterServers {
+		serverChecks := []health.Check{health.APIServerCheck(server.clientManager.InsecureClient().Discovery())}
+		if args.Holder.GetMetricsProvider() != "none" {
+			serverChecks = append(serverChecks, health.MetricCheck(server.integrationManager.Metric()))
+		}
+
+		for _, check := range serverChecks {
+			if len(server.name) > 0 {
+				check.Name += "-" + server.name
+			}
+			checks = append(checks, check)
+		}
 	}
-	select {}
+
+	return health.NewHandler("readyz", checks...)
 }
 
-func initAuthManager(clientManager clientapi.ClientManager) authApi.AuthManager {
+func initAuthManager(clientManager clientapi.ClientManager, cluster string, oidcProvider *oidc.Provider) (
+	authApi.AuthManager, authApi.TokenManager) {
 	insecureClient := clientManager.InsecureClient()
 
 	// Init default encryption key synchronizer
-	synchronizerManager := sync.NewSynchronizerManager(insecureClient)
+	synchronizerManager := sync.NewClusterSynchronizerManager(insecureClient, cluster)
 	keySynchronizer := synchronizerManager.Secret(args.Holder.GetNamespace(), authApi.EncryptionKeyHolderName)
 
 	// Register synchronizer. Overwatch will be responsible for restarting it in case of error.
@@ -204,6 +385,14 @@ func initAuthManager(clientManager clientapi.ClientManager) authApi.AuthManager
 	if tokenTTL != authApi.DefaultTokenTTL {
 		tokenManager.SetTokenTTL(tokenTTL)
 	}
+	if oidcProvider != nil {
+		tokenManager.SetTokenRefresher(oidcProvider)
+	}
+
+	// Init revoked tokens synchronizer and revocation list
+	revocationSynchronizer := synchronizerManager.Secret(args.Holder.GetNamespace(), authApi.RevokedTokensHolderName)
+	sync.Overwatch.RegisterSynchronizer(revocationSynchronizer, sync.AlwaysRestart)
+	tokenManager.SetRevocationList(jwe.NewSecretRevocationList(revocationSynchronizer))
 
 	// Set token manager for client manager.
 	clientManager.SetTokenManager(tokenManager)
@@ -215,11 +404,75 @@ func initAuthManager(clientManager clientapi.ClientManager) authApi.AuthManager
 	// UI logic dictates this should be the inverse of the cli option
 	authenticationSkippable := args.Holder.GetEnableSkipLogin()
 
-	return auth.NewAuthManager(clientManager, tokenManager, authModes, authenticationSkippable)
+	authManager := auth.NewAuthManager(clientManager, tokenManager, authModes, authenticationSkippable, oidcProvider)
+	return authManager, tokenManager
+}
+
+// initOIDCProvider creates provider used by oidc authentication mode. It returns nil if the mode is disabled.
+func initOIDCProvider() *oidc.Provider {
+	if !authApi.ToAuthenticationModes(args.Holder.GetAuthenticationMode()).IsEnabled(authApi.OIDC) {
+		return nil
+	}
+
+	if args.Holder.GetOIDCIssuerURL() == "" || args.Holder.GetOIDCClientID() == "" ||
+		args.Holder.GetOIDCRedirectURL() == "" {
+		log.Fatal("Error while initializing oidc authentication mode. Reason: --oidc-issuer-url, " +
+			"--oidc-client-id and --oidc-redirect-url arguments are required")
+	}
+
+	log.Printf("Using oidc issuer: %s", args.Holder.GetOIDCIssuerURL())
+	return oidc.NewProvider(oidc.Config{
+		IssuerURL:    args.Holder.GetOIDCIssuerURL(),
+		ClientID:     args.Holder.GetOIDCClientID(),
+		ClientSecret: args.Holder.GetOIDCClientSecret(),
+		RedirectURL:  args.Holder.GetOIDCRedirectURL(),
+		Scopes:       args.Holder.GetOIDCScopes(),
+	})
+}
+
+func initAuditManager() auditApi.AuditManager {
+	sinks := make([]auditApi.Sink, 0)
+	if args.Holder.GetAuditLogFile() != "" {
+		log.Printf("Writing audit log to file: %s", args.Holder.GetAuditLogFile())
+		sink, err := audit.NewFileSink(args.Holder.GetAuditLogFile(),
+			int64(args.Holder.GetAuditLogMaxSize())*1024*1024, args.Holder.GetAuditLogMaxBackups())
+		if err != nil {
+			log.Fatalf("Error while opening audit log file. Reason: %s", err)
+		}
+		sinks = append(sinks, sink)
+	}
+	if args.Holder.GetAuditLogStdout() {
+		sinks = append(sinks, audit.NewWriterSink(os.Stdout))
+	}
+	if args.Holder.GetAuditWebhookURL() != "" {
+		log.Printf("Sending audit entries to webhook: %s", args.Holder.GetAuditWebhookURL())
+		sinks = append(sinks, audit.NewWebhookSink(args.Holder.GetAuditWebhookURL()))
+	}
+
+	return audit.NewAuditManager(sinks...)
+}
+
+// reloadArgs applies reloadable arguments, that changed in the config file.
+func reloadArgs(changed []string, systemBannerManager *systembanner.SystemBannerManager,
+	clusterServers []*clusterServer) {
+	builder := args.GetHolderBuilder()
+	builder.SetSystemBanner(*argSystemBanner)
+	builder.SetSystemBannerSeverity(*argSystemBannerSeverity)
+	builder.SetAPILogLevel(*argAPILogLevel)
+	builder.SetTokenTTL(*argTokenTTL)
+	builder.SetMetricClientCheckPeriod(*argMetricClientCheckPeriod)
+
+	systemBannerManager.Set(args.Holder.GetSystemBanner(), args.Holder.GetSystemBannerSeverity())
+	for _, server := range clusterServers {
+		server.tokenManager.SetTokenTTL(time.Duration(args.Holder.GetTokenTTL()))
+		server.integrationManager.Metric().SetRetryPeriod(time.Duration(args.Holder.GetMetricClientCheckPeriod()))
+	}
+	log.Printf("Reloaded arguments from config file: %s", strings.Join(changed, ", "))
 }
 
 func initArgHolder() {
 	builder := args.GetHolderBuilder()
+	builder.SetConfigFile(*argConfigFile)
 	builder.SetInsecurePort(*argInsecurePort)
 	builder.SetPort(*argPort)
 	builder.SetTokenTTL(*argTokenTTL)
@@ -234,16 +487,42 @@ func initArgHolder() {
 	builder.SetHeapsterHost(*argHeapsterHost)
 	builder.SetSidecarHost(*argSidecarHost)
 	builder.SetKubeConfigFile(*argKubeConfigFile)
+	builder.SetKubeConfigContexts(*argKubeConfigContexts)
+	builder.SetClusterRegistryFile(*argClusterRegistry)
 	builder.SetSystemBanner(*argSystemBanner)
 	builder.SetSystemBannerSeverity(*argSystemBannerSeverity)
 	builder.SetAPILogLevel(*argAPILogLevel)
+	builder.SetLogFormat(*argLogFormat)
 	builder.SetAuthenticationMode(*argAuthenticationMode)
+	builder.SetOIDCIssuerURL(*argOIDCIssuerURL)
+	builder.SetOIDCClientID(*argOIDCClientID)
+	builder.SetOIDCClientSecret(*argOIDCClientSecret)
+	builder.SetOIDCRedirectURL(*argOIDCRedirectURL)
+	builder.SetOIDCScopes(*argOIDCScopes)
+	builder.SetTokenKeyRotationInterval(*argTokenKeyRotationInterval)
+	builder.SetTokenKeyMaxAge(*argTokenKeyMaxAge)
 	builder.SetAutoGenerateCertificates(*argAutoGenerateCertificates)
 	builder.SetEnableInsecureLogin(*argEnableInsecureLogin)
 	builder.SetDisableSettingsAuthorizer(*argDisableSettingsAuthorizer)
 	builder.SetEnableSkipLogin(*argEnableSkip)
 	builder.SetNamespace(*argNamespace)
 	builder.SetLocaleConfig(*localeConfig)
+	builder.SetEnableResourceCache(*argEnableResourceCache)
+	builder.SetResourceCacheAccessReviewTTL(*argResourceCacheReviewTTL)
+	builder.SetAuditLogFile(*argAuditLogFile)
+	builder.SetAuditLogMaxSize(*argAuditLogMaxSize)
+	builder.SetAuditLogMaxBackups(*argAuditLogMaxBackups)
+	builder.SetAuditLogStdout(*argAuditLogStdout)
+	builder.SetAuditWebhookURL(*argAuditWebhookURL)
+	builder.SetRateLimitRead(*argRateLimitRead)
+	builder.SetRateLimitWrite(*argRateLimitWrite)
+	builder.SetRateLimitExec(*argRateLimitExec)
+	builder.SetRateLimitLogs(*argRateLimitLogs)
+	builder.SetRateLimitBurst(*argRateLimitBurst)
+	builder.SetRateLimitTrustedProxies(*argRateLimitTrustedProxies)
+	builder.SetRequestTimeout(*argRequestTimeout)
+	builder.SetRouteTimeouts(*argRouteTimeouts)
+	builder.SetShutdownTimeout(*argShutdownTimeout)
 }
 
 /**
@@ -15,6 +15,8 @@
 package errors
 
 import (
+	"context"
+	goerrors "errors"
 	"fmt"
 	"net/http"
 
@@ -93,6 +95,30 @@ func NewInternal(reason string) *errors.StatusError {
 	}}
 }
 
+// NewRequestTimeout returns an error indicating that the request could not be completed before its deadline.
+func NewRequestTimeout(reason string) *errors.StatusError {
+	return &errors.StatusError{
+		ErrStatus: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusGatewayTimeout,
+			Reason:  metav1.StatusReasonTimeout,
+			Message: reason,
+		},
+	}
+}
+
+// NewTooManyRequests returns an error indicating that the client exceeded its rate limit and should retry later.
+func NewTooManyRequests(reason string) *errors.StatusError {
+	return &errors.StatusError{
+		ErrStatus: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusTooManyRequests,
+			Reason:  metav1.StatusReasonTooManyRequests,
+			Message: reason,
+		},
+	}
+}
+
 // NewUnexpectedObject return a statusError
 // which is an error intended for consumption by a REST API server; it can also be
 // reconstructed by clients from a REST response. Public to allow easy type switches.
@@ -189,3 +215,8 @@ func IsAlreadyExists(err error) bool {
 func IsUnauthorized(err error) bool {
 	return errors.IsUnauthorized(err)
 }
+
+// IsTimeoutError determines if err was caused by the request context reaching its deadline.
+func IsTimeoutError(err error) bool {
+	return goerrors.Is(err, context.DeadlineExceeded)
+}
@@ -123,6 +123,10 @@ func IsTokenExpiredError(err error) bool {
 
 // HandleInternalError writes the given error to the response and sets appropriate HTTP status headers.
 func HandleInternalError(response *restful.Response, err error) {
+	if IsTimeoutError(err) {
+		err = NewRequestTimeout(MsgRequestTimeoutError)
+	}
+
 	statusCode := http.StatusInternalServerError
 	statusError, ok := err.(*errors.StatusError)
 	if ok && statusError.Status().Code > 0 {
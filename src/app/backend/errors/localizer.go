@@ -28,6 +28,8 @@ const (
 	MsgEncryptionKeyChanged            = "MSG_ENCRYPTION_KEY_CHANGED"
 	MsgDashboardExclusiveResourceError = "MSG_DASHBOARD_EXCLUSIVE_RESOURCE_ERROR"
 	MsgTokenExpiredError               = "MSG_TOKEN_EXPIRED_ERROR"
+	MsgRequestTimeoutError             = "MSG_REQUEST_TIMEOUT_ERROR"
+	MsgTooManyRequestsError            = "MSG_TOO_MANY_REQUESTS_ERROR"
 )
 
 // This file contains all errors that should be kept in sync with:
@@ -51,6 +53,10 @@ func LocalizeError(err error) error {
 		return nil
 	}
 
+	if IsTimeoutError(err) {
+		return NewRequestTimeout(MsgRequestTimeoutError)
+	}
+
 	for partial, errString := range partialsToErrorsMap {
 		if strings.Contains(err.Error(), partial) {
 			if IsUnauthorized(err) {
@@ -15,6 +15,8 @@
 package errors_test
 
 import (
+	"context"
+	"net/url"
 	"testing"
 
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
@@ -41,6 +43,10 @@ func TestLocalizeError(t *testing.T) {
 			errors.NewInvalid("empty namespace may not be set"),
 			errors.NewInvalid("MSG_DEPLOY_EMPTY_NAMESPACE_ERROR"),
 		},
+		{
+			&url.Error{Op: "Get", URL: "https://apiserver/api/v1/pods", Err: context.DeadlineExceeded},
+			errors.NewRequestTimeout("MSG_REQUEST_TIMEOUT_ERROR"),
+		},
 	}
 	for _, c := range cases {
 		actual := errors.LocalizeError(c.err)
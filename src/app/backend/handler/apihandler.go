@@ -15,7 +15,10 @@
 package handler
 
 import (
+	"fmt"
+	"io/ioutil"
 	"log"
+	"mime"
 	"net/http"
 	"strconv"
 	"strings"
@@ -31,15 +34,20 @@ import (
 
 	"github.com/emicklei/go-restful/v3"
 	"golang.org/x/net/xsrftoken"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/remotecommand"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/audit"
+	auditApi "github.com/kubernetes/dashboard/src/app/backend/audit/api"
 	"github.com/kubernetes/dashboard/src/app/backend/auth"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/integration"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/bulk"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/clusterrole"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/clusterrolebinding"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
@@ -52,6 +60,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/deployment"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/export"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/horizontalpodautoscaler"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/ingress"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/ingressclass"
@@ -59,6 +68,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/logs"
 	ns "github.com/kubernetes/dashboard/src/app/backend/resource/namespace"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/node"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/overview"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/persistentvolume"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/persistentvolumeclaim"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/pod"
@@ -66,11 +76,13 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/replicationcontroller"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/role"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/rolebinding"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/search"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/secret"
 	resourceService "github.com/kubernetes/dashboard/src/app/backend/resource/service"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/serviceaccount"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/statefulset"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/storageclass"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/workload"
 	"github.com/kubernetes/dashboard/src/app/backend/scaling"
 	"github.com/kubernetes/dashboard/src/app/backend/settings"
 	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
@@ -86,6 +98,17 @@ const (
 	ResponseLogString = "[%s] Outcoming response to %s with %d status code"
 )
 
+// patchContentTypes are the content types accepted by patch routes. Each of them selects a patch type.
+var patchContentTypes = []string{
+	string(k8stypes.JSONPatchType),
+	string(k8stypes.MergePatchType),
+	string(k8stypes.StrategicMergePatchType),
+	string(k8stypes.ApplyPatchType),
+}
+
+// exportYAMLContentType is the content type of exported multi-document YAML bundles.
+const exportYAMLContentType = "application/yaml"
+
 // APIHandler is a representation of API handler. Structure contains clientapi, Heapster clientapi and clientapi configuration.
 type APIHandler struct {
 	iManager integration.IntegrationManager
@@ -102,14 +125,14 @@ type TerminalResponse struct {
 // CreateHTTPAPIHandler creates a new HTTP handler that handles all requests to the API of the backend.
 func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clientapi.ClientManager,
 	authManager authApi.AuthManager, sManager settingsApi.SettingsManager,
-	sbManager systembanner.SystemBannerManager) (http.Handler, error) {
+	sbManager *systembanner.SystemBannerManager, auditManager auditApi.AuditManager) (http.Handler, error) {
 	apiHandler := APIHandler{iManager: iManager, cManager: cManager, sManager: sManager}
 	wsContainer := restful.NewContainer()
 	wsContainer.EnableContentEncoding(true)
 
 	apiV1Ws := new(restful.WebService)
 
-	InstallFilters(apiV1Ws, cManager)
+	InstallFilters(apiV1Ws, cManager, auditManager)
 
 	apiV1Ws.Path("/api/v1").
 		Consumes(restful.MIME_JSON).
@@ -122,7 +145,7 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 	pluginHandler := plugin.NewPluginHandler(cManager)
 	pluginHandler.Install(apiV1Ws)
 
-	authHandler := auth.NewAuthHandler(authManager)
+	authHandler := auth.NewAuthHandler(authManager, cManager)
 	authHandler.Install(apiV1Ws)
 
 	settingsHandler := settings.NewSettingsHandler(sManager, cManager)
@@ -131,6 +154,11 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 	systemBannerHandler := systembanner.NewSystemBannerHandler(sbManager)
 	systemBannerHandler.Install(apiV1Ws)
 
+	if auditManager != nil {
+		auditHandler := audit.NewAuditHandler(auditManager, cManager, GetCluster)
+		auditHandler.Install(apiV1Ws)
+	}
+
 	apiV1Ws.Route(
 		apiV1Ws.GET("csrftoken/{action}").
 			To(apiHandler.handleGetCsrfToken).
@@ -166,6 +194,11 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 			To(apiHandler.handleDeployFromFile).
 			Reads(deployment.AppDeploymentFromFileSpec{}).
 			Writes(deployment.AppDeploymentFromFileResponse{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/appdeploymentfromfile/diff").
+			To(apiHandler.handleDiffFromFile).
+			Reads(deployment.AppDeploymentFromFileSpec{}).
+			Writes(deployment.AppDeploymentFromFileDiff{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.GET("/replicationcontroller").
@@ -577,6 +610,10 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 	apiV1Ws.Route(
 		apiV1Ws.PUT("/_raw/{kind}/namespace/{namespace}/name/{name}").
 			To(apiHandler.handlePutResource))
+	apiV1Ws.Route(
+		apiV1Ws.PATCH("/_raw/{kind}/namespace/{namespace}/name/{name}").
+			Consumes(patchContentTypes...).
+			To(apiHandler.handlePatchResource))
 
 	apiV1Ws.Route(
 		apiV1Ws.DELETE("/_raw/{kind}/name/{name}").
@@ -587,6 +624,16 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 	apiV1Ws.Route(
 		apiV1Ws.PUT("/_raw/{kind}/name/{name}").
 			To(apiHandler.handlePutResource))
+	apiV1Ws.Route(
+		apiV1Ws.PATCH("/_raw/{kind}/name/{name}").
+			Consumes(patchContentTypes...).
+			To(apiHandler.handlePatchResource))
+
+	apiV1Ws.Route(
+		apiV1Ws.POST("/bulk").
+			To(apiHandler.handleBulkOperation).
+			Reads(bulk.Operation{}).
+			Writes(bulk.Result{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.GET("/clusterrole").
@@ -716,6 +763,53 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 			To(apiHandler.handleLogFile).
 			Writes(logs.LogDetails{}))
 
+	apiV1Ws.Route(
+		apiV1Ws.GET("/workloads").
+			To(apiHandler.handleGetWorkloads).
+			Writes(workload.Workloads{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/workloads/{namespace}").
+			To(apiHandler.handleGetWorkloads).
+			Writes(workload.Workloads{}))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/overview").
+			To(apiHandler.handleGetOverview).
+			Writes(overview.Overview{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/overview/{namespace}").
+			To(apiHandler.handleGetOverview).
+			Writes(overview.Overview{}))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/search").
+			To(apiHandler.handleSearch).
+			Writes(search.SearchResult{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/search/{namespace}").
+			To(apiHandler.handleSearch).
+			Writes(search.SearchResult{}))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/export").
+			To(apiHandler.handleExport).
+			Produces(restful.MIME_JSON, exportYAMLContentType))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/export/{namespace}").
+			To(apiHandler.handleExport).
+			Produces(restful.MIME_JSON, exportYAMLContentType))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/watch/{kind}").
+			To(apiHandler.handleWatchResourceList).
+			ContentEncodingEnabled(false).
+			Produces("text/event-stream"))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/watch/{kind}/{namespace}").
+			To(apiHandler.handleWatchResourceList).
+			ContentEncodingEnabled(false).
+			Produces("text/event-stream"))
+
 	return wsContainer, nil
 }
 
@@ -727,7 +821,7 @@ func (apiHandler *APIHandler) handleGetClusterRoleList(request *restful.Request,
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := clusterrole.GetClusterRoleList(k8sClient, dataSelect)
+	result, err := clusterrole.GetClusterRoleList(request.Request.Context(), k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -743,7 +837,7 @@ func (apiHandler *APIHandler) handleGetClusterRoleDetail(request *restful.Reques
 	}
 
 	name := request.PathParameter("name")
-	result, err := clusterrole.GetClusterRoleDetail(k8sClient, name)
+	result, err := clusterrole.GetClusterRoleDetail(request.Request.Context(), k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -759,7 +853,7 @@ func (apiHandler *APIHandler) handleGetClusterRoleBindingList(request *restful.R
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := clusterrolebinding.GetClusterRoleBindingList(k8sClient, dataSelect)
+	result, err := clusterrolebinding.GetClusterRoleBindingList(request.Request.Context(), k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -775,7 +869,7 @@ func (apiHandler *APIHandler) handleGetClusterRoleBindingDetail(request *restful
 	}
 
 	name := request.PathParameter("name")
-	result, err := clusterrolebinding.GetClusterRoleBindingDetail(k8sClient, name)
+	result, err := clusterrolebinding.GetClusterRoleBindingDetail(request.Request.Context(), k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -792,7 +886,7 @@ func (apiHandler *APIHandler) handleGetRoleList(request *restful.Request, respon
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := role.GetRoleList(k8sClient, namespace, dataSelect)
+	result, err := role.GetRoleList(request.Request.Context(), k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -809,7 +903,7 @@ func (apiHandler *APIHandler) handleGetRoleDetail(request *restful.Request, resp
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := role.GetRoleDetail(k8sClient, namespace, name)
+	result, err := role.GetRoleDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -826,7 +920,7 @@ func (apiHandler *APIHandler) handleGetRoleBindingList(request *restful.Request,
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := rolebinding.GetRoleBindingList(k8sClient, namespace, dataSelect)
+	result, err := rolebinding.GetRoleBindingList(request.Request.Context(), k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -843,7 +937,7 @@ func (apiHandler *APIHandler) handleGetRoleBindingDetail(request *restful.Reques
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := rolebinding.GetRoleBindingDetail(k8sClient, namespace, name)
+	result, err := rolebinding.GetRoleBindingDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -867,7 +961,7 @@ func (apiHandler *APIHandler) handleGetStatefulSetList(request *restful.Request,
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := statefulset.GetStatefulSetList(k8sClient, namespace, dataSelect,
+	result, err := statefulset.GetStatefulSetList(request.Request.Context(), k8sClient, namespace, dataSelect,
 		apiHandler.iManager.Metric().Client())
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -885,7 +979,7 @@ func (apiHandler *APIHandler) handleGetStatefulSetDetail(request *restful.Reques
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("statefulset")
-	result, err := statefulset.GetStatefulSetDetail(k8sClient, apiHandler.iManager.Metric().Client(), namespace, name)
+	result, err := statefulset.GetStatefulSetDetail(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), namespace, name)
 
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -905,7 +999,7 @@ func (apiHandler *APIHandler) handleGetStatefulSetPods(request *restful.Request,
 	name := request.PathParameter("statefulset")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := statefulset.GetStatefulSetPods(k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, name, namespace)
+	result, err := statefulset.GetStatefulSetPods(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, name, namespace)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -923,7 +1017,7 @@ func (apiHandler *APIHandler) handleGetStatefulSetEvents(request *restful.Reques
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("statefulset")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := event.GetResourceEvents(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -940,7 +1034,7 @@ func (apiHandler *APIHandler) handleGetServiceList(request *restful.Request, res
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := resourceService.GetServiceList(k8sClient, namespace, dataSelect)
+	result, err := resourceService.GetServiceList(request.Request.Context(), k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -957,7 +1051,7 @@ func (apiHandler *APIHandler) handleGetServiceDetail(request *restful.Request, r
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("service")
-	result, err := resourceService.GetServiceDetail(k8sClient, namespace, name)
+	result, err := resourceService.GetServiceDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -976,7 +1070,7 @@ func (apiHandler *APIHandler) handleGetServiceEvent(request *restful.Request, re
 	name := request.PathParameter("service")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := resourceService.GetServiceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := resourceService.GetServiceEvents(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -993,7 +1087,7 @@ func (apiHandler *APIHandler) handleGetServiceAccountList(request *restful.Reque
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := serviceaccount.GetServiceAccountList(k8sClient, namespace, dataSelect)
+	result, err := serviceaccount.GetServiceAccountList(request.Request.Context(), k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1010,7 +1104,7 @@ func (apiHandler *APIHandler) handleGetServiceAccountDetail(request *restful.Req
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("serviceaccount")
-	result, err := serviceaccount.GetServiceAccountDetail(k8sClient, namespace, name)
+	result, err := serviceaccount.GetServiceAccountDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1028,7 +1122,7 @@ func (apiHandler *APIHandler) handleGetServiceAccountImagePullSecrets(request *r
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("serviceaccount")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := serviceaccount.GetServiceAccountImagePullSecrets(k8sClient, namespace, name, dataSelect)
+	result, err := serviceaccount.GetServiceAccountImagePullSecrets(request.Request.Context(), k8sClient, namespace, name, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1046,7 +1140,7 @@ func (apiHandler *APIHandler) handleGetServiceAccountSecrets(request *restful.Re
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("serviceaccount")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := serviceaccount.GetServiceAccountSecrets(k8sClient, namespace, name, dataSelect)
+	result, err := serviceaccount.GetServiceAccountSecrets(request.Request.Context(), k8sClient, namespace, name, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1063,7 +1157,7 @@ func (apiHandler *APIHandler) handleGetIngressDetail(request *restful.Request, r
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := ingress.GetIngressDetail(k8sClient, namespace, name)
+	result, err := ingress.GetIngressDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1082,7 +1176,7 @@ func (apiHandler *APIHandler) handleGetIngressEvent(request *restful.Request, re
 	name := request.PathParameter("ingress")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.NoMetrics
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := event.GetResourceEvents(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1099,7 +1193,7 @@ func (apiHandler *APIHandler) handleGetIngressList(request *restful.Request, res
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	namespace := parseNamespacePathParameter(request)
-	result, err := ingress.GetIngressList(k8sClient, namespace, dataSelect)
+	result, err := ingress.GetIngressList(request.Request.Context(), k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1118,7 +1212,7 @@ func (apiHandler *APIHandler) handleGetServicePods(request *restful.Request, res
 	name := request.PathParameter("service")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := resourceService.GetServicePods(k8sClient, apiHandler.iManager.Metric().Client(), namespace, name, dataSelect)
+	result, err := resourceService.GetServicePods(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), namespace, name, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1137,7 +1231,7 @@ func (apiHandler *APIHandler) handleGetServiceIngressList(request *restful.Reque
 	name := request.PathParameter("service")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.NoMetrics
-	result, err := resourceService.GetServiceIngressList(k8sClient, dataSelect, namespace, name)
+	result, err := resourceService.GetServiceIngressList(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1154,7 +1248,7 @@ func (apiHandler *APIHandler) handleGetNetworkPolicyList(request *restful.Reques
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := networkpolicy.GetNetworkPolicyList(k8sClient, namespace, dataSelect)
+	result, err := networkpolicy.GetNetworkPolicyList(request.Request.Context(), k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1171,7 +1265,7 @@ func (apiHandler *APIHandler) handleGetNetworkPolicyDetail(request *restful.Requ
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("networkpolicy")
-	result, err := networkpolicy.GetNetworkPolicyDetail(k8sClient, namespace, name)
+	result, err := networkpolicy.GetNetworkPolicyDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1188,7 +1282,7 @@ func (apiHandler *APIHandler) handleGetNodeList(request *restful.Request, respon
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := node.GetNodeList(k8sClient, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := node.GetNodeList(request.Request.Context(), k8sClient, dataSelect, apiHandler.iManager.Metric().Client())
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1206,7 +1300,7 @@ func (apiHandler *APIHandler) handleGetNodeDetail(request *restful.Request, resp
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := node.GetNodeDetail(k8sClient, apiHandler.iManager.Metric().Client(), name, dataSelect)
+	result, err := node.GetNodeDetail(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), name, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1224,7 +1318,7 @@ func (apiHandler *APIHandler) handleGetNodeEvents(request *restful.Request, resp
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := event.GetNodeEvents(k8sClient, dataSelect, name)
+	result, err := event.GetNodeEvents(request.Request.Context(), k8sClient, dataSelect, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1242,7 +1336,7 @@ func (apiHandler *APIHandler) handleGetNodePods(request *restful.Request, respon
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := node.GetNodePods(k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, name)
+	result, err := node.GetNodePods(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1262,7 +1356,7 @@ func (apiHandler *APIHandler) handleDeploy(request *restful.Request, response *r
 		errors.HandleInternalError(response, err)
 		return
 	}
-	if err := deployment.DeployApp(appDeploymentSpec, k8sClient); err != nil {
+	if err := deployment.DeployApp(request.Request.Context(), appDeploymentSpec, k8sClient); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
@@ -1280,7 +1374,7 @@ func (apiHandler *APIHandler) handleScaleResource(request *restful.Request, resp
 	kind := request.PathParameter("kind")
 	name := request.PathParameter("name")
 	count := request.QueryParameter("scaleBy")
-	replicaCountSpec, err := scaling.ScaleResource(cfg, kind, namespace, name, count)
+	replicaCountSpec, err := scaling.ScaleResource(request.Request.Context(), cfg, kind, namespace, name, count)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1288,6 +1382,39 @@ func (apiHandler *APIHandler) handleScaleResource(request *restful.Request, resp
 	response.WriteHeaderAndEntity(http.StatusOK, replicaCountSpec)
 }
 
+func (apiHandler *APIHandler) handleBulkOperation(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	cfg, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	verber, err := apiHandler.cManager.VerberClient(request, cfg)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	operation := new(bulk.Operation)
+	if err := request.ReadEntity(operation); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := bulk.Execute(request.Request.Context(), k8sClient, verber, cfg, operation)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
 func (apiHandler *APIHandler) handleGetReplicaCount(request *restful.Request, response *restful.Response) {
 	cfg, err := apiHandler.cManager.Config(request)
 	if err != nil {
@@ -1298,7 +1425,7 @@ func (apiHandler *APIHandler) handleGetReplicaCount(request *restful.Request, re
 	namespace := request.PathParameter("namespace")
 	kind := request.PathParameter("kind")
 	name := request.PathParameter("name")
-	replicaCounts, err := scaling.GetReplicaCounts(cfg, kind, namespace, name)
+	replicaCounts, err := scaling.GetReplicaCounts(request.Request.Context(), cfg, kind, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1319,24 +1446,58 @@ func (apiHandler *APIHandler) handleDeployFromFile(request *restful.Request, res
 		return
 	}
 
-	isDeployed, err := deployment.DeployAppFromFile(cfg, deploymentSpec)
-	if !isDeployed {
+	results, err := deployment.DeployAppFromFile(request.Request.Context(), cfg, deploymentSpec)
+	if err != nil && !deploymentSpec.Apply {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	status := http.StatusCreated
+	if deploymentSpec.DryRun {
+		status = http.StatusOK
+	}
+
+	// In apply mode results of all objects are returned even if one of them failed, so that it is known which
+	// objects were changed.
 	errorMessage := ""
 	if err != nil {
 		errorMessage = err.Error()
+		status = http.StatusInternalServerError
+		if statusError, ok := err.(*k8serrors.StatusError); ok && statusError.Status().Code > 0 {
+			status = int(statusError.Status().Code)
+		}
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, deployment.AppDeploymentFromFileResponse{
+	response.WriteHeaderAndEntity(status, deployment.AppDeploymentFromFileResponse{
 		Name:    deploymentSpec.Name,
 		Content: deploymentSpec.Content,
 		Error:   errorMessage,
+		Results: results,
 	})
 }
 
+func (apiHandler *APIHandler) handleDiffFromFile(request *restful.Request, response *restful.Response) {
+	cfg, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	deploymentSpec := new(deployment.AppDeploymentFromFileSpec)
+	if err := request.ReadEntity(deploymentSpec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := deployment.DiffAppFromFile(request.Request.Context(), cfg, deploymentSpec)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
 func (apiHandler *APIHandler) handleDeploymentPause(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -1346,7 +1507,7 @@ func (apiHandler *APIHandler) handleDeploymentPause(request *restful.Request, re
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("deployment")
-	deploymentSpec, err := deployment.PauseDeployment(k8sClient, namespace, name)
+	deploymentSpec, err := deployment.PauseDeployment(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1368,7 +1529,7 @@ func (apiHandler *APIHandler) handleDeploymentRollback(request *restful.Request,
 	}
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("deployment")
-	rolloutSpec, err = deployment.RollbackDeployment(k8sClient, rolloutSpec, namespace, name)
+	rolloutSpec, err = deployment.RollbackDeployment(request.Request.Context(), k8sClient, rolloutSpec, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1385,7 +1546,7 @@ func (apiHandler *APIHandler) handleDeploymentRestart(request *restful.Request,
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("deployment")
-	rolloutSpec, err := deployment.RestartDeployment(k8sClient, namespace, name)
+	rolloutSpec, err := deployment.RestartDeployment(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1402,7 +1563,7 @@ func (apiHandler *APIHandler) handleDeploymentResume(request *restful.Request, r
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("deployment")
-	deploymentSpec, err := deployment.ResumeDeployment(k8sClient, namespace, name)
+	deploymentSpec, err := deployment.ResumeDeployment(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1423,7 +1584,7 @@ func (apiHandler *APIHandler) handleNameValidity(request *restful.Request, respo
 		return
 	}
 
-	validity, err := validation.ValidateAppName(spec, k8sClient)
+	validity, err := validation.ValidateAppName(request.Request.Context(), spec, k8sClient)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1470,7 +1631,7 @@ func (apiHandler *APIHandler) handleGetReplicationControllerList(request *restfu
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicationcontroller.GetReplicationControllerList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := replicationcontroller.GetReplicationControllerList(request.Request.Context(), k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1488,7 +1649,7 @@ func (apiHandler *APIHandler) handleGetReplicaSets(request *restful.Request, res
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicaset.GetReplicaSetList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := replicaset.GetReplicaSetList(request.Request.Context(), k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1505,7 +1666,7 @@ func (apiHandler *APIHandler) handleGetReplicaSetDetail(request *restful.Request
 
 	namespace := request.PathParameter("namespace")
 	replicaSet := request.PathParameter("replicaSet")
-	result, err := replicaset.GetReplicaSetDetail(k8sClient, apiHandler.iManager.Metric().Client(), namespace, replicaSet)
+	result, err := replicaset.GetReplicaSetDetail(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), namespace, replicaSet)
 
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1526,7 +1687,7 @@ func (apiHandler *APIHandler) handleGetReplicaSetPods(request *restful.Request,
 	replicaSet := request.PathParameter("replicaSet")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicaset.GetReplicaSetPods(k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, replicaSet, namespace)
+	result, err := replicaset.GetReplicaSetPods(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, replicaSet, namespace)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1546,7 +1707,7 @@ func (apiHandler *APIHandler) handleGetReplicaSetServices(request *restful.Reque
 	replicaSet := request.PathParameter("replicaSet")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicaset.GetReplicaSetServices(k8sClient, dataSelect, namespace, replicaSet)
+	result, err := replicaset.GetReplicaSetServices(request.Request.Context(), k8sClient, dataSelect, namespace, replicaSet)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1566,7 +1727,7 @@ func (apiHandler *APIHandler) handleGetReplicaSetEvents(request *restful.Request
 	name := request.PathParameter("replicaSet")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := event.GetResourceEvents(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1587,7 +1748,7 @@ func (apiHandler *APIHandler) handleGetPodEvents(request *restful.Request, respo
 	name := request.PathParameter("pod")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := pod.GetEventsForPod(k8sClient, dataSelect, namespace, name)
+	result, err := pod.GetEventsForPod(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1634,7 +1795,7 @@ func (apiHandler *APIHandler) handleGetDeployments(request *restful.Request, res
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := deployment.GetDeploymentList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := deployment.GetDeploymentList(request.Request.Context(), k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1651,7 +1812,7 @@ func (apiHandler *APIHandler) handleGetDeploymentDetail(request *restful.Request
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("deployment")
-	result, err := deployment.GetDeploymentDetail(k8sClient, namespace, name)
+	result, err := deployment.GetDeploymentDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1670,7 +1831,7 @@ func (apiHandler *APIHandler) handleGetDeploymentEvents(request *restful.Request
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("deployment")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := event.GetResourceEvents(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1689,7 +1850,7 @@ func (apiHandler *APIHandler) handleGetDeploymentOldReplicaSets(request *restful
 	name := request.PathParameter("deployment")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := deployment.GetDeploymentOldReplicaSets(k8sClient, dataSelect, namespace, name)
+	result, err := deployment.GetDeploymentOldReplicaSets(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1708,7 +1869,7 @@ func (apiHandler *APIHandler) handleGetDeploymentNewReplicaSet(request *restful.
 	name := request.PathParameter("deployment")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := deployment.GetDeploymentNewReplicaSet(k8sClient, dataSelect, namespace, name)
+	result, err := deployment.GetDeploymentNewReplicaSet(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1726,7 +1887,7 @@ func (apiHandler *APIHandler) handleGetPods(request *restful.Request, response *
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics // download standard metrics - cpu, and memory - by default
-	result, err := pod.GetPodList(k8sClient, apiHandler.iManager.Metric().Client(), namespace, dataSelect)
+	result, err := pod.GetPodList(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1743,7 +1904,7 @@ func (apiHandler *APIHandler) handleGetPodDetail(request *restful.Request, respo
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("pod")
-	result, err := pod.GetPodDetail(k8sClient, apiHandler.iManager.Metric().Client(), namespace, name)
+	result, err := pod.GetPodDetail(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1760,7 +1921,7 @@ func (apiHandler *APIHandler) handleGetReplicationControllerDetail(request *rest
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("replicationController")
-	result, err := replicationcontroller.GetReplicationControllerDetail(k8sClient, namespace, name)
+	result, err := replicationcontroller.GetReplicationControllerDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1783,7 +1944,7 @@ func (apiHandler *APIHandler) handleUpdateReplicasCount(request *restful.Request
 		return
 	}
 
-	if err := replicationcontroller.UpdateReplicasCount(k8sClient, namespace, name, spec); err != nil {
+	if err := replicationcontroller.UpdateReplicasCount(request.Request.Context(), k8sClient, namespace, name, spec); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
@@ -1807,7 +1968,7 @@ func (apiHandler *APIHandler) handleGetResource(request *restful.Request, respon
 	kind := request.PathParameter("kind")
 	namespace, ok := request.PathParameters()["namespace"]
 	name := request.PathParameter("name")
-	result, err := verber.Get(kind, ok, namespace, name)
+	result, err := verber.Get(request.Request.Context(), kind, ok, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1839,14 +2000,83 @@ func (apiHandler *APIHandler) handlePutResource(
 		return
 	}
 
-	if err := verber.Put(kind, ok, namespace, name, putSpec); err != nil {
+	dryRun, err := parseBoolQueryParameter(request, "dryRun")
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := verber.Put(request.Request.Context(), kind, ok, namespace, name, putSpec, clientapi.PutOptions{DryRun: dryRun})
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	// Dry run doesn't store anything, so the object as it would be stored is returned for review.
+	if dryRun {
+		response.WriteHeaderAndEntity(http.StatusOK, result)
+		return
+	}
+
 	response.WriteHeader(http.StatusCreated)
 }
 
+func (apiHandler *APIHandler) handlePatchResource(
+	request *restful.Request, response *restful.Response) {
+	config, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	verber, err := apiHandler.cManager.VerberClient(request, config)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	// The patch type is selected by the content type, the same way as in the apiserver.
+	contentType, _, err := mime.ParseMediaType(request.HeaderParameter("Content-Type"))
+	if err != nil {
+		errors.HandleInternalError(response, errors.NewBadRequest(err.Error()))
+		return
+	}
+
+	data, err := ioutil.ReadAll(request.Request.Body)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	force, err := parseBoolQueryParameter(request, "force")
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	dryRun, err := parseBoolQueryParameter(request, "dryRun")
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace, ok := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	options := clientapi.PatchOptions{
+		FieldManager: request.QueryParameter("fieldManager"),
+		Force:        force,
+		DryRun:       dryRun,
+	}
+	result, err := verber.Patch(request.Request.Context(), kind, ok, namespace, name, k8stypes.PatchType(contentType), data, options)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
 func (apiHandler *APIHandler) handleDeleteResource(
 	request *restful.Request, response *restful.Response) {
 	config, err := apiHandler.cManager.Config(request)
@@ -1865,7 +2095,7 @@ func (apiHandler *APIHandler) handleDeleteResource(
 	namespace, ok := request.PathParameters()["namespace"]
 	name := request.PathParameter("name")
 
-	if err := verber.Delete(kind, ok, namespace, name); err != nil {
+	if err := verber.Delete(request.Request.Context(), kind, ok, namespace, name, clientapi.DeleteOptions{}); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
@@ -1882,7 +2112,7 @@ func (apiHandler *APIHandler) handleDeleteResource(
 		errors.HandleInternalError(response, err)
 		return
 	}
-	if err = apiHandler.sManager.DeletePinnedResource(k8sClient, pinnedResource); err != nil {
+	if err = apiHandler.sManager.DeletePinnedResource(request.Request.Context(), k8sClient, pinnedResource); err != nil {
 		if !errors.IsNotFoundError(err) {
 			log.Printf("error while unpinning resource: %s", err.Error())
 		}
@@ -1902,7 +2132,7 @@ func (apiHandler *APIHandler) handleGetReplicationControllerPods(request *restfu
 	rc := request.PathParameter("replicationController")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicationcontroller.GetReplicationControllerPods(k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, rc, namespace)
+	result, err := replicationcontroller.GetReplicationControllerPods(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, rc, namespace)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1922,7 +2152,7 @@ func (apiHandler *APIHandler) handleCreateNamespace(request *restful.Request, re
 		errors.HandleInternalError(response, err)
 		return
 	}
-	if err := ns.CreateNamespace(namespaceSpec, k8sClient); err != nil {
+	if err := ns.CreateNamespace(request.Request.Context(), namespaceSpec, k8sClient); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
@@ -1937,7 +2167,7 @@ func (apiHandler *APIHandler) handleGetNamespaces(request *restful.Request, resp
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := ns.GetNamespaceList(k8sClient, dataSelect)
+	result, err := ns.GetNamespaceList(request.Request.Context(), k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1953,7 +2183,7 @@ func (apiHandler *APIHandler) handleGetNamespaceDetail(request *restful.Request,
 	}
 
 	name := request.PathParameter("name")
-	result, err := ns.GetNamespaceDetail(k8sClient, name)
+	result, err := ns.GetNamespaceDetail(request.Request.Context(), k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1970,7 +2200,7 @@ func (apiHandler *APIHandler) handleGetNamespaceEvents(request *restful.Request,
 
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetNamespaceEvents(k8sClient, dataSelect, name)
+	result, err := event.GetNamespaceEvents(request.Request.Context(), k8sClient, dataSelect, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1987,7 +2217,7 @@ func (apiHandler *APIHandler) handleGetEventList(request *restful.Request, respo
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	namespace := parseNamespacePathParameter(request)
-	result, err := event.GetEventList(k8sClient, namespace, dataSelect)
+	result, err := event.GetEventList(request.Request.Context(), k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2007,7 +2237,7 @@ func (apiHandler *APIHandler) handleCreateImagePullSecret(request *restful.Reque
 		errors.HandleInternalError(response, err)
 		return
 	}
-	result, err := secret.CreateSecret(k8sClient, spec)
+	result, err := secret.CreateSecret(request.Request.Context(), k8sClient, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2024,7 +2254,7 @@ func (apiHandler *APIHandler) handleGetSecretDetail(request *restful.Request, re
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := secret.GetSecretDetail(k8sClient, namespace, name)
+	result, err := secret.GetSecretDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2041,7 +2271,7 @@ func (apiHandler *APIHandler) handleGetSecretList(request *restful.Request, resp
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	namespace := parseNamespacePathParameter(request)
-	result, err := secret.GetSecretList(k8sClient, namespace, dataSelect)
+	result, err := secret.GetSecretList(request.Request.Context(), k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2058,7 +2288,7 @@ func (apiHandler *APIHandler) handleGetConfigMapList(request *restful.Request, r
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := configmap.GetConfigMapList(k8sClient, namespace, dataSelect)
+	result, err := configmap.GetConfigMapList(request.Request.Context(), k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2075,7 +2305,7 @@ func (apiHandler *APIHandler) handleGetConfigMapDetail(request *restful.Request,
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("configmap")
-	result, err := configmap.GetConfigMapDetail(k8sClient, namespace, name)
+	result, err := configmap.GetConfigMapDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2091,7 +2321,7 @@ func (apiHandler *APIHandler) handleGetPersistentVolumeList(request *restful.Req
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := persistentvolume.GetPersistentVolumeList(k8sClient, dataSelect)
+	result, err := persistentvolume.GetPersistentVolumeList(request.Request.Context(), k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2107,7 +2337,7 @@ func (apiHandler *APIHandler) handleGetPersistentVolumeDetail(request *restful.R
 	}
 
 	name := request.PathParameter("persistentvolume")
-	result, err := persistentvolume.GetPersistentVolumeDetail(k8sClient, name)
+	result, err := persistentvolume.GetPersistentVolumeDetail(request.Request.Context(), k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2124,7 +2354,7 @@ func (apiHandler *APIHandler) handleGetPersistentVolumeClaimList(request *restfu
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := persistentvolumeclaim.GetPersistentVolumeClaimList(k8sClient, namespace, dataSelect)
+	result, err := persistentvolumeclaim.GetPersistentVolumeClaimList(request.Request.Context(), k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2141,7 +2371,7 @@ func (apiHandler *APIHandler) handleGetPersistentVolumeClaimDetail(request *rest
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := persistentvolumeclaim.GetPersistentVolumeClaimDetail(k8sClient, namespace, name)
+	result, err := persistentvolumeclaim.GetPersistentVolumeClaimDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2158,7 +2388,7 @@ func (apiHandler *APIHandler) handleGetPodContainers(request *restful.Request, r
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("pod")
-	result, err := container.GetPodContainers(k8sClient, namespace, name)
+	result, err := container.GetPodContainers(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2176,7 +2406,7 @@ func (apiHandler *APIHandler) handleGetReplicationControllerEvents(request *rest
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("replicationController")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := event.GetResourceEvents(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2195,7 +2425,7 @@ func (apiHandler *APIHandler) handleGetReplicationControllerServices(request *re
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("replicationController")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := replicationcontroller.GetReplicationControllerServices(k8sClient, dataSelect, namespace, name)
+	result, err := replicationcontroller.GetReplicationControllerServices(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2213,7 +2443,7 @@ func (apiHandler *APIHandler) handleGetDaemonSetList(request *restful.Request, r
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := daemonset.GetDaemonSetList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := daemonset.GetDaemonSetList(request.Request.Context(), k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2231,7 +2461,7 @@ func (apiHandler *APIHandler) handleGetDaemonSetDetail(
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("daemonSet")
-	result, err := daemonset.GetDaemonSetDetail(k8sClient, apiHandler.iManager.Metric().Client(), namespace, name)
+	result, err := daemonset.GetDaemonSetDetail(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2250,7 +2480,7 @@ func (apiHandler *APIHandler) handleGetDaemonSetPods(request *restful.Request, r
 	name := request.PathParameter("daemonSet")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := daemonset.GetDaemonSetPods(k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, name, namespace)
+	result, err := daemonset.GetDaemonSetPods(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, name, namespace)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2268,7 +2498,7 @@ func (apiHandler *APIHandler) handleGetDaemonSetServices(request *restful.Reques
 	namespace := request.PathParameter("namespace")
 	daemonSet := request.PathParameter("daemonSet")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := daemonset.GetDaemonSetServices(k8sClient, dataSelect, namespace, daemonSet)
+	result, err := daemonset.GetDaemonSetServices(request.Request.Context(), k8sClient, dataSelect, namespace, daemonSet)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2286,7 +2516,7 @@ func (apiHandler *APIHandler) handleGetDaemonSetEvents(request *restful.Request,
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("daemonSet")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := event.GetResourceEvents(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2304,7 +2534,7 @@ func (apiHandler *APIHandler) handleGetHorizontalPodAutoscalerList(request *rest
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerList(k8sClient, namespace, dataSelect)
+	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerList(request.Request.Context(), k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2323,7 +2553,7 @@ func (apiHandler *APIHandler) handleGetHorizontalPodAutoscalerListForResource(re
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
 	kind := request.PathParameter("kind")
-	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerListForResource(k8sClient, namespace, kind, name)
+	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerListForResource(request.Request.Context(), k8sClient, namespace, kind, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2340,7 +2570,7 @@ func (apiHandler *APIHandler) handleGetHorizontalPodAutoscalerDetail(request *re
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("horizontalpodautoscaler")
-	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerDetail(k8sClient, namespace, name)
+	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2358,7 +2588,7 @@ func (apiHandler *APIHandler) handleGetJobList(request *restful.Request, respons
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := job.GetJobList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := job.GetJobList(request.Request.Context(), k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2375,7 +2605,7 @@ func (apiHandler *APIHandler) handleGetJobDetail(request *restful.Request, respo
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := job.GetJobDetail(k8sClient, namespace, name)
+	result, err := job.GetJobDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2394,7 +2624,7 @@ func (apiHandler *APIHandler) handleGetJobPods(request *restful.Request, respons
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := job.GetJobPods(k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, namespace, name)
+	result, err := job.GetJobPods(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2412,7 +2642,7 @@ func (apiHandler *APIHandler) handleGetJobEvents(request *restful.Request, respo
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := job.GetJobEvents(k8sClient, dataSelect, namespace, name)
+	result, err := job.GetJobEvents(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2430,7 +2660,7 @@ func (apiHandler *APIHandler) handleGetCronJobList(request *restful.Request, res
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := cronjob.GetCronJobList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := cronjob.GetCronJobList(request.Request.Context(), k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2447,7 +2677,7 @@ func (apiHandler *APIHandler) handleGetCronJobDetail(request *restful.Request, r
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := cronjob.GetCronJobDetail(k8sClient, namespace, name)
+	result, err := cronjob.GetCronJobDetail(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2470,7 +2700,7 @@ func (apiHandler *APIHandler) handleGetCronJobJobs(request *restful.Request, res
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := cronjob.GetCronJobJobs(k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, namespace, name, active)
+	result, err := cronjob.GetCronJobJobs(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, namespace, name, active)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2488,7 +2718,7 @@ func (apiHandler *APIHandler) handleGetCronJobEvents(request *restful.Request, r
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := cronjob.GetCronJobEvents(k8sClient, dataSelect, namespace, name)
+	result, err := cronjob.GetCronJobEvents(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2505,7 +2735,7 @@ func (apiHandler *APIHandler) handleTriggerCronJob(request *restful.Request, res
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	err = cronjob.TriggerCronJob(k8sClient, namespace, name)
+	err = cronjob.TriggerCronJob(request.Request.Context(), k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2521,7 +2751,7 @@ func (apiHandler *APIHandler) handleGetStorageClassList(request *restful.Request
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := storageclass.GetStorageClassList(k8sClient, dataSelect)
+	result, err := storageclass.GetStorageClassList(request.Request.Context(), k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2537,7 +2767,7 @@ func (apiHandler *APIHandler) handleGetStorageClass(request *restful.Request, re
 	}
 
 	name := request.PathParameter("storageclass")
-	result, err := storageclass.GetStorageClass(k8sClient, name)
+	result, err := storageclass.GetStorageClass(request.Request.Context(), k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2555,7 +2785,7 @@ func (apiHandler *APIHandler) handleGetStorageClassPersistentVolumes(request *re
 
 	name := request.PathParameter("storageclass")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := persistentvolume.GetStorageClassPersistentVolumes(k8sClient,
+	result, err := persistentvolume.GetStorageClassPersistentVolumes(request.Request.Context(), k8sClient,
 		name, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2572,7 +2802,7 @@ func (apiHandler *APIHandler) handleGetIngressClassList(request *restful.Request
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := ingressclass.GetIngressClassList(k8sClient, dataSelect)
+	result, err := ingressclass.GetIngressClassList(request.Request.Context(), k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2588,7 +2818,25 @@ func (apiHandler *APIHandler) handleGetIngressClass(request *restful.Request, re
 	}
 
 	name := request.PathParameter("ingressclass")
-	result, err := ingressclass.GetIngressClass(k8sClient, name)
+	result, err := ingressclass.GetIngressClass(request.Request.Context(), k8sClient, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetWorkloads(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := workload.GetWorkloads(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2596,6 +2844,116 @@ func (apiHandler *APIHandler) handleGetIngressClass(request *restful.Request, re
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (apiHandler *APIHandler) handleGetOverview(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := overview.GetOverview(request.Request.Context(), k8sClient, apiHandler.iManager.Metric().Client(), namespace, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleSearch(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	config, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	apiextensionsclient, err := apiHandler.cManager.APIExtensionsClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	result, err := search.Search(request.Request.Context(), k8sClient, apiextensionsclient, config, namespace, request.QueryParameter("q"))
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleExport(request *restful.Request, response *restful.Response) {
+	format := export.FormatYAML
+	if value := request.QueryParameter("format"); len(value) > 0 {
+		format = export.Format(value)
+	}
+
+	if err := format.Validate(); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	config, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	verber, err := apiHandler.cManager.VerberClient(request, config)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kinds := make([]string, 0)
+	for _, kind := range strings.Split(request.QueryParameter("kind"), ",") {
+		if kind = strings.TrimSpace(kind); len(kind) > 0 {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	namespace := request.PathParameter("namespace")
+	bundle, err := export.Export(request.Request.Context(), verber, kinds, namespace)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	out, err := bundle.Marshal(format)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	// Kinds that could not be listed and protected resources that were left out are reported as warnings, so that
+	// the rest of the bundle can be downloaded.
+	for _, err := range bundle.Errors {
+		response.AddHeader("Warning", fmt.Sprintf("299 - %q", err.Error()))
+	}
+
+	contentType := restful.MIME_JSON
+	if format == export.FormatYAML {
+		contentType = exportYAMLContentType
+	}
+	filename := "export"
+	if len(namespace) > 0 {
+		filename = namespace
+	}
+
+	response.AddHeader("Content-Type", contentType)
+	response.AddHeader("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+"."+string(format)))
+	response.WriteHeader(http.StatusOK)
+	response.Write(out)
+}
+
 func (apiHandler *APIHandler) handleGetPodPersistentVolumeClaims(request *restful.Request,
 	response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
@@ -2607,7 +2965,7 @@ func (apiHandler *APIHandler) handleGetPodPersistentVolumeClaims(request *restfu
 	name := request.PathParameter("pod")
 	namespace := request.PathParameter("namespace")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := persistentvolumeclaim.GetPodPersistentVolumeClaims(k8sClient,
+	result, err := persistentvolumeclaim.GetPodPersistentVolumeClaims(request.Request.Context(), k8sClient,
 		namespace, name, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2624,7 +2982,7 @@ func (apiHandler *APIHandler) handleGetCustomResourceDefinitionList(request *res
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := customresourcedefinition.GetCustomResourceDefinitionList(apiextensionsclient, dataSelect)
+	result, err := customresourcedefinition.GetCustomResourceDefinitionList(request.Request.Context(), apiextensionsclient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2647,7 +3005,7 @@ func (apiHandler *APIHandler) handleGetCustomResourceDefinitionDetail(request *r
 	}
 
 	name := request.PathParameter("crd")
-	result, err := customresourcedefinition.GetCustomResourceDefinitionDetail(apiextensionsclient, config, name)
+	result, err := customresourcedefinition.GetCustomResourceDefinitionDetail(request.Request.Context(), apiextensionsclient, config, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2672,7 +3030,7 @@ func (apiHandler *APIHandler) handleGetCustomResourceObjectList(request *restful
 	crdName := request.PathParameter("crd")
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := customresourcedefinition.GetCustomResourceObjectList(apiextensionsclient, config, namespace, dataSelect, crdName)
+	result, err := customresourcedefinition.GetCustomResourceObjectList(request.Request.Context(), apiextensionsclient, config, namespace, dataSelect, crdName)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2697,7 +3055,7 @@ func (apiHandler *APIHandler) handleGetCustomResourceObjectDetail(request *restf
 	name := request.PathParameter("object")
 	crdName := request.PathParameter("crd")
 	namespace := parseNamespacePathParameter(request)
-	result, err := customresourcedefinition.GetCustomResourceObjectDetail(apiextensionsclient, namespace, config, crdName, name)
+	result, err := customresourcedefinition.GetCustomResourceObjectDetail(request.Request.Context(), apiextensionsclient, namespace, config, crdName, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2719,7 +3077,7 @@ func (apiHandler *APIHandler) handleGetCustomResourceObjectEvents(request *restf
 	namespace := request.PathParameter("namespace")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := customresourcedefinition.GetEventsForCustomResourceObject(k8sClient, dataSelect, namespace, name)
+	result, err := customresourcedefinition.GetEventsForCustomResourceObject(request.Request.Context(), k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2738,7 +3096,7 @@ func (apiHandler *APIHandler) handleLogSource(request *restful.Request, response
 	resourceName := request.PathParameter("resourceName")
 	resourceType := request.PathParameter("resourceType")
 	namespace := request.PathParameter("namespace")
-	logSources, err := logs.GetLogSources(k8sClient, namespace, resourceName, resourceType)
+	logSources, err := logs.GetLogSources(request.Request.Context(), k8sClient, namespace, resourceName, resourceType)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2784,7 +3142,7 @@ func (apiHandler *APIHandler) handleLogs(request *restful.Request, response *res
 		}
 	}
 
-	result, err := container.GetLogDetails(k8sClient, namespace, podID, containerID, logSelector, usePreviousLogs)
+	result, err := container.GetLogDetails(request.Request.Context(), k8sClient, namespace, podID, containerID, logSelector, usePreviousLogs)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2806,7 +3164,7 @@ func (apiHandler *APIHandler) handleLogFile(request *restful.Request, response *
 	opts.Previous = request.QueryParameter("previous") == "true"
 	opts.Timestamps = request.QueryParameter("timestamps") == "true"
 
-	logStream, err := container.GetLogFile(k8sClient, namespace, podID, containerID, opts)
+	logStream, err := container.GetLogFile(request.Request.Context(), k8sClient, namespace, podID, containerID, opts)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2829,3 +3187,17 @@ func parseNamespacePathParameter(request *restful.Request) *common.NamespaceQuer
 	}
 	return common.NewNamespaceQuery(nonEmptyNamespaces)
 }
+
+// parseBoolQueryParameter returns false when the query parameter is not set.
+func parseBoolQueryParameter(request *restful.Request, name string) (bool, error) {
+	value := request.QueryParameter(name)
+	if len(value) == 0 {
+		return false, nil
+	}
+
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.NewBadRequest(fmt.Sprintf("invalid value of %s query parameter: %s", name, value))
+	}
+	return result, nil
+}
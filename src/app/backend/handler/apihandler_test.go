@@ -15,9 +15,13 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
+	"time"
 
 	"bytes"
 	"reflect"
@@ -25,14 +29,20 @@ import (
 
 	restful "github.com/emicklei/go-restful/v3"
 	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/audit"
+	auditApi "github.com/kubernetes/dashboard/src/app/backend/audit/api"
 	"github.com/kubernetes/dashboard/src/app/backend/auth"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	"github.com/kubernetes/dashboard/src/app/backend/auth/jwe"
 	"github.com/kubernetes/dashboard/src/app/backend/client"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/logging"
 	"github.com/kubernetes/dashboard/src/app/backend/settings"
 	"github.com/kubernetes/dashboard/src/app/backend/sync"
 	"github.com/kubernetes/dashboard/src/app/backend/systembanner"
 	"k8s.io/client-go/kubernetes/fake"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 func getTokenManager() authApi.TokenManager {
@@ -44,10 +54,11 @@ func getTokenManager() authApi.TokenManager {
 
 func TestCreateHTTPAPIHandler(t *testing.T) {
 	cManager := client.NewClientManager("", "http://localhost:8080")
-	authManager := auth.NewAuthManager(cManager, getTokenManager(), authApi.AuthenticationModes{}, true)
+	authManager := auth.NewAuthManager(cManager, getTokenManager(), authApi.AuthenticationModes{}, true, nil)
 	sManager := settings.NewSettingsManager()
 	sbManager := systembanner.NewSystemBannerManager("Hello world!", "INFO")
-	_, err := CreateHTTPAPIHandler(nil, cManager, authManager, sManager, sbManager)
+	_, err := CreateHTTPAPIHandler(nil, cManager, authManager, sManager, sbManager,
+		audit.NewAuditManager())
 	if err != nil {
 		t.Fatal("CreateHTTPAPIHandler() cannot create HTTP API handler")
 	}
@@ -147,6 +158,13 @@ func TestFormatRequestLog(t *testing.T) {
 			"Incoming HTTP/1.1 POST /api/v1/login request from : {\"password\":\"abc123\"}",
 			"DEBUG",
 		},
+		{
+			"GET",
+			"/api/v1/login/oidc/callback?code=abc123&state=def456",
+			map[string]string{},
+			"Incoming HTTP/1.1 GET /api/v1/login/oidc/callback request",
+			"DEFAULT",
+		},
 	}
 
 	for _, c := range cases {
@@ -171,3 +189,208 @@ func TestFormatRequestLog(t *testing.T) {
 		}
 	}
 }
+
+func TestAuditFilter(t *testing.T) {
+	cases := []struct {
+		method, path, contentType, body string
+		expected                        []auditApi.Entry
+	}{
+		{
+			http.MethodPatch, "/api/v1/_raw/deployment/namespace/default/name/web",
+			"application/merge-patch+json", `{"spec":{"replicas":3}}`,
+			[]auditApi.Entry{{Verb: http.MethodPatch, Cluster: "production",
+				Path: "/api/v1/_raw/deployment/namespace/default/name/web", Kind: "deployment", Namespace: "default",
+				Name: "web", Summary: "spec.replicas", StatusCode: http.StatusOK, SourceIP: "192.0.2.1:1234"}},
+		},
+		{
+			http.MethodPut, "/api/v1/deployment/default/web/restart", "application/json", "",
+			[]auditApi.Entry{{Verb: http.MethodPut, Cluster: "production",
+				Path: "/api/v1/deployment/default/web/restart", Kind: "deployment", Namespace: "default", Name: "web",
+				StatusCode: http.StatusOK, SourceIP: "192.0.2.1:1234"}},
+		},
+		{
+			http.MethodPut, "/api/v1/clusters/staging/deployment/default/web/restart", "application/json", "",
+			[]auditApi.Entry{{Verb: http.MethodPut, Cluster: "staging",
+				Path: "/api/v1/deployment/default/web/restart", Kind: "deployment", Namespace: "default", Name: "web",
+				StatusCode: http.StatusOK, SourceIP: "192.0.2.1:1234"}},
+		},
+		{
+			http.MethodPost, "/api/v1/pod/default/web/container/app", "application/json", "",
+			[]auditApi.Entry{{Verb: http.MethodPost, Cluster: "production",
+				Path: "/api/v1/pod/default/web/container/app", Kind: "pod", Namespace: "default", Name: "web",
+				StatusCode: http.StatusOK, SourceIP: "192.0.2.1:1234"}},
+		},
+		{
+			http.MethodPost, "/api/v1/appdeployment/validate/name", "application/json", `{"name":"web"}`,
+			[]auditApi.Entry{},
+		},
+		{
+			http.MethodGet, "/api/v1/pod", "", "",
+			[]auditApi.Entry{},
+		},
+	}
+
+	for _, c := range cases {
+		manager := audit.NewAuditManager()
+		cManager := client.NewClientManager("", "http://localhost:8080")
+		router := NewClusterRouter()
+		for _, name := range []string{"production", "staging"} {
+			ws := new(restful.WebService)
+			ws.Path("/api/v1")
+			ws.Filter(auditFilter(manager, audit.NewUserResolver(cManager, time.Minute)))
+			handle := func(request *restful.Request, response *restful.Response) {
+				response.WriteHeader(http.StatusOK)
+			}
+			ws.Route(ws.PATCH("/_raw/{kind}/namespace/{namespace}/name/{name}").
+				Consumes("application/merge-patch+json").To(handle))
+			ws.Route(ws.PUT("/{kind}/{namespace}/{deployment}/restart").To(handle))
+			ws.Route(ws.POST("/appdeployment/validate/name").To(handle))
+			ws.Route(ws.POST("/pod/{namespace}/{pod}/container/{container}").To(handle))
+			ws.Route(ws.GET("/pod").To(handle))
+			container := restful.NewContainer()
+			container.Add(ws)
+			router.AddCluster(name, container)
+		}
+
+		req := httptest.NewRequest(c.method, c.path, strings.NewReader(c.body))
+		req.RemoteAddr = "192.0.2.1:1234"
+		if len(c.contentType) > 0 {
+			req.Header.Set("Content-Type", c.contentType)
+		}
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		actual := manager.Recent("staging", 0)
+		actual = append(actual, manager.Recent("production", 0)...)
+		for i := range actual {
+			actual[i].Timestamp = time.Time{}
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("auditFilter recorded %#v for %s %s, expected %#v", actual, c.method, c.path, c.expected)
+		}
+	}
+}
+
+// fakeAuditClientManager resolves bearer tokens of requests to user names equal to the tokens, until they are
+// revoked.
+type fakeAuditClientManager struct {
+	clientapi.ClientManager
+	revoked bool
+}
+
+func (self *fakeAuditClientManager) AuthInfo(req *restful.Request) (*clientcmdapi.AuthInfo, error) {
+	if self.revoked {
+		return nil, errors.NewUnauthorized(errors.MsgLoginUnauthorizedError)
+	}
+	return &clientcmdapi.AuthInfo{Token: req.HeaderParameter("Authorization")}, nil
+}
+
+func (self *fakeAuditClientManager) HasAccess(ctx context.Context, authInfo clientcmdapi.AuthInfo) (string, error) {
+	return authInfo.Token, nil
+}
+
+func TestAuditFilterShouldRecordUserOfLogout(t *testing.T) {
+	manager := audit.NewAuditManager()
+	cManager := &fakeAuditClientManager{}
+	ws := new(restful.WebService)
+	ws.Path("/api/v1")
+	ws.Filter(auditFilter(manager, audit.NewUserResolver(cManager, time.Minute)))
+	ws.Route(ws.POST("/logout").To(func(request *restful.Request, response *restful.Response) {
+		cManager.revoked = true
+		response.WriteHeader(http.StatusNoContent)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logout", nil)
+	req.Header.Set("Authorization", "alice")
+	container.ServeHTTP(httptest.NewRecorder(), req)
+
+	if entries := manager.Recent("", 0); len(entries) != 1 || entries[0].User != "alice" {
+		t.Errorf("auditFilter recorded %#v for logout, expected entry of user alice", entries)
+	}
+}
+
+func TestRequestAndResponseLogger(t *testing.T) {
+	out := &bytes.Buffer{}
+	if err := logging.Init(logging.FormatJSON, out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer logging.Init(logging.FormatText, os.Stdout)
+	args.GetHolderBuilder().SetAPILogLevel("INFO").SetLogFormat(string(logging.FormatJSON))
+	defer args.GetHolderBuilder().SetLogFormat("")
+
+	cases := []struct {
+		requestID string
+	}{
+		{""},
+		{"from-proxy"},
+	}
+
+	for _, c := range cases {
+		cManager := client.NewClientManager("", "http://localhost:8080")
+		out.Reset()
+		ws := new(restful.WebService)
+		ws.Path("/api/v1")
+		ws.Filter(requestAndResponseLogger(audit.NewUserResolver(cManager, time.Minute)))
+		ws.Route(ws.GET("/pod/{namespace}").To(func(request *restful.Request, response *restful.Response) {
+			response.WriteHeader(http.StatusOK)
+			response.Write([]byte("ok"))
+		}))
+		container := restful.NewContainer()
+		container.Add(ws)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pod/default", nil)
+		if len(c.requestID) > 0 {
+			req.Header.Set(requestIDHeader, c.requestID)
+		}
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, req)
+
+		requestID := recorder.Header().Get(requestIDHeader)
+		if len(requestID) == 0 || (len(c.requestID) > 0 && requestID != c.requestID) {
+			t.Errorf("Expected request ID %q in response, got %q", c.requestID, requestID)
+		}
+
+		entry := make(map[string]interface{})
+		if err := json.Unmarshal(out.Bytes(), &entry); err != nil {
+			t.Fatalf("Expected single JSON entry, got %s", out.String())
+		}
+		expected := map[string]interface{}{"msg": "request", "requestID": requestID, "method": http.MethodGet,
+			"route": "/api/v1/pod/{namespace}", "status": float64(http.StatusOK), "size": float64(2), "user": ""}
+		for key, value := range expected {
+			if entry[key] != value {
+				t.Errorf("Expected %s=%v in entry %s", key, value, out.String())
+			}
+		}
+	}
+}
+
+func TestFilterQueryFilter(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Path("/api/v1")
+	ws.Filter(filterQueryFilter)
+	ws.Route(ws.GET("/pod").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeader(http.StatusOK)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		path     string
+		expected int
+	}{
+		{"/api/v1/pod", http.StatusOK},
+		{"/api/v1/pod?filterBy=name,api", http.StatusOK},
+		{"/api/v1/pod?filterBy=restarts%3E%3D3", http.StatusOK},
+		{"/api/v1/pod?filterBy=name", http.StatusBadRequest},
+		{"/api/v1/pod?filterBy=name%3D~%5B", http.StatusBadRequest},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, c.path, nil))
+		if recorder.Code != c.expected {
+			t.Errorf("GET %s responded with %d, expected %d", c.path, recorder.Code, c.expected)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// ClusterHeader is the name of request header that selects the cluster to which API request is routed.
+	ClusterHeader = "X-Dashboard-Cluster"
+	// clusterPath lists served clusters. Its subpaths select the cluster to which API request is routed, i.e.
+	// '/api/v1/clusters/{name}/pod' is routed to '/api/v1/pod' of cluster {name}.
+	clusterPath = "/api/v1/clusters"
+)
+
+// clusterContextKey is the key of request context value, that holds the name of the cluster selected by request.
+type clusterContextKey struct{}
+
+// ClusterList is a list of clusters served by Dashboard.
+type ClusterList struct {
+	Clusters []Cluster `json:"clusters"`
+}
+
+// Cluster is a cluster served by Dashboard.
+type Cluster struct {
+	// Name used to select the cluster in API requests.
+	Name string `json:"name"`
+	// Default is true for the cluster that serves requests, that do not select a cluster.
+	Default bool `json:"default"`
+}
+
+// ClusterRouter routes API requests to handlers of clusters selected by path prefix or ClusterHeader. Path prefix
+// takes precedence over the header. Requests that do not select a cluster are routed to the default one.
+type ClusterRouter struct {
+	names    []string
+	handlers map[string]http.Handler
+}
+
+// AddCluster registers API handler of given cluster. The first added cluster is the default one.
+func (self *ClusterRouter) AddCluster(name string, handler http.Handler) {
+	self.names = append(self.names, name)
+	self.handlers[name] = handler
+}
+
+// ServeHTTP implements http.Handler. It serves the list of clusters and passes other requests to the handler of
+// selected cluster.
+func (self *ClusterRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.TrimSuffix(r.URL.Path, "/") == clusterPath {
+		self.handleGetClusters(w, r)
+		return
+	}
+
+	name, r := self.route(r)
+	handler, exists := self.handlers[name]
+	if !exists {
+		http.Error(w, fmt.Sprintf("cluster %s not found", name), http.StatusNotFound)
+		return
+	}
+
+	handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clusterContextKey{}, name)))
+}
+
+func (self *ClusterRouter) handleGetClusters(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	list := ClusterList{Clusters: make([]Cluster, 0, len(self.names))}
+	for i, name := range self.names {
+		list.Clusters = append(list.Clusters, Cluster{Name: name, Default: i == 0})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(list)
+}
+
+// route returns name of the cluster selected by request and the request that should be passed to its handler.
+func (self *ClusterRouter) route(r *http.Request) (string, *http.Request) {
+	if strings.HasPrefix(r.URL.Path, clusterPath+"/") {
+		parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, clusterPath+"/"), "/", 2)
+		path := "/api/v1/"
+		if len(parts) == 2 {
+			path += parts[1]
+		}
+
+		routed := r.Clone(r.Context())
+		routed.URL.Path = path
+		routed.URL.RawPath = ""
+		return parts[0], routed
+	}
+
+	if name := r.Header.Get(ClusterHeader); len(name) > 0 {
+		return name, r
+	}
+
+	if len(self.names) == 0 {
+		return "", r
+	}
+
+	return self.names[0], r
+}
+
+// GetCluster returns name of the cluster to which the request was routed by ClusterRouter. The path prefix that
+// selected the cluster is stripped by then, so it can not be taken from the request path.
+func GetCluster(r *http.Request) string {
+	name, _ := r.Context().Value(clusterContextKey{}).(string)
+	return name
+}
+
+// NewClusterRouter creates router without any clusters.
+func NewClusterRouter() *ClusterRouter {
+	return &ClusterRouter{handlers: make(map[string]http.Handler)}
+}
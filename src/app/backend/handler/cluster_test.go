@@ -0,0 +1,87 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClusterRouter(t *testing.T) {
+	router := NewClusterRouter()
+	for _, name := range []string{"production", "staging"} {
+		cluster := name
+		router.AddCluster(cluster, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(cluster + " " + r.URL.Path))
+		}))
+	}
+
+	cases := []struct {
+		path     string
+		header   string
+		code     int
+		expected string
+	}{
+		{"/api/v1/pod", "", http.StatusOK, "production /api/v1/pod"},
+		{"/api/v1/pod", "staging", http.StatusOK, "staging /api/v1/pod"},
+		{"/api/v1/clusters/staging/pod/default", "", http.StatusOK, "staging /api/v1/pod/default"},
+		{"/api/v1/clusters/production/pod", "staging", http.StatusOK, "production /api/v1/pod"},
+		{"/api/v1/clusters/staging", "", http.StatusOK, "staging /api/v1/"},
+		{"/api/v1/clusters/test/pod", "", http.StatusNotFound, "cluster test not found\n"},
+		{"/api/v1/pod", "test", http.StatusNotFound, "cluster test not found\n"},
+	}
+
+	for _, c := range cases {
+		req := httptest.NewRequest(http.MethodGet, c.path, nil)
+		if len(c.header) > 0 {
+			req.Header.Set(ClusterHeader, c.header)
+		}
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		if recorder.Code != c.code || recorder.Body.String() != c.expected {
+			t.Errorf("ServeHTTP(%s, %s): Expected %d %q, got %d %q", c.path, c.header, c.code, c.expected,
+				recorder.Code, recorder.Body.String())
+		}
+	}
+}
+
+func TestClusterRouterList(t *testing.T) {
+	router := NewClusterRouter()
+	router.AddCluster("production", http.NotFoundHandler())
+	router.AddCluster("staging", http.NotFoundHandler())
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/clusters", nil))
+
+	list := ClusterList{}
+	if err := json.NewDecoder(recorder.Body).Decode(&list); err != nil {
+		t.Fatalf("Expected cluster list, got error: %s", err)
+	}
+
+	expected := ClusterList{Clusters: []Cluster{{Name: "production", Default: true}, {Name: "staging"}}}
+	if !reflect.DeepEqual(list, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, list)
+	}
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/clusters", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status %d for POST request, got %d", http.StatusMethodNotAllowed, recorder.Code)
+	}
+}
@@ -19,32 +19,84 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"mime"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/emicklei/go-restful/v3"
 	"golang.org/x/net/xsrftoken"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/uuid"
 
 	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/audit"
+	auditApi "github.com/kubernetes/dashboard/src/app/backend/audit/api"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/handler/parser"
+	"github.com/kubernetes/dashboard/src/app/backend/logging"
 )
 
 const (
 	originalForwardedForHeader = "X-Original-Forwarded-For"
 	forwardedForHeader         = "X-Forwarded-For"
 	realIPHeader               = "X-Real-Ip"
+	requestIDHeader            = "X-Request-Id"
+
+	// requestIDAttribute is the request attribute, that holds the ID of the request.
+	requestIDAttribute = "requestID"
+
+	// maxRequestIDLength is the maximum length of request IDs accepted from clients.
+	maxRequestIDLength = 128
+
+	// oidcCallbackURL is the route to which oidc issuer redirects user with authorization code after login.
+	oidcCallbackURL = "/api/v1/login/oidc/callback"
+)
+
+// nonMutatingRoutes are routes that accept non-GET requests without changing any resources.
+var nonMutatingRoutes = []string{
+	"/api/v1/appdeployment/validate/",
+	"/api/v1/appdeploymentfromfile/diff",
+	"/api/v1/login",
+	"/api/v1/token/refresh",
+}
+
+// sharedRateLimiter is used by web services of all clusters, so that clients get a single budget no matter how many
+// clusters are served.
+var (
+	sharedRateLimiter     *rateLimiter
+	sharedRateLimiterOnce sync.Once
 )
 
 // InstallFilters installs defined filter for given web service
-func InstallFilters(ws *restful.WebService, manager clientapi.ClientManager) {
-	ws.Filter(requestAndResponseLogger)
+func InstallFilters(ws *restful.WebService, manager clientapi.ClientManager, auditManager auditApi.AuditManager) {
+	resolver := audit.NewUserResolver(manager, audit.DefaultUserCacheTTL)
+	sharedRateLimiterOnce.Do(func() {
+		// Trusted proxies are validated when Dashboard starts.
+		trustedProxies, _ := ParseTrustedProxies(args.Holder.GetRateLimitTrustedProxies())
+		sharedRateLimiter = newRateLimiter(RateLimits{
+			Read:           args.Holder.GetRateLimitRead(),
+			Write:          args.Holder.GetRateLimitWrite(),
+			Exec:           args.Holder.GetRateLimitExec(),
+			Logs:           args.Holder.GetRateLimitLogs(),
+			Burst:          args.Holder.GetRateLimitBurst(),
+			TrustedProxies: trustedProxies,
+		})
+	})
+	ws.Filter(requestAndResponseLogger(resolver))
+	ws.Filter(rateLimitFilter(sharedRateLimiter, resolver))
+	ws.Filter(requestTimeoutFilter(NewRequestTimeouts(args.Holder.GetRequestTimeout(),
+		args.Holder.GetRouteTimeouts())))
+	if auditManager != nil {
+		ws.Filter(auditFilter(auditManager, resolver))
+	}
 	ws.Filter(metricsFilter)
 	ws.Filter(validateXSRFFilter(manager.CSRFKey()))
 	ws.Filter(restrictedResourcesFilter)
+	ws.Filter(filterQueryFilter)
 }
 
 // Filter used to restrict access to dashboard exclusive resource, i.e. secret used to store dashboard encryption key.
@@ -58,18 +110,73 @@ func restrictedResourcesFilter(request *restful.Request, response *restful.Respo
 	response.WriteHeaderAndEntity(int(err.ErrStatus.Code), err.Error())
 }
 
-// web-service filter function used for request and response logging.
-func requestAndResponseLogger(request *restful.Request, response *restful.Response,
-	chain *restful.FilterChain) {
-	if args.Holder.GetAPILogLevel() != "NONE" {
-		log.Printf(formatRequestLog(request))
+// Filter used to reject requests with filterBy query parameter that can not be parsed. Lists would be returned
+// unfiltered otherwise.
+func filterQueryFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	if _, err := parser.ParseFilterQuery(request.QueryParameter("filterBy")); err != nil {
+		errors.HandleInternalError(response, errors.NewBadRequest(fmt.Sprintf("Invalid filterBy parameter: %s",
+			err.Error())))
+		return
 	}
 
 	chain.ProcessFilter(request, response)
+}
+
+// web-service filter function used for request and response logging. Every request gets an ID, which is
+// returned in the X-Request-Id header. In JSON log format a single entry is written after the response.
+func requestAndResponseLogger(resolver *audit.UserResolver) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		requestID := getRequestID(request)
+		request.SetAttribute(requestIDAttribute, requestID)
+		response.AddHeader(requestIDHeader, requestID)
+
+		jsonFormat := logging.Format(args.Holder.GetLogFormat()) == logging.FormatJSON
+		start := time.Now()
+		if args.Holder.GetAPILogLevel() != "NONE" && !jsonFormat {
+			log.Printf(formatRequestLog(request))
+		}
 
-	if args.Holder.GetAPILogLevel() != "NONE" {
-		log.Printf(formatResponseLog(response, request))
+		chain.ProcessFilter(request, response)
+
+		if args.Holder.GetAPILogLevel() == "NONE" {
+			return
+		}
+		if !jsonFormat {
+			log.Printf(formatResponseLog(response, request))
+			return
+		}
+
+		fields := logging.Fields{
+			"requestID":  requestID,
+			"method":     request.Request.Method,
+			"route":      request.SelectedRoutePath(),
+			"status":     response.StatusCode(),
+			"latencyMs":  time.Since(start).Milliseconds(),
+			"size":       response.ContentLength(),
+			"remoteAddr": getRemoteAddr(request.Request),
+			"user":       getCachedUsername(resolver, request),
+		}
+		if args.Holder.GetAPILogLevel() == "DEBUG" {
+			fields["uri"] = request.Request.URL.RequestURI()
+		}
+		logging.Log("request", fields)
+	}
+}
+
+// getCachedUsername returns name of the user resolved by rate limit or audit filter. Throttled requests are not
+// resolved, so logging them does not send requests to the apiserver.
+func getCachedUsername(resolver *audit.UserResolver, request *restful.Request) string {
+	name, _ := resolver.CachedUsername(request)
+	return name
+}
+
+// getRequestID returns the ID of the request sent by a proxy in front of Dashboard or generates a new one.
+func getRequestID(request *restful.Request) string {
+	if requestID := strings.TrimSpace(request.HeaderParameter(requestIDHeader)); len(requestID) > 0 &&
+		len(requestID) <= maxRequestIDLength {
+		return requestID
 	}
+	return string(uuid.NewUUID())
 }
 
 // formatRequestLog formats request log string.
@@ -79,16 +186,17 @@ func formatRequestLog(request *restful.Request) string {
 
 	if request.Request.URL != nil {
 		uri = request.Request.URL.RequestURI()
+		// Query of oidc login callback contains authorization code.
+		if request.Request.URL.Path == oidcCallbackURL {
+			uri = request.Request.URL.Path
+		}
 	}
 
-	byteArr, err := io.ReadAll(request.Request.Body)
+	byteArr, err := readRequestBody(request)
 	if err == nil {
 		content = string(byteArr)
 	}
 
-	// Restore request body so we can read it again in regular request handlers
-	request.Request.Body = io.NopCloser(bytes.NewReader(byteArr))
-
 	// Is DEBUG level logging enabled? Yes?
 	// Great now let's filter out any content from sensitive URLs
 	if args.Holder.GetAPILogLevel() != "DEBUG" && checkSensitiveURL(&uri) {
@@ -99,6 +207,14 @@ func formatRequestLog(request *restful.Request) string {
 		request.Request.Method, uri, getRemoteAddr(request.Request), content)
 }
 
+// readRequestBody reads the request body and restores it, so that it can be read again in regular request
+// handlers.
+func readRequestBody(request *restful.Request) ([]byte, error) {
+	byteArr, err := io.ReadAll(request.Request.Body)
+	request.Request.Body = io.NopCloser(bytes.NewReader(byteArr))
+	return byteArr, err
+}
+
 // formatResponseLog formats response log string.
 func formatResponseLog(response *restful.Response, request *restful.Request) string {
 	return fmt.Sprintf(ResponseLogString, time.Now().Format(time.RFC3339),
@@ -121,6 +237,84 @@ func checkSensitiveURL(url *string) bool {
 
 }
 
+// auditFilter records mutating requests together with the user that made them in the audit log.
+func auditFilter(auditManager auditApi.AuditManager, resolver *audit.UserResolver) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		if !shouldAudit(request) {
+			chain.ProcessFilter(request, response)
+			return
+		}
+
+		entry := auditApi.Entry{
+			Timestamp: time.Now(),
+			SourceIP:  getRemoteAddr(request.Request),
+			Verb:      request.Request.Method,
+			Cluster:   GetCluster(request.Request),
+			Path:      request.Request.URL.Path,
+		}
+		entry.Kind, entry.Namespace, entry.Name = getAuditTarget(request)
+		if body, err := readRequestBody(request); err == nil {
+			contentType, _, _ := mime.ParseMediaType(request.HeaderParameter("Content-Type"))
+			entry.Summary = audit.Summarize(contentType, body)
+		}
+		// User is resolved before the request is handled, because credentials can not be resolved anymore after
+		// logout revokes them.
+		entry.User = resolver.Username(request)
+
+		chain.ProcessFilter(request, response)
+
+		entry.StatusCode = response.StatusCode()
+		auditManager.Record(entry)
+	}
+}
+
+// shouldAudit returns true for requests that may change resources.
+func shouldAudit(request *restful.Request) bool {
+	switch request.Request.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return false
+	}
+
+	for _, route := range nonMutatingRoutes {
+		if strings.HasPrefix(request.SelectedRoutePath(), route) {
+			return false
+		}
+	}
+	return true
+}
+
+// getAuditTarget extracts kind, namespace and name of the resource from path parameters. Routes that do not
+// have a kind parameter are named after the resource kind, e.g. /api/v1/deployment/{namespace}/{deployment}.
+// Routes that do not have a name parameter take the name from the first other parameter of the route path, so
+// that name of the resource is taken rather than name of its part, e.g. /api/v1/pod/{namespace}/{pod}/{container}.
+func getAuditTarget(request *restful.Request) (kind, namespace, name string) {
+	params := request.PathParameters()
+	kind = params["kind"]
+	if resource := mapUrlToResource(request.SelectedRoutePath()); len(kind) == 0 && resource != nil {
+		kind = *resource
+	}
+
+	namespace = params["namespace"]
+	name = params["name"]
+	if len(name) > 0 {
+		return
+	}
+
+	for _, segment := range strings.Split(request.SelectedRoutePath(), "/") {
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+			continue
+		}
+
+		key := strings.SplitN(strings.Trim(segment, "{}"), ":", 2)[0]
+		if key != "kind" && key != "namespace" && len(params[key]) > 0 {
+			name = params[key]
+			break
+		}
+	}
+	return
+}
+
 func metricsFilter(req *restful.Request, resp *restful.Response,
 	chain *restful.FilterChain) {
 	resource := mapUrlToResource(req.SelectedRoutePath())
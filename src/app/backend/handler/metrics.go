@@ -47,6 +47,13 @@ var (
 		},
 		[]string{"verb", "resource"},
 	)
+	throttledRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dashboard_throttled_request_count",
+			Help: "Counter of requests rejected by the rate limiter broken out for each route class and client key type.",
+		},
+		[]string{"class", "keyType"},
+	)
 )
 
 // Initialize all metrics in prometheus
@@ -54,6 +61,7 @@ func init() {
 	prometheus.MustRegister(requestCounter)
 	prometheus.MustRegister(requestLatencies)
 	prometheus.MustRegister(requestLatenciesSummary)
+	prometheus.MustRegister(throttledRequestCounter)
 }
 
 // Track API call in prometheus
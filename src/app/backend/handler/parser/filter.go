@@ -0,0 +1,121 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+// filterOperatorChars are characters that operators of filter expressions are built of.
+const filterOperatorChars = "=!^~<>"
+
+// ParseFilterQuery parses filter expression and returns FilterQuery object. Expression is a comma separated list
+// of 'property<operator>value' terms, for example 'name=~^api-,status!=Running,creationTimestamp>2024-01-01'.
+// Supported operators are listed in dataselect.FilterOperators. A comma or a backslash that is part of a value
+// has to be escaped with a backslash, other backslashes are kept as they are, so regular expressions like '\d'
+// can be used directly. Legacy 'property,value' pairs are supported as well and match items whose string property
+// contains the value or whose other property, e.g. a number or a time, is equal to it.
+func ParseFilterQuery(expression string) (*dataselect.FilterQuery, error) {
+	if len(expression) == 0 {
+		return dataselect.NoFilter, nil
+	}
+
+	terms := splitFilterExpression(expression)
+	filterByList := make([]dataselect.FilterBy, 0, len(terms))
+	for i := 0; i < len(terms); i++ {
+		property, operator, value, err := parseFilterTerm(terms[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(operator) == 0 {
+			// Legacy filter, the value is the next term.
+			if i+1 >= len(terms) {
+				return nil, fmt.Errorf("missing value of filter on property %q", property)
+			}
+			i++
+			operator, value = dataselect.LegacyOperator, terms[i]
+		}
+
+		filterBy := dataselect.FilterBy{
+			Property: dataselect.PropertyName(property),
+			Operator: operator,
+			Value:    dataselect.StdComparableString(value),
+		}
+
+		if operator == dataselect.RegexOperator || operator == dataselect.NotRegexOperator {
+			re, err := dataselect.NewStdComparableRegexp(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression in filter on property %q: %s", property,
+					err.Error())
+			}
+			filterBy.Value = re
+		}
+
+		filterByList = append(filterByList, filterBy)
+	}
+
+	return &dataselect.FilterQuery{FilterByList: filterByList}, nil
+}
+
+// parseFilterTerm splits a single term of filter expression into property, operator and value. Operator is empty
+// when the term consists of the property name only, which is the case of legacy filters.
+func parseFilterTerm(term string) (string, dataselect.FilterOperator, string, error) {
+	end := strings.IndexAny(term, filterOperatorChars)
+	if end < 0 {
+		end = len(term)
+	}
+
+	property := strings.TrimSpace(term[:end])
+	if len(property) == 0 {
+		return "", "", "", fmt.Errorf("missing property name in filter %q", term)
+	}
+
+	rest := term[end:]
+	if len(rest) == 0 {
+		return property, "", "", nil
+	}
+
+	for _, operator := range dataselect.FilterOperators {
+		if strings.HasPrefix(rest, string(operator)) {
+			return property, operator, rest[len(operator):], nil
+		}
+	}
+
+	return "", "", "", fmt.Errorf("unknown operator in filter %q", term)
+}
+
+// splitFilterExpression splits filter expression into terms on commas that are not escaped with a backslash.
+func splitFilterExpression(expression string) []string {
+	terms := make([]string, 0)
+	var term strings.Builder
+	for i := 0; i < len(expression); i++ {
+		switch c := expression[i]; {
+		case c == '\\' && i+1 < len(expression) && (expression[i+1] == ',' || expression[i+1] == '\\'):
+			i++
+			term.WriteByte(expression[i])
+		case c == ',':
+			terms = append(terms, term.String())
+			term.Reset()
+		default:
+			term.WriteByte(c)
+		}
+	}
+
+	return append(terms, term.String())
+}
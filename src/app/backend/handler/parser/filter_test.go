@@ -0,0 +1,99 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+func TestParseFilterQuery(t *testing.T) {
+	cases := []struct {
+		expression string
+		expected   []dataselect.FilterBy
+		err        bool
+	}{
+		{"", []dataselect.FilterBy{}, false},
+		{
+			"name,api",
+			[]dataselect.FilterBy{{Property: "name", Operator: dataselect.LegacyOperator, Value: dataselect.StdComparableString("api")}},
+			false,
+		},
+		{
+			"status!=Running,creationTimestamp>2024-01-01,restarts>=3",
+			[]dataselect.FilterBy{
+				{Property: "status", Operator: dataselect.NotEqualOperator, Value: dataselect.StdComparableString("Running")},
+				{Property: "creationTimestamp", Operator: dataselect.GreaterOperator, Value: dataselect.StdComparableString("2024-01-01")},
+				{Property: "restarts", Operator: dataselect.GreaterOrEqualOperator, Value: dataselect.StdComparableString("3")},
+			},
+			false,
+		},
+		{
+			`name=a\,b,namespace^=kube-,name~x\\`,
+			[]dataselect.FilterBy{
+				{Property: "name", Operator: dataselect.EqualOperator, Value: dataselect.StdComparableString("a,b")},
+				{Property: "namespace", Operator: dataselect.PrefixOperator, Value: dataselect.StdComparableString("kube-")},
+				{Property: "name", Operator: dataselect.ContainsOperator, Value: dataselect.StdComparableString(`x\`)},
+			},
+			false,
+		},
+		{"name", nil, true},
+		{"=value", nil, true},
+		{"name^value", nil, true},
+		{"name=~[", nil, true},
+	}
+
+	for _, c := range cases {
+		actual, err := ParseFilterQuery(c.expression)
+		if (err != nil) != c.err {
+			t.Errorf("ParseFilterQuery(%q) returned error %v, expected error: %t", c.expression, err, c.err)
+			continue
+		}
+
+		if err == nil && !reflect.DeepEqual(actual.FilterByList, c.expected) {
+			t.Errorf("ParseFilterQuery(%q) == %#v, expected %#v", c.expression, actual.FilterByList, c.expected)
+		}
+	}
+}
+
+func TestParseFilterQueryRegexp(t *testing.T) {
+	actual, err := ParseFilterQuery(`name=~^api-\d{1\,3}$,name!~-canary$`)
+	if err != nil {
+		t.Fatalf("ParseFilterQuery() returned unexpected error: %s", err.Error())
+	}
+
+	expected := []struct {
+		operator dataselect.FilterOperator
+		pattern  string
+	}{
+		{dataselect.RegexOperator, `^api-\d{1,3}$`},
+		{dataselect.NotRegexOperator, `-canary$`},
+	}
+
+	if len(actual.FilterByList) != len(expected) {
+		t.Fatalf("ParseFilterQuery() returned %d filters, expected %d", len(actual.FilterByList), len(expected))
+	}
+
+	for i, e := range expected {
+		filterBy := actual.FilterByList[i]
+		re, ok := filterBy.Value.(dataselect.StdComparableRegexp)
+		if filterBy.Operator != e.operator || !ok || re.String() != e.pattern {
+			t.Errorf("ParseFilterQuery() returned filter %#v, expected operator %q and pattern %q", filterBy,
+				e.operator, e.pattern)
+		}
+	}
+}
@@ -15,6 +15,7 @@
 package parser
 
 import (
+	"log"
 	"strconv"
 	"strings"
 
@@ -38,8 +39,15 @@ func parsePaginationPathParameter(request *restful.Request) *dataselect.Paginati
 	return dataselect.NewPaginationQuery(int(itemsPerPage), int(page-1))
 }
 
+// Parses query parameters of the request and returns a FilterQuery object. Requests with invalid filter
+// expressions are rejected by API filters before they reach list handlers, so NoFilter is only a fallback here.
 func parseFilterPathParameter(request *restful.Request) *dataselect.FilterQuery {
-	return dataselect.NewFilterQuery(strings.Split(request.QueryParameter("filterBy"), ","))
+	filterQuery, err := ParseFilterQuery(request.QueryParameter("filterBy"))
+	if err != nil {
+		return dataselect.NoFilter
+	}
+
+	return filterQuery
 }
 
 // Parses query parameters of the request and returns a SortQuery object
@@ -71,11 +79,43 @@ func parseMetricPathParameter(request *restful.Request) *dataselect.MetricQuery
 
 }
 
+// Parses query parameters of the request and returns a SelectorQuery object
+func parseSelectorPathParameter(request *restful.Request) *dataselect.SelectorQuery {
+	return dataselect.NewSelectorQuery(request.QueryParameter("labelSelector"), request.QueryParameter("fieldSelector"))
+}
+
+// Parses query parameters of the request and returns a CursorQuery object or nil if cursor pagination was not
+// requested. Sorting and filtering need the whole list, so cursor pagination is not used together with them.
+func parseCursorPathParameter(request *restful.Request, sortQuery *dataselect.SortQuery,
+	filterQuery *dataselect.FilterQuery) *dataselect.CursorQuery {
+	limit, err := strconv.ParseInt(request.QueryParameter("limit"), 10, 64)
+	if err != nil || len(sortQuery.SortByList) > 0 || len(filterQuery.FilterByList) > 0 {
+		return nil
+	}
+
+	cursorQuery, err := dataselect.NewCursorQuery(limit, request.QueryParameter("continue"))
+	if err != nil {
+		log.Printf("Ignoring invalid cursor pagination: %s", err.Error())
+		return nil
+	}
+
+	return cursorQuery
+}
+
 // ParseDataSelectPathParameter parses query parameters of the request and returns a DataSelectQuery object
 func ParseDataSelectPathParameter(request *restful.Request) *dataselect.DataSelectQuery {
 	paginationQuery := parsePaginationPathParameter(request)
 	sortQuery := parseSortPathParameter(request)
 	filterQuery := parseFilterPathParameter(request)
 	metricQuery := parseMetricPathParameter(request)
-	return dataselect.NewDataSelectQuery(paginationQuery, sortQuery, filterQuery, metricQuery)
+	cursorQuery := parseCursorPathParameter(request, sortQuery, filterQuery)
+	if cursorQuery != nil {
+		// The apiserver returns a single page already.
+		paginationQuery = dataselect.NoPagination
+	}
+
+	dsQuery := dataselect.NewDataSelectQuery(paginationQuery, sortQuery, filterQuery, metricQuery)
+	dsQuery.SelectorQuery = parseSelectorPathParameter(request)
+	dsQuery.CursorQuery = cursorQuery
+	return dsQuery
 }
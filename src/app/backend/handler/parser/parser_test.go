@@ -0,0 +1,55 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+func TestParseDataSelectPathParameterCursor(t *testing.T) {
+	cases := []struct {
+		query          string
+		expectedCursor *dataselect.CursorQuery
+		paginated      bool
+	}{
+		{"itemsPerPage=10&page=1", nil, true},
+		{"limit=10", &dataselect.CursorQuery{Limit: 10}, false},
+		{"limit=10&itemsPerPage=10&page=1", &dataselect.CursorQuery{Limit: 10}, false},
+		{"limit=10&sortBy=a,name&itemsPerPage=10&page=1", nil, true},
+		{"limit=10&filterBy=name,foo&itemsPerPage=10&page=1", nil, true},
+		{"limit=10&continue=invalid&itemsPerPage=10&page=1", nil, true},
+	}
+
+	for _, c := range cases {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/pod?"+c.query, nil)
+		dsQuery := ParseDataSelectPathParameter(restful.NewRequest(req))
+
+		if (c.expectedCursor == nil) != (dsQuery.CursorQuery == nil) ||
+			(c.expectedCursor != nil && *c.expectedCursor != *dsQuery.CursorQuery) {
+			t.Errorf("ParseDataSelectPathParameter(%q) returned cursor query %#v, expected %#v", c.query,
+				dsQuery.CursorQuery, c.expectedCursor)
+		}
+
+		if dsQuery.PaginationQuery.IsValidPagination() != c.paginated {
+			t.Errorf("ParseDataSelectPathParameter(%q) returned pagination %#v, expected paginated: %t", c.query,
+				dsQuery.PaginationQuery, c.paginated)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"golang.org/x/time/rate"
+
+	"github.com/kubernetes/dashboard/src/app/backend/audit"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// routeClass groups routes, that share a rate limit budget.
+type routeClass string
+
+const (
+	routeClassRead  routeClass = "read"
+	routeClassWrite routeClass = "write"
+	routeClassExec  routeClass = "exec"
+	routeClassLogs  routeClass = "logs"
+)
+
+// idleBucketTTL is the time after which buckets of clients that did not send any requests are removed.
+const idleBucketTTL = 10 * time.Minute
+
+// RateLimits holds the number of requests per second allowed for every client in every route class. Zero
+// disables the limit of the class. Burst is a multiplier of the rate, that gives the size of token buckets.
+// Forwarded headers are used to get client address only for requests sent by TrustedProxies.
+type RateLimits struct {
+	Read           int
+	Write          int
+	Exec           int
+	Logs           int
+	Burst          int
+	TrustedProxies []*net.IPNet
+}
+
+// forClass returns the rate limit of the route class.
+func (self RateLimits) forClass(class routeClass) int {
+	switch class {
+	case routeClassWrite:
+		return self.Write
+	case routeClassExec:
+		return self.Exec
+	case routeClassLogs:
+		return self.Logs
+	}
+	return self.Read
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rateLimiter keeps a token bucket for every client and route class.
+type rateLimiter struct {
+	mux         sync.Mutex
+	limits      RateLimits
+	buckets     map[string]*bucket
+	lastCleanup time.Time
+}
+
+// reserve takes a token from the bucket of the client and returns the time the client has to wait, if the
+// bucket is empty.
+func (self *rateLimiter) reserve(class routeClass, key string, now time.Time) (time.Duration, bool) {
+	limit := self.limits.forClass(class)
+	if limit <= 0 {
+		return 0, true
+	}
+
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	if now.Sub(self.lastCleanup) > idleBucketTTL {
+		for bucketKey, b := range self.buckets {
+			if now.Sub(b.lastSeen) > idleBucketTTL {
+				delete(self.buckets, bucketKey)
+			}
+		}
+		self.lastCleanup = now
+	}
+
+	bucketKey := string(class) + "/" + key
+	b, ok := self.buckets[bucketKey]
+	if !ok {
+		burst := limit * self.limits.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit), burst)}
+		self.buckets[bucketKey] = b
+	}
+	b.lastSeen = now
+
+	reservation := b.limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+func newRateLimiter(limits RateLimits) *rateLimiter {
+	return &rateLimiter{limits: limits, buckets: make(map[string]*bucket), lastCleanup: time.Now()}
+}
+
+// rateLimitFilter rejects requests of clients, that exhausted their budget for the route class, with 429 status
+// code and Retry-After header telling the client when a token is available again. Clients are identified by the authenticated user and fall back to the client address. Only user names
+// resolved before are used, so the decision does not require requests to the apiserver. Names of users are resolved
+// only for requests, that fit into the budget of the client address, so clients sending random tokens can not
+// flood the apiserver.
+func rateLimitFilter(limiter *rateLimiter, resolver *audit.UserResolver) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		class := getRouteClass(request)
+		keyType := "user"
+		key, resolved := resolver.CachedUsername(request)
+		if len(key) == 0 {
+			keyType, key = "ip", getClientHost(request.Request, limiter.limits.TrustedProxies)
+		}
+
+		delay, ok := limiter.reserve(class, keyType+":"+key, time.Now())
+		if ok {
+			if !resolved {
+				resolver.Username(request)
+			}
+
+			chain.ProcessFilter(request, response)
+			return
+		}
+
+		throttledRequestCounter.WithLabelValues(string(class), keyType).Inc()
+		response.AddHeader("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		errors.HandleInternalError(response, errors.NewTooManyRequests(errors.MsgTooManyRequestsError))
+	}
+}
+
+// getRouteClass returns the rate limit budget used by the request.
+func getRouteClass(request *restful.Request) routeClass {
+	route := request.SelectedRoutePath()
+	switch {
+	case strings.Contains(route, "/shell/"):
+		return routeClassExec
+	case strings.HasPrefix(route, "/api/v1/log/"):
+		return routeClassLogs
+	case request.Request.Method != http.MethodGet:
+		return routeClassWrite
+	}
+	return routeClassRead
+}
+
+// getClientHost returns the address of the client without port, so that all connections of a client share a single
+// budget. Forwarded headers are used only if the request was sent by a trusted proxy, otherwise clients could get
+// a new budget by changing them.
+func getClientHost(r *http.Request, trustedProxies []*net.IPNet) string {
+	peer := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(peer); err == nil {
+		peer = host
+	}
+
+	if !isTrustedProxy(peer, trustedProxies) {
+		return peer
+	}
+
+	// Every proxy appends address of its peer, so the rightmost address, that is not a trusted proxy, is the client.
+	forwarded := strings.Split(r.Header.Get(forwardedForHeader), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		if ip := strings.TrimSpace(forwarded[i]); len(ip) > 0 && !isTrustedProxy(ip, trustedProxies) {
+			return ip
+		}
+	}
+
+	if realIP := strings.TrimSpace(r.Header.Get(realIPHeader)); len(realIP) > 0 {
+		return realIP
+	}
+	return peer
+}
+
+func isTrustedProxy(addr string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTrustedProxies parses addresses and CIDRs of trusted proxies. Addresses are handled as single host networks.
+func ParseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy address %s", proxy)
+			}
+
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy CIDR %s: %s", proxy, err.Error())
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
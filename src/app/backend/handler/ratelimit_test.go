@@ -0,0 +1,190 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/audit"
+	"github.com/kubernetes/dashboard/src/app/backend/client"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	limiter := newRateLimiter(RateLimits{Read: 1, Write: 0, Burst: 2})
+	now := time.Now()
+
+	cases := []struct {
+		info     string
+		class    routeClass
+		key      string
+		at       time.Time
+		expected bool
+	}{
+		{"first request within burst", routeClassRead, "ip:a", now, true},
+		{"second request within burst", routeClassRead, "ip:a", now, true},
+		{"bucket exhausted", routeClassRead, "ip:a", now, false},
+		{"other client has own bucket", routeClassRead, "ip:b", now, true},
+		{"disabled class is not limited", routeClassWrite, "ip:a", now, true},
+		{"bucket refilled", routeClassRead, "ip:a", now.Add(time.Second), true},
+	}
+
+	for _, c := range cases {
+		delay, ok := limiter.reserve(c.class, c.key, c.at)
+		if ok != c.expected {
+			t.Errorf("Test Case: %s. Expected %v, got %v", c.info, c.expected, ok)
+		}
+		if !ok && delay <= 0 {
+			t.Errorf("Test Case: %s. Expected positive delay, got %v", c.info, delay)
+		}
+	}
+}
+
+func TestRateLimitFilter(t *testing.T) {
+	cManager := client.NewClientManager("", "http://localhost:8080")
+	ws := new(restful.WebService)
+	ws.Path("/api/v1")
+	ws.Filter(rateLimitFilter(newRateLimiter(RateLimits{Read: 1, Exec: 1, Burst: 1}),
+		audit.NewUserResolver(cManager, time.Minute)))
+	handle := func(request *restful.Request, response *restful.Response) {
+		response.WriteHeader(http.StatusOK)
+	}
+	ws.Route(ws.GET("/pod").To(handle))
+	ws.Route(ws.GET("/pod/{namespace}/{pod}/shell/{container}").To(handle))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		path, remoteAddr, forwardedFor string
+		expected                       int
+	}{
+		{"/api/v1/pod", "192.0.2.1:1000", "", http.StatusOK},
+		{"/api/v1/pod", "192.0.2.1:1001", "", http.StatusTooManyRequests},
+		{"/api/v1/pod", "192.0.2.1:1001", "198.51.100.1", http.StatusTooManyRequests},
+		{"/api/v1/pod/default/web/shell/app", "192.0.2.1:1002", "", http.StatusOK},
+		{"/api/v1/pod", "192.0.2.2:1000", "", http.StatusOK},
+	}
+
+	for _, c := range cases {
+		req := httptest.NewRequest(http.MethodGet, c.path, nil)
+		req.RemoteAddr = c.remoteAddr
+		if len(c.forwardedFor) > 0 {
+			req.Header.Set(forwardedForHeader, c.forwardedFor)
+		}
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, req)
+
+		if recorder.Code != c.expected {
+			t.Errorf("GET %s from %s returned %d, expected %d", c.path, c.remoteAddr, recorder.Code, c.expected)
+		}
+		if c.expected == http.StatusTooManyRequests && recorder.Header().Get("Retry-After") != "1" {
+			t.Errorf("Expected Retry-After header of 1 second, got %q", recorder.Header().Get("Retry-After"))
+		}
+		if c.expected == http.StatusTooManyRequests &&
+			!strings.Contains(recorder.Body.String(), errors.MsgTooManyRequestsError) {
+			t.Errorf("Expected %s message, got %q", errors.MsgTooManyRequestsError, recorder.Body.String())
+		}
+	}
+}
+
+func TestGetRouteClass(t *testing.T) {
+	cases := []struct {
+		method, route, path string
+		expected            routeClass
+	}{
+		{http.MethodGet, "/api/v1/pod/{namespace}", "/api/v1/pod/default", routeClassRead},
+		{http.MethodDelete, "/api/v1/_raw/{kind}/name/{name}", "/api/v1/_raw/node/name/a", routeClassWrite},
+		{http.MethodGet, "/api/v1/pod/{namespace}/{pod}/shell/{container}", "/api/v1/pod/default/a/shell/b",
+			routeClassExec},
+		{http.MethodGet, "/api/v1/log/file/{namespace}/{pod}/{container}", "/api/v1/log/file/default/a/b",
+			routeClassLogs},
+	}
+
+	for _, c := range cases {
+		called := false
+		ws := new(restful.WebService)
+		ws.Route(ws.Method(c.method).Path(c.route).To(func(request *restful.Request, response *restful.Response) {
+			called = true
+			if actual := getRouteClass(request); actual != c.expected {
+				t.Errorf("getRouteClass(%s %s) returned %s, expected %s", c.method, c.route, actual, c.expected)
+			}
+		}))
+		container := restful.NewContainer()
+		container.Add(ws)
+		container.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(c.method, c.path, nil))
+		if !called {
+			t.Errorf("Route %s %s was not selected for %s", c.method, c.route, c.path)
+		}
+	}
+}
+
+func TestGetClientHost(t *testing.T) {
+	trustedProxies, _ := ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1"})
+	cases := []struct {
+		info, remoteAddr, forwardedFor, realIP string
+		expected                               string
+	}{
+		{"forwarded headers of untrusted peer are ignored", "198.51.100.1:1000", "203.0.113.1", "203.0.113.2",
+			"198.51.100.1"},
+		{"client is read from forwarded header of trusted proxy", "192.0.2.1:1000", "203.0.113.1", "",
+			"203.0.113.1"},
+		{"addresses added by client are skipped", "192.0.2.1:1000", "203.0.113.9, 203.0.113.1, 10.0.0.1", "",
+			"203.0.113.1"},
+		{"real IP header of trusted proxy is used", "10.1.1.1:1000", "", "203.0.113.2", "203.0.113.2"},
+		{"trusted proxy without headers is the client", "10.1.1.1:1000", "", "", "10.1.1.1"},
+	}
+
+	for _, c := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pod", nil)
+		req.RemoteAddr = c.remoteAddr
+		if len(c.forwardedFor) > 0 {
+			req.Header.Set(forwardedForHeader, c.forwardedFor)
+		}
+		if len(c.realIP) > 0 {
+			req.Header.Set(realIPHeader, c.realIP)
+		}
+
+		if actual := getClientHost(req, trustedProxies); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected %s, got %s", c.info, c.expected, actual)
+		}
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	cases := []struct {
+		proxies []string
+		valid   bool
+	}{
+		{[]string{"10.0.0.0/8", "192.0.2.1", "2001:db8::1", "2001:db8::/32"}, true},
+		{[]string{"10.0.0.0/33"}, false},
+		{[]string{"proxy.local"}, false},
+	}
+
+	for _, c := range cases {
+		networks, err := ParseTrustedProxies(c.proxies)
+		if (err == nil) != c.valid {
+			t.Errorf("ParseTrustedProxies(%v) returned error %v, expected valid: %t", c.proxies, err, c.valid)
+		}
+		if c.valid && len(networks) != len(c.proxies) {
+			t.Errorf("ParseTrustedProxies(%v) returned %d networks", c.proxies, len(networks))
+		}
+	}
+}
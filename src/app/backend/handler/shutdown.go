@@ -0,0 +1,33 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import "sync"
+
+var (
+	// streamsClosing is closed when the server shuts down, so that long-lived streams end and do not hold off
+	// draining of in-flight requests.
+	streamsClosing   = make(chan struct{})
+	closeStreamsOnce sync.Once
+)
+
+// CloseStreams ends all watch streams and terminal sessions. It is called when the server shuts down, as
+// these connections would otherwise be kept open until the client disconnects.
+func CloseStreams() {
+	closeStreamsOnce.Do(func() {
+		close(streamsClosing)
+	})
+	terminalSessions.CloseAll(2, "Dashboard is shutting down")
+}
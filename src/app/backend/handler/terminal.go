@@ -161,9 +161,25 @@ func (sm *SessionMap) Set(sessionId string, session TerminalSession) {
 func (sm *SessionMap) Close(sessionId string, status uint32, reason string) {
 	sm.Lock.Lock()
 	defer sm.Lock.Unlock()
-	err := sm.Sessions[sessionId].sockJSSession.Close(status, reason)
-	if err != nil {
-		log.Println(err)
+	sm.close(sessionId, status, reason)
+}
+
+// CloseAll shuts down SockJS connections of all sessions, i.e. when the server shuts down
+func (sm *SessionMap) CloseAll(status uint32, reason string) {
+	sm.Lock.Lock()
+	defer sm.Lock.Unlock()
+	for sessionId := range sm.Sessions {
+		sm.close(sessionId, status, reason)
+	}
+}
+
+// close has to be called with the lock held. Sessions, that were already closed or not yet bound to a SockJS
+// connection are only removed from the map
+func (sm *SessionMap) close(sessionId string, status uint32, reason string) {
+	if session, ok := sm.Sessions[sessionId]; ok && session.sockJSSession != nil {
+		if err := session.sockJSSession.Close(status, reason); err != nil {
+			log.Println(err)
+		}
 	}
 
 	delete(sm.Sessions, sessionId)
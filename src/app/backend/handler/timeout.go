@@ -0,0 +1,97 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+)
+
+// streamingRoutes are route prefixes of long-lived responses, that are not limited by the request timeout.
+var streamingRoutes = []string{
+	"/api/v1/watch/",
+	"/api/v1/log/file/",
+}
+
+// RequestTimeouts holds deadlines of API requests. Routes maps route path prefixes to timeouts overriding the
+// default one, the longest matching prefix wins. Zero or negative timeout disables the deadline.
+type RequestTimeouts struct {
+	Default time.Duration
+	Routes  map[string]time.Duration
+}
+
+// NewRequestTimeouts creates request timeouts from the number of seconds given in Dashboard arguments.
+func NewRequestTimeouts(defaultTimeout int, routeTimeouts map[string]int) RequestTimeouts {
+	timeouts := RequestTimeouts{
+		Default: time.Duration(defaultTimeout) * time.Second,
+		Routes:  make(map[string]time.Duration, len(routeTimeouts)),
+	}
+	for route, timeout := range routeTimeouts {
+		timeouts.Routes[route] = time.Duration(timeout) * time.Second
+	}
+
+	return timeouts
+}
+
+// forRoute returns the timeout of requests to the route.
+func (self RequestTimeouts) forRoute(route string) time.Duration {
+	timeout := self.Default
+	longest := -1
+	for prefix, routeTimeout := range self.Routes {
+		if strings.HasPrefix(route, prefix) && len(prefix) > longest {
+			timeout = routeTimeout
+			longest = len(prefix)
+		}
+	}
+
+	return timeout
+}
+
+// requestTimeoutFilter sets a deadline on the context of the request, so that all apiserver calls made to serve
+// it are cancelled once the timeout of its route passes. Streaming routes are left without deadline.
+func requestTimeoutFilter(timeouts RequestTimeouts) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		timeout := timeouts.forRoute(request.SelectedRoutePath())
+		if timeout <= 0 || isStreamingRoute(request) {
+			chain.ProcessFilter(request, response)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(request.Request.Context(), timeout)
+		defer cancel()
+
+		request.Request = request.Request.WithContext(ctx)
+		chain.ProcessFilter(request, response)
+	}
+}
+
+// isStreamingRoute returns true if the response of the request is streamed for as long as the client is connected.
+func isStreamingRoute(request *restful.Request) bool {
+	route := request.SelectedRoutePath()
+	if strings.Contains(route, "/shell/") {
+		return true
+	}
+
+	for _, prefix := range streamingRoutes {
+		if strings.HasPrefix(route, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,94 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+func TestRequestTimeoutsForRoute(t *testing.T) {
+	timeouts := NewRequestTimeouts(60, map[string]int{
+		"/api/v1/search":         120,
+		"/api/v1/search/{query}": 0,
+		"/api/v1/node":           10,
+	})
+
+	cases := []struct {
+		route    string
+		expected time.Duration
+	}{
+		{"/api/v1/pod", time.Minute},
+		{"/api/v1/node/{name}", 10 * time.Second},
+		{"/api/v1/search", 2 * time.Minute},
+		{"/api/v1/search/{query}", 0},
+	}
+
+	for _, c := range cases {
+		actual := timeouts.forRoute(c.route)
+		if actual != c.expected {
+			t.Errorf("forRoute(%s) == %v, expected %v", c.route, actual, c.expected)
+		}
+	}
+}
+
+func TestRequestTimeoutFilter(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Path("/api/v1")
+	ws.Filter(requestTimeoutFilter(RequestTimeouts{
+		Default: 10 * time.Millisecond,
+		Routes:  map[string]time.Duration{"/api/v1/node": 0},
+	}))
+	handle := func(request *restful.Request, response *restful.Response) {
+		ctx := request.Request.Context()
+		if _, ok := ctx.Deadline(); !ok {
+			response.WriteHeader(http.StatusOK)
+			return
+		}
+
+		<-ctx.Done()
+		errors.HandleInternalError(response, ctx.Err())
+	}
+	ws.Route(ws.GET("/pod").To(handle))
+	ws.Route(ws.GET("/node").To(handle))
+	ws.Route(ws.GET("/watch/{kind}").To(handle))
+	ws.Route(ws.GET("/pod/{namespace}/{pod}/shell/{container}").To(handle))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		path     string
+		expected int
+	}{
+		{"/api/v1/pod", http.StatusGatewayTimeout},
+		{"/api/v1/node", http.StatusOK},
+		{"/api/v1/watch/pod", http.StatusOK},
+		{"/api/v1/pod/default/web/shell/app", http.StatusOK},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, c.path, nil))
+		if recorder.Code != c.expected {
+			t.Errorf("GET %s responded with %d, expected %d", c.path, recorder.Code, c.expected)
+		}
+	}
+}
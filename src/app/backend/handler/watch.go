@@ -0,0 +1,175 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/handler/parser"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/configmap"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/cronjob"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/daemonset"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/deployment"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/ingress"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/job"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/listwatch"
+	ns "github.com/kubernetes/dashboard/src/app/backend/resource/namespace"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/persistentvolumeclaim"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/pod"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/replicaset"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/secret"
+	resourceService "github.com/kubernetes/dashboard/src/app/backend/resource/service"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/statefulset"
+)
+
+// listWatchFactory creates list watch for a single resource kind based on the list route parameters.
+type listWatchFactory func(client kubernetes.Interface, metricClient metricapi.MetricClient,
+	nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch
+
+// listWatchFactories holds resource kinds that can be streamed through the watch routes. Data select queries of
+// kinds that show metrics on the list are extended with standard metrics, the same as on regular list routes.
+var listWatchFactories = map[string]listWatchFactory{
+	api.ResourceKindPod: func(client kubernetes.Interface, metricClient metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		dsQuery.MetricQuery = dataselect.StandardMetrics
+		return pod.NewPodListWatch(client, metricClient, nsQuery, dsQuery)
+	},
+	api.ResourceKindDeployment: func(client kubernetes.Interface, metricClient metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		dsQuery.MetricQuery = dataselect.StandardMetrics
+		return deployment.NewDeploymentListWatch(client, metricClient, nsQuery, dsQuery)
+	},
+	api.ResourceKindReplicaSet: func(client kubernetes.Interface, metricClient metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		dsQuery.MetricQuery = dataselect.StandardMetrics
+		return replicaset.NewReplicaSetListWatch(client, metricClient, nsQuery, dsQuery)
+	},
+	api.ResourceKindDaemonSet: func(client kubernetes.Interface, metricClient metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		dsQuery.MetricQuery = dataselect.StandardMetrics
+		return daemonset.NewDaemonSetListWatch(client, metricClient, nsQuery, dsQuery)
+	},
+	api.ResourceKindStatefulSet: func(client kubernetes.Interface, metricClient metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		dsQuery.MetricQuery = dataselect.StandardMetrics
+		return statefulset.NewStatefulSetListWatch(client, metricClient, nsQuery, dsQuery)
+	},
+	api.ResourceKindJob: func(client kubernetes.Interface, metricClient metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		dsQuery.MetricQuery = dataselect.StandardMetrics
+		return job.NewJobListWatch(client, metricClient, nsQuery, dsQuery)
+	},
+	api.ResourceKindCronJob: func(client kubernetes.Interface, metricClient metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		dsQuery.MetricQuery = dataselect.StandardMetrics
+		return cronjob.NewCronJobListWatch(client, metricClient, nsQuery, dsQuery)
+	},
+	api.ResourceKindService: func(client kubernetes.Interface, _ metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		return resourceService.NewServiceListWatch(client, nsQuery, dsQuery)
+	},
+	api.ResourceKindIngress: func(client kubernetes.Interface, _ metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		return ingress.NewIngressListWatch(client, nsQuery, dsQuery)
+	},
+	api.ResourceKindConfigMap: func(client kubernetes.Interface, _ metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		return configmap.NewConfigMapListWatch(client, nsQuery, dsQuery)
+	},
+	api.ResourceKindSecret: func(client kubernetes.Interface, _ metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		return secret.NewSecretListWatch(client, nsQuery, dsQuery)
+	},
+	api.ResourceKindPersistentVolumeClaim: func(client kubernetes.Interface, _ metricapi.MetricClient,
+		nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		return persistentvolumeclaim.NewPersistentVolumeClaimListWatch(client, nsQuery, dsQuery)
+	},
+	api.ResourceKindNamespace: func(client kubernetes.Interface, _ metricapi.MetricClient,
+		_ *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+		return ns.NewNamespaceListWatch(client, dsQuery)
+	},
+}
+
+// handleWatchResourceList streams changes of the resource list as Server-Sent Events. The list is selected
+// using the same query parameters as regular list route of given kind.
+func (apiHandler *APIHandler) handleWatchResourceList(request *restful.Request, response *restful.Response) {
+	kind := request.PathParameter("kind")
+	factory, ok := listWatchFactories[kind]
+	if !ok {
+		errors.HandleInternalError(response, errors.NewBadRequest(fmt.Sprintf("watching %s list is not supported", kind)))
+		return
+	}
+
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	// Streamed list is kept in sync as a whole, a single page of it can not be watched.
+	dataSelect.CursorQuery = nil
+	listWatch := factory(k8sClient, apiHandler.iManager.Metric().Client(), namespace, dataSelect)
+
+	response.AddHeader("Content-Type", "text/event-stream")
+	response.AddHeader("Cache-Control", "no-cache")
+	response.AddHeader("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	ctx, cancel := context.WithCancel(request.Request.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-streamsClosing:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err = listWatch.Stream(ctx, func(event listwatch.Event) error {
+		return writeServerSentEvent(response, string(event.Type), event)
+	})
+	if err != nil {
+		_ = writeServerSentEvent(response, "ERROR", errors.LocalizeError(err).Error())
+	}
+}
+
+// writeServerSentEvent writes a single named event with JSON encoded data to the stream and flushes it.
+func writeServerSentEvent(response *restful.Response, name string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if _, err = fmt.Fprintf(response, "event: %s\ndata: %s\n\n", name, payload); err != nil {
+		return err
+	}
+
+	response.Flush()
+	return nil
+}
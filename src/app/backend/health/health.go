@@ -0,0 +1,128 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/client-go/discovery"
+
+	"github.com/kubernetes/dashboard/src/app/backend/integration/metric"
+	"github.com/kubernetes/dashboard/src/app/backend/sync"
+)
+
+// checkTimeout is the time after which a check that did not return is reported as failed.
+const checkTimeout = 5 * time.Second
+
+// Check verifies a single dependency of Dashboard. Run returns nil when the dependency is healthy.
+type Check struct {
+	Name string
+	// Optional checks are reported in the verbose output, but do not fail the endpoint.
+	Optional bool
+	Run      func() error
+}
+
+// Handler serves results of a set of checks in the same way as Kubernetes components do. It responds with "ok"
+// when all required checks pass and with the list of checks and status 500 otherwise. The list is always returned
+// when 'verbose' query parameter is set.
+type Handler struct {
+	name   string
+	checks []Check
+}
+
+// ServeHTTP implements http.Handler interface.
+func (self *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var out bytes.Buffer
+	failed := false
+	for _, check := range self.checks {
+		if err := run(check); err != nil {
+			fmt.Fprintf(&out, "[-]%s failed: %s\n", check.Name, err.Error())
+			failed = failed || !check.Optional
+			continue
+		}
+		fmt.Fprintf(&out, "[+]%s ok\n", check.Name)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "no-store")
+	if failed {
+		fmt.Fprintf(&out, "%s check failed\n", self.name)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = out.WriteTo(w)
+		return
+	}
+
+	if _, verbose := r.URL.Query()["verbose"]; verbose {
+		fmt.Fprintf(&out, "%s check passed\n", self.name)
+		_, _ = out.WriteTo(w)
+		return
+	}
+
+	_, _ = fmt.Fprint(w, "ok")
+}
+
+// run runs the check and fails it if it does not return in time.
+func run(check Check) error {
+	result := make(chan error, 1)
+	go func() { result <- check.Run() }()
+
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(checkTimeout):
+		return fmt.Errorf("check did not finish within %s", checkTimeout)
+	}
+}
+
+// NewHandler creates handler serving results of given checks under given name, i.e. 'healthz'.
+func NewHandler(name string, checks ...Check) *Handler {
+	return &Handler{name: name, checks: checks}
+}
+
+// PingCheck always passes. It tells that the server is able to handle requests.
+func PingCheck() Check {
+	return Check{Name: "ping", Run: func() error { return nil }}
+}
+
+// APIServerCheck passes when the apiserver can be reached using given client.
+func APIServerCheck(client discovery.DiscoveryInterface) Check {
+	return Check{Name: "apiserver", Run: func() error {
+		_, err := client.ServerVersion()
+		return err
+	}}
+}
+
+// SynchronizerCheck passes when all synchronizers, i.e. the one of encryption key, are running and synced.
+func SynchronizerCheck() Check {
+	return Check{Name: "synchronizers", Run: sync.Overwatch.Check}
+}
+
+// MetricCheck passes when there is an active metric client that is healthy. It is optional, as Dashboard works
+// without metrics.
+func MetricCheck(manager metric.MetricManager) Check {
+	return Check{Name: "metrics", Optional: true, Run: func() error {
+		client := manager.Client()
+		if client == nil {
+			return errors.New("no metric client is active")
+		}
+
+		return client.HealthCheck()
+	}}
+}
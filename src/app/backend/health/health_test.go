@@ -0,0 +1,56 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestHandler(t *testing.T) {
+	failing := Check{Name: "failing", Run: func() error { return errors.New("unreachable") }}
+	optional := Check{Name: "optional", Optional: true, Run: failing.Run}
+
+	cases := []struct {
+		info   string
+		checks []Check
+		query  string
+		code   int
+		body   string
+	}{
+		{"all checks pass", []Check{PingCheck()}, "", http.StatusOK, "ok"},
+		{"verbose output", []Check{PingCheck()}, "?verbose", http.StatusOK, "[+]ping ok\nreadyz check passed\n"},
+		{"required check fails", []Check{PingCheck(), failing}, "", http.StatusInternalServerError,
+			"[+]ping ok\n[-]failing failed: unreachable\nreadyz check failed\n"},
+		{"optional check fails", []Check{PingCheck(), optional}, "", http.StatusOK, "ok"},
+		{"apiserver is reachable", []Check{APIServerCheck(fake.NewSimpleClientset().Discovery())}, "",
+			http.StatusOK, "ok"},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		NewHandler("readyz", c.checks...).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz"+c.query, nil))
+		if recorder.Code != c.code {
+			t.Errorf("Test Case: %s. Expected status %d, got %d", c.info, c.code, recorder.Code)
+		}
+		if recorder.Body.String() != c.body {
+			t.Errorf("Test Case: %s. Expected body %q, got %q", c.info, c.body, recorder.Body.String())
+		}
+	}
+}
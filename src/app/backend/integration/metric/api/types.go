@@ -15,6 +15,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -28,12 +29,13 @@ import (
 type MetricClient interface {
 	// DownloadMetric returns MetricPromises for specified list of selector, for single type
 	// of metric, i.e. cpu usage. Cached resources is usually list of pods as other high level
-	// resources do not directly provide metrics. Only pods targeted by them.
-	DownloadMetric(selectors []ResourceSelector, metricName string,
+	// resources do not directly provide metrics. Only pods targeted by them. Downloads are cancelled together
+	// with the context.
+	DownloadMetric(ctx context.Context, selectors []ResourceSelector, metricName string,
 		cachedResources *CachedResources) MetricPromises
 	// DownloadMetrics is similar to DownloadMetric method. It returns MetricPromises for
 	// given list of metrics, i.e. cpu/memory usage instead of single metric type.
-	DownloadMetrics(selectors []ResourceSelector, metricNames []string,
+	DownloadMetrics(ctx context.Context, selectors []ResourceSelector, metricNames []string,
 		cachedResources *CachedResources) MetricPromises
 	// AggregateMetrics is used to aggregate previously downloaded metrics based on
 	// aggregation mode (sum, min, avg). It is used to show cumulative metric graphs on
@@ -57,24 +57,24 @@ func (self heapsterClient) ID() integrationapi.IntegrationID {
 // Implement MetricClient interface
 
 // DownloadMetrics implements metric client interface. See MetricClient for more information.
-func (self heapsterClient) DownloadMetrics(selectors []metricapi.ResourceSelector,
+func (self heapsterClient) DownloadMetrics(ctx context.Context, selectors []metricapi.ResourceSelector,
 	metricNames []string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
 	result := metricapi.MetricPromises{}
 	for _, metricName := range metricNames {
-		collectedMetrics := self.DownloadMetric(selectors, metricName, cachedResources)
+		collectedMetrics := self.DownloadMetric(ctx, selectors, metricName, cachedResources)
 		result = append(result, collectedMetrics...)
 	}
 	return result
 }
 
 // DownloadMetric implements metric client interface. See MetricClient for more information.
-func (self heapsterClient) DownloadMetric(selectors []metricapi.ResourceSelector,
+func (self heapsterClient) DownloadMetric(ctx context.Context, selectors []metricapi.ResourceSelector,
 	metricName string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
 	heapsterSelectors := getHeapsterSelectors(selectors, cachedResources)
 
 	// Downloads metric in the fastest possible way by first compressing HeapsterSelectors and later unpacking the result to separate boxes.
 	compressedSelectors, reverseMapping := compress(heapsterSelectors)
-	return self.downloadMetric(heapsterSelectors, compressedSelectors, reverseMapping, metricName)
+	return self.downloadMetric(ctx, heapsterSelectors, compressedSelectors, reverseMapping, metricName)
 }
 
 // AggregateMetrics implements metric client interface. See MetricClient for more information.
@@ -83,14 +83,14 @@ func (self heapsterClient) AggregateMetrics(metrics metricapi.MetricPromises, me
 	return common.AggregateMetricPromises(metrics, metricName, aggregations, nil)
 }
 
-func (self heapsterClient) downloadMetric(heapsterSelectors []heapsterSelector,
+func (self heapsterClient) downloadMetric(ctx context.Context, heapsterSelectors []heapsterSelector,
 	compressedSelectors []heapsterSelector, reverseMapping map[string][]int,
 	metricName string) metricapi.MetricPromises {
 	// collect all the required data (as promises)
 	unassignedResourcePromisesList := make([]metricapi.MetricPromises, len(compressedSelectors))
 	for selectorId, compressedSelector := range compressedSelectors {
 		unassignedResourcePromisesList[selectorId] =
-			self.downloadMetricForEachTargetResource(compressedSelector, metricName)
+			self.downloadMetricForEachTargetResource(ctx, compressedSelector, metricName)
 	}
 	// prepare final result
 	result := metricapi.NewMetricPromises(len(heapsterSelectors))
@@ -136,14 +136,15 @@ func (self heapsterClient) downloadMetric(heapsterSelectors []heapsterSelector,
 
 // downloadMetricForEachTargetResource downloads requested metric for each resource present in HeapsterSelector
 // and returns the result as a list of promises - one promise for each resource. Order of promises returned is the same as order in self.Resources.
-func (self heapsterClient) downloadMetricForEachTargetResource(selector heapsterSelector, metricName string) metricapi.MetricPromises {
+func (self heapsterClient) downloadMetricForEachTargetResource(ctx context.Context, selector heapsterSelector,
+	metricName string) metricapi.MetricPromises {
 	var notAggregatedMetrics metricapi.MetricPromises
 	if HeapsterAllInOneDownloadConfig[selector.TargetResourceType] {
-		notAggregatedMetrics = self.allInOneDownload(selector, metricName)
+		notAggregatedMetrics = self.allInOneDownload(ctx, selector, metricName)
 	} else {
 		notAggregatedMetrics = metricapi.MetricPromises{}
 		for i := range selector.Resources {
-			notAggregatedMetrics = append(notAggregatedMetrics, self.ithResourceDownload(selector, metricName, i))
+			notAggregatedMetrics = append(notAggregatedMetrics, self.ithResourceDownload(ctx, selector, metricName, i))
 		}
 	}
 	return notAggregatedMetrics
@@ -151,12 +152,12 @@ func (self heapsterClient) downloadMetricForEachTargetResource(selector heapster
 
 // ithResourceDownload downloads metric for ith resource in self.Resources. Use only in case all in 1 download is not supported
 // for this resource type.
-func (self heapsterClient) ithResourceDownload(selector heapsterSelector, metricName string,
+func (self heapsterClient) ithResourceDownload(ctx context.Context, selector heapsterSelector, metricName string,
 	i int) metricapi.MetricPromise {
 	result := metricapi.NewMetricPromise()
 	go func() {
 		rawResult := heapster.MetricResult{}
-		err := self.unmarshalType(selector.Path+selector.Resources[i]+"/metrics/"+metricName, &rawResult)
+		err := self.unmarshalType(ctx, selector.Path+selector.Resources[i]+"/metrics/"+metricName, &rawResult)
 		if err != nil {
 			result.Metric <- nil
 			result.Error <- err
@@ -182,14 +183,15 @@ func (self heapsterClient) ithResourceDownload(selector heapsterSelector, metric
 
 // allInOneDownload downloads metrics for all resources present in self.Resources in one request.
 // returns a list of metric promises - one promise for each resource. Order of self.Resources is preserved.
-func (self heapsterClient) allInOneDownload(selector heapsterSelector, metricName string) metricapi.MetricPromises {
+func (self heapsterClient) allInOneDownload(ctx context.Context, selector heapsterSelector,
+	metricName string) metricapi.MetricPromises {
 	result := metricapi.NewMetricPromises(len(selector.Resources))
 	go func() {
 		if len(selector.Resources) == 0 {
 			return
 		}
 		rawResults := heapster.MetricResultList{}
-		err := self.unmarshalType(selector.Path+strings.Join(selector.Resources, ",")+"/metrics/"+metricName, &rawResults)
+		err := self.unmarshalType(ctx, selector.Path+strings.Join(selector.Resources, ",")+"/metrics/"+metricName, &rawResults)
 		if err != nil {
 			result.PutMetrics(nil, err)
 			return
@@ -222,8 +224,8 @@ func (self heapsterClient) allInOneDownload(selector heapsterSelector, metricNam
 
 // unmarshalType performs heapster GET request to the specifies path and transfers
 // the data to the interface provided.
-func (self heapsterClient) unmarshalType(path string, v interface{}) error {
-	rawData, err := self.client.Get("/model/" + path).DoRaw(context.TODO())
+func (self heapsterClient) unmarshalType(ctx context.Context, path string, v interface{}) error {
+	rawData, err := self.client.Get("/model/" + path).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
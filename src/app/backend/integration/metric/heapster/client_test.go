@@ -295,7 +295,7 @@ func TestDownloadMetric(t *testing.T) {
 	for _, testCase := range testCases {
 		log.Println("-----------\n\n\n", testCase.Info, int(_NumRequests.get()))
 		hClient := heapsterClient{fakeHeapsterClient}
-		promises := hClient.DownloadMetric(testCase.Selectors, "",
+		promises := hClient.DownloadMetric(context.TODO(), testCase.Selectors, "",
 			&metricapi.CachedResources{})
 		metrics, err := hClient.AggregateMetrics(promises, "", nil).GetMetrics()
 		if err != nil {
@@ -419,7 +419,7 @@ func TestDownloadMetrics(t *testing.T) {
 
 		metricPromises := make(metricapi.MetricPromises, 0)
 		for _, metricName := range testCase.MetricNames {
-			promises := hClient.DownloadMetric(selectors, metricName,
+			promises := hClient.DownloadMetric(context.TODO(), selectors, metricName,
 				&metricapi.CachedResources{})
 			promises = hClient.AggregateMetrics(promises, metricName,
 				testCase.AggregationNames)
@@ -17,6 +17,7 @@ package metric
 import (
 	"fmt"
 	"log"
+	"sync/atomic"
 	"time"
 
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
@@ -24,7 +25,6 @@ import (
 	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
 	"github.com/kubernetes/dashboard/src/app/backend/integration/metric/heapster"
 	"github.com/kubernetes/dashboard/src/app/backend/integration/metric/sidecar"
-	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // MetricManager is responsible for management of all integrated applications related to metrics.
@@ -39,6 +39,8 @@ type MetricManager interface {
 	// EnableWithRetry works similar to enable. It runs in a separate thread and tries to enable integration with given
 	// id every 'period' seconds.
 	EnableWithRetry(id integrationapi.IntegrationID, period time.Duration)
+	// SetRetryPeriod changes period in seconds between retries started by EnableWithRetry.
+	SetRetryPeriod(period time.Duration)
 	// List returns list of available metric related integrations.
 	List() []integrationapi.Integration
 	// ConfigureSidecar configures and adds sidecar to clients list.
@@ -52,6 +54,8 @@ type metricManager struct {
 	manager clientapi.ClientManager
 	clients map[integrationapi.IntegrationID]metricapi.MetricClient
 	active  metricapi.MetricClient
+	// retryPeriod is accessed atomically, as it can be changed while retries are running.
+	retryPeriod int64
 }
 
 // AddClient implements metric manager interface. See MetricManager for more information.
@@ -86,25 +90,39 @@ func (self *metricManager) Enable(id integrationapi.IntegrationID) error {
 
 // EnableWithRetry implements metric manager interface. See MetricManager for more information.
 func (self *metricManager) EnableWithRetry(id integrationapi.IntegrationID, period time.Duration) {
-	go wait.Forever(func() {
-		metricClient, exists := self.clients[id]
-		if !exists {
-			log.Printf("Metric client with given id %s does not exist.", id)
-			return
+	self.SetRetryPeriod(period)
+	go func() {
+		for {
+			self.retryEnable(id)
+			time.Sleep(time.Duration(atomic.LoadInt64(&self.retryPeriod)) * time.Second)
 		}
+	}()
+}
 
-		err := metricClient.HealthCheck()
-		if err != nil {
-			self.active = nil
-			log.Printf("Metric client health check failed: %s. Retrying in %d seconds.", err, period)
-			return
-		}
+// SetRetryPeriod implements metric manager interface. See MetricManager for more information.
+func (self *metricManager) SetRetryPeriod(period time.Duration) {
+	atomic.StoreInt64(&self.retryPeriod, int64(period))
+}
 
-		if self.active == nil {
-			log.Printf("Successful request to %s", id)
-			self.active = metricClient
-		}
-	}, period*time.Second)
+func (self *metricManager) retryEnable(id integrationapi.IntegrationID) {
+	metricClient, exists := self.clients[id]
+	if !exists {
+		log.Printf("Metric client with given id %s does not exist.", id)
+		return
+	}
+
+	err := metricClient.HealthCheck()
+	if err != nil {
+		self.active = nil
+		log.Printf("Metric client health check failed: %s. Retrying in %d seconds.", err,
+			atomic.LoadInt64(&self.retryPeriod))
+		return
+	}
+
+	if self.active == nil {
+		log.Printf("Successful request to %s", id)
+		self.active = metricClient
+	}
 }
 
 // List implements metric manager interface. See MetricManager for more information.
@@ -15,6 +15,7 @@
 package metric
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -42,12 +43,12 @@ func (self FakeMetricClient) HealthCheck() error {
 	return errors.NewInvalid("test-error")
 }
 
-func (self FakeMetricClient) DownloadMetric(selectors []api.ResourceSelector, metricName string,
+func (self FakeMetricClient) DownloadMetric(ctx context.Context, selectors []api.ResourceSelector, metricName string,
 	cachedResources *api.CachedResources) api.MetricPromises {
 	return nil
 }
 
-func (self FakeMetricClient) DownloadMetrics(selectors []api.ResourceSelector, metricNames []string,
+func (self FakeMetricClient) DownloadMetrics(ctx context.Context, selectors []api.ResourceSelector, metricNames []string,
 	cachedResources *api.CachedResources) api.MetricPromises {
 	return nil
 }
@@ -55,24 +55,24 @@ func (self sidecarClient) ID() integrationapi.IntegrationID {
 // Implement MetricClient interface
 
 // DownloadMetrics implements metric client interface. See MetricClient for more information.
-func (self sidecarClient) DownloadMetrics(selectors []metricapi.ResourceSelector,
+func (self sidecarClient) DownloadMetrics(ctx context.Context, selectors []metricapi.ResourceSelector,
 	metricNames []string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
 	result := metricapi.MetricPromises{}
 	for _, metricName := range metricNames {
-		collectedMetrics := self.DownloadMetric(selectors, metricName, cachedResources)
+		collectedMetrics := self.DownloadMetric(ctx, selectors, metricName, cachedResources)
 		result = append(result, collectedMetrics...)
 	}
 	return result
 }
 
 // DownloadMetric implements metric client interface. See MetricClient for more information.
-func (self sidecarClient) DownloadMetric(selectors []metricapi.ResourceSelector,
+func (self sidecarClient) DownloadMetric(ctx context.Context, selectors []metricapi.ResourceSelector,
 	metricName string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
 	sidecarSelectors := getSidecarSelectors(selectors, cachedResources)
 
 	// Downloads metric in the fastest possible way by first compressing SidecarSelectors and later unpacking the result to separate boxes.
 	compressedSelectors, reverseMapping := compress(sidecarSelectors)
-	return self.downloadMetric(sidecarSelectors, compressedSelectors, reverseMapping, metricName)
+	return self.downloadMetric(ctx, sidecarSelectors, compressedSelectors, reverseMapping, metricName)
 }
 
 // AggregateMetrics implements metric client interface. See MetricClient for more information.
@@ -81,14 +81,14 @@ func (self sidecarClient) AggregateMetrics(metrics metricapi.MetricPromises, met
 	return common.AggregateMetricPromises(metrics, metricName, aggregations, nil)
 }
 
-func (self sidecarClient) downloadMetric(sidecarSelectors []sidecarSelector,
+func (self sidecarClient) downloadMetric(ctx context.Context, sidecarSelectors []sidecarSelector,
 	compressedSelectors []sidecarSelector, reverseMapping map[string][]int,
 	metricName string) metricapi.MetricPromises {
 	// collect all the required data (as promises)
 	unassignedResourcePromisesList := make([]metricapi.MetricPromises, len(compressedSelectors))
 	for selectorId, compressedSelector := range compressedSelectors {
 		unassignedResourcePromisesList[selectorId] =
-			self.downloadMetricForEachTargetResource(compressedSelector, metricName)
+			self.downloadMetricForEachTargetResource(ctx, compressedSelector, metricName)
 	}
 	// prepare final result
 	result := metricapi.NewMetricPromises(len(sidecarSelectors))
@@ -134,14 +134,15 @@ func (self sidecarClient) downloadMetric(sidecarSelectors []sidecarSelector,
 
 // downloadMetricForEachTargetResource downloads requested metric for each resource present in SidecarSelector
 // and returns the result as a list of promises - one promise for each resource. Order of promises returned is the same as order in self.Resources.
-func (self sidecarClient) downloadMetricForEachTargetResource(selector sidecarSelector, metricName string) metricapi.MetricPromises {
+func (self sidecarClient) downloadMetricForEachTargetResource(ctx context.Context, selector sidecarSelector,
+	metricName string) metricapi.MetricPromises {
 	var notAggregatedMetrics metricapi.MetricPromises
 	if SidecarAllInOneDownloadConfig[selector.TargetResourceType] {
-		notAggregatedMetrics = self.allInOneDownload(selector, metricName)
+		notAggregatedMetrics = self.allInOneDownload(ctx, selector, metricName)
 	} else {
 		notAggregatedMetrics = metricapi.MetricPromises{}
 		for i := range selector.Resources {
-			notAggregatedMetrics = append(notAggregatedMetrics, self.ithResourceDownload(selector, metricName, i))
+			notAggregatedMetrics = append(notAggregatedMetrics, self.ithResourceDownload(ctx, selector, metricName, i))
 		}
 	}
 	return notAggregatedMetrics
@@ -149,12 +150,12 @@ func (self sidecarClient) downloadMetricForEachTargetResource(selector sidecarSe
 
 // ithResourceDownload downloads metric for ith resource in self.Resources. Use only in case all in 1 download is not supported
 // for this resource type.
-func (self sidecarClient) ithResourceDownload(selector sidecarSelector, metricName string,
+func (self sidecarClient) ithResourceDownload(ctx context.Context, selector sidecarSelector, metricName string,
 	i int) metricapi.MetricPromise {
 	result := metricapi.NewMetricPromise()
 	go func() {
 		rawResult := metricapi.SidecarMetricResultList{}
-		err := self.unmarshalType(selector.Path+selector.Resources[i]+"/metrics/"+metricName, &rawResult)
+		err := self.unmarshalType(ctx, selector.Path+selector.Resources[i]+"/metrics/"+metricName, &rawResult)
 		if err != nil {
 			result.Metric <- nil
 			result.Error <- err
@@ -187,7 +188,8 @@ func (self sidecarClient) ithResourceDownload(selector sidecarSelector, metricNa
 
 // allInOneDownload downloads metrics for all resources present in self.Resources in one request.
 // returns a list of metric promises - one promise for each resource. Order of self.Resources is preserved.
-func (self sidecarClient) allInOneDownload(selector sidecarSelector, metricName string) metricapi.MetricPromises {
+func (self sidecarClient) allInOneDownload(ctx context.Context, selector sidecarSelector,
+	metricName string) metricapi.MetricPromises {
 	result := metricapi.NewMetricPromises(len(selector.Resources))
 	go func() {
 		if len(selector.Resources) == 0 {
@@ -195,7 +197,7 @@ func (self sidecarClient) allInOneDownload(selector sidecarSelector, metricName
 		}
 		rawResults := metricapi.SidecarMetricResultList{}
 
-		err := self.unmarshalType(selector.Path+strings.Join(selector.Resources, ",")+"/metrics/"+metricName, &rawResults)
+		err := self.unmarshalType(ctx, selector.Path+strings.Join(selector.Resources, ",")+"/metrics/"+metricName, &rawResults)
 
 		if err != nil {
 			result.PutMetrics(nil, err)
@@ -249,8 +251,8 @@ func (self sidecarClient) allInOneDownload(selector sidecarSelector, metricName
 
 // unmarshalType performs sidecar GET request to the specifies path and transfers
 // the data to the interface provided.
-func (self sidecarClient) unmarshalType(path string, v interface{}) error {
-	rawData, err := self.client.Get("/api/v1/dashboard/" + path).DoRaw(context.TODO())
+func (self sidecarClient) unmarshalType(ctx context.Context, path string, v interface{}) error {
+	rawData, err := self.client.Get("/api/v1/dashboard/" + path).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
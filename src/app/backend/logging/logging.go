@@ -0,0 +1,193 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/klog/v2"
+)
+
+// Format is the format of log entries.
+type Format string
+
+// List of supported log formats.
+const (
+	// FormatText writes log entries as plain text lines. It is the default.
+	FormatText Format = "text"
+
+	// FormatJSON writes every log entry as a single line JSON object.
+	FormatJSON Format = "json"
+)
+
+// Fields are structured key-value pairs of a log entry.
+type Fields map[string]interface{}
+
+// logger writes log entries of the standard log package, klog and Log in a single format.
+type logger struct {
+	mux    sync.Mutex
+	format Format
+	out    io.Writer
+}
+
+var std = &logger{format: FormatText}
+
+// Init configures the format of all log entries. Entries of the standard log package and of Kubernetes
+// client packages, which log through klog, are written to out in the given format.
+func Init(format Format, out io.Writer) error {
+	switch format {
+	case FormatText:
+		log.SetFlags(log.LstdFlags)
+		log.SetOutput(out)
+		klog.ClearLogger()
+	case FormatJSON:
+		log.SetFlags(0)
+		log.SetOutput(&stdWriter{})
+		klog.SetLogger(logr.New(&klogSink{}))
+	default:
+		return fmt.Errorf("unsupported log format: %s", format)
+	}
+
+	std.mux.Lock()
+	defer std.mux.Unlock()
+	std.format = format
+	std.out = out
+	return nil
+}
+
+// Log writes an informational entry with the given fields.
+func Log(msg string, fields Fields) {
+	std.write("info", msg, fields)
+}
+
+func (self *logger) write(level, msg string, fields Fields) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	if self.format != FormatJSON {
+		log.Print(formatText(msg, fields))
+		return
+	}
+
+	entry := make(map[string]interface{}, len(fields)+3)
+	for key, value := range fields {
+		entry[key] = value
+	}
+	entry["time"] = time.Now().Format(time.RFC3339Nano)
+	entry["level"] = level
+	entry["msg"] = msg
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		line, _ = json.Marshal(map[string]interface{}{"time": entry["time"], "level": level, "msg": msg,
+			"error": err.Error()})
+	}
+	self.out.Write(append(line, '\n'))
+}
+
+func formatText(msg string, fields Fields) string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := msg
+	for _, key := range keys {
+		result += fmt.Sprintf(" %s=%v", key, fields[key])
+	}
+	return result
+}
+
+// stdWriter turns lines written by the standard log package into JSON entries.
+type stdWriter struct{}
+
+// Write implements io.Writer interface.
+func (self *stdWriter) Write(p []byte) (int, error) {
+	std.write("info", strings.TrimSuffix(string(p), "\n"), nil)
+	return len(p), nil
+}
+
+// klogSink turns entries of klog into JSON entries.
+type klogSink struct {
+	name   string
+	values []interface{}
+}
+
+// Init implements logr.LogSink interface.
+func (self *klogSink) Init(info logr.RuntimeInfo) {}
+
+// Enabled implements logr.LogSink interface. Verbosity is already checked by klog.
+func (self *klogSink) Enabled(level int) bool {
+	return true
+}
+
+// Info implements logr.LogSink interface.
+func (self *klogSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	fields := self.fields(keysAndValues)
+	if level > 0 {
+		fields["v"] = level
+	}
+	std.write("info", msg, fields)
+}
+
+// Error implements logr.LogSink interface.
+func (self *klogSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	fields := self.fields(keysAndValues)
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	std.write("error", msg, fields)
+}
+
+// WithValues implements logr.LogSink interface.
+func (self *klogSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	values := append(append(make([]interface{}, 0, len(self.values)+len(keysAndValues)), self.values...),
+		keysAndValues...)
+	return &klogSink{name: self.name, values: values}
+}
+
+// WithName implements logr.LogSink interface.
+func (self *klogSink) WithName(name string) logr.LogSink {
+	if len(self.name) > 0 {
+		name = self.name + "." + name
+	}
+	return &klogSink{name: name, values: self.values}
+}
+
+func (self *klogSink) fields(keysAndValues []interface{}) Fields {
+	fields := Fields{}
+	if len(self.name) > 0 {
+		fields["logger"] = self.name
+	}
+	all := append(append(make([]interface{}, 0, len(self.values)+len(keysAndValues)), self.values...),
+		keysAndValues...)
+	for i := 0; i+1 < len(all); i += 2 {
+		value := all[i+1]
+		if err, ok := value.(error); ok {
+			value = err.Error()
+		}
+		fields[fmt.Sprint(all[i])] = value
+	}
+	return fields
+}
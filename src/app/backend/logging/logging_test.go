@@ -0,0 +1,84 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"k8s.io/klog/v2"
+)
+
+func TestInitShouldRejectUnknownFormat(t *testing.T) {
+	if err := Init("xml", os.Stdout); err == nil {
+		t.Error("Expected error for unknown format, got nil")
+	}
+}
+
+func TestJSONFormat(t *testing.T) {
+	out := &bytes.Buffer{}
+	if err := Init(FormatJSON, out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer Init(FormatText, os.Stdout)
+
+	Log("request", Fields{"status": 200, "route": "/api/v1/pod"})
+	log.Printf("plain %s", "message")
+	klog.ErrorS(errors.New("failed"), "klog message", "pod", "web")
+
+	expected := []map[string]interface{}{
+		{"level": "info", "msg": "request", "status": float64(200), "route": "/api/v1/pod"},
+		{"level": "info", "msg": "plain message"},
+		{"level": "error", "msg": "klog message", "pod": "web", "error": "failed"},
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d entries, got %s", len(expected), out.String())
+	}
+	for i, line := range lines {
+		entry := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Errorf("Expected JSON entry, got %s", line)
+			continue
+		}
+		if _, ok := entry["time"]; !ok {
+			t.Errorf("Expected time in entry %s", line)
+		}
+		for key, value := range expected[i] {
+			if entry[key] != value {
+				t.Errorf("Expected %s=%v in entry %s", key, value, line)
+			}
+		}
+	}
+}
+
+func TestTextFormat(t *testing.T) {
+	out := &bytes.Buffer{}
+	if err := Init(FormatText, out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer Init(FormatText, os.Stdout)
+
+	Log("request", Fields{"status": 200, "route": "/api/v1/pod"})
+
+	if expected := "request route=/api/v1/pod status=200\n"; !strings.HasSuffix(out.String(), expected) {
+		t.Errorf("Expected entry ending with %q, got %q", expected, out.String())
+	}
+}
@@ -55,7 +55,7 @@ func (h *Handler) handleConfig(request *restful.Request, response *restful.Respo
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := GetPluginList(pluginClient, "", dataSelect)
+	result, err := GetPluginList(request.Request.Context(), pluginClient, "", dataSelect)
 	if err != nil {
 		cfg.Status = statusCodeFromError(err)
 		cfg.Errors = append(cfg.Errors, err)
@@ -103,6 +103,10 @@ func (cm *fakeClientManager) InsecurePluginClient() versioned.Interface {
 	return cm.pluginClient
 }
 
+func (cm *fakeClientManager) InsecureConfig() *rest.Config {
+	panic("implement me")
+}
+
 func (cm *fakeClientManager) CanI(req *restful.Request, ssar *v1.SelfSubjectAccessReview) bool {
 	panic("implement me")
 }
@@ -115,11 +119,15 @@ func (cm *fakeClientManager) ClientCmdConfig(req *restful.Request) (clientcmd.Cl
 	panic("implement me")
 }
 
+func (cm *fakeClientManager) AuthInfo(req *restful.Request) (*api.AuthInfo, error) {
+	panic("implement me")
+}
+
 func (cm *fakeClientManager) CSRFKey() string {
 	panic("implement me")
 }
 
-func (cm *fakeClientManager) HasAccess(authInfo api.AuthInfo) (string, error) {
+func (cm *fakeClientManager) HasAccess(ctx context.Context, authInfo api.AuthInfo) (string, error) {
 	panic("implement me")
 }
 
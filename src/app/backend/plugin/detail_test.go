@@ -42,7 +42,7 @@ func TestGetPluginSource(t *testing.T) {
 	pcs := fakePluginClientset.NewSimpleClientset()
 	cs := fakeK8sClient.NewSimpleClientset()
 
-	_, err := GetPluginSource(pcs, cs, ns, pluginName)
+	_, err := GetPluginSource(context.TODO(), pcs, cs, ns, pluginName)
 	if err == nil {
 		t.Errorf("error 'plugins.dashboard.k8s.io \"%s\" not found' did not occur", pluginName)
 	}
@@ -57,7 +57,7 @@ func TestGetPluginSource(t *testing.T) {
 				Filename: filename}},
 	}, metaV1.CreateOptions{})
 
-	_, err = GetPluginSource(pcs, cs, ns, pluginName)
+	_, err = GetPluginSource(context.TODO(), pcs, cs, ns, pluginName)
 	if err == nil {
 		t.Errorf("error 'configmaps \"%s\" not found' did not occur", cfgMapName)
 	}
@@ -68,7 +68,7 @@ func TestGetPluginSource(t *testing.T) {
 		Data: map[string]string{filename: srcData},
 	}, v1.CreateOptions{})
 
-	data, err := GetPluginSource(pcs, cs, ns, pluginName)
+	data, err := GetPluginSource(context.TODO(), pcs, cs, ns, pluginName)
 	if err != nil {
 		t.Errorf("error while fetching plugin source: %s", err)
 	}
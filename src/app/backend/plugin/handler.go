@@ -72,7 +72,7 @@ func (h *Handler) handlePluginList(request *restful.Request, response *restful.R
 	namespace := request.PathParameter("namespace")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 
-	result, err := GetPluginList(pluginClient, namespace, dataSelect)
+	result, err := GetPluginList(request.Request.Context(), pluginClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -90,7 +90,7 @@ func (h *Handler) servePluginSource(request *restful.Request, response *restful.
 	pluginName := request.PathParameter("pluginName")
 	name := strings.TrimSuffix(pluginName, filepath.Ext(pluginName))
 
-	result, err := GetPluginSource(pluginClient, k8sClient, namespace, name)
+	result, err := GetPluginSource(request.Request.Context(), pluginClient, k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -61,8 +61,9 @@ func (p PluginCell) GetProperty(name dataselect.PropertyName) dataselect.Compara
 }
 
 // GetPluginList returns all the registered plugins
-func GetPluginList(client pluginclientset.Interface, ns string, dsQuery *dataselect.DataSelectQuery) (*PluginList, error) {
-	plugins, err := client.DashboardV1alpha1().Plugins(ns).List(context.TODO(), v1.ListOptions{})
+func GetPluginList(ctx context.Context, client pluginclientset.Interface, ns string,
+	dsQuery *dataselect.DataSelectQuery) (*PluginList, error) {
+	plugins, err := client.DashboardV1alpha1().Plugins(ns).List(ctx, v1.ListOptions{})
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return &PluginList{Items: []Plugin{}, Errors: []error{criticalError}}, nil
@@ -58,7 +58,7 @@ func TestGetPluginList(t *testing.T) {
 		FilterQuery: dataselect.NoFilter,
 		MetricQuery: dataselect.NoMetrics,
 	}
-	data, err := GetPluginList(pcs, ns, &dsQuery)
+	data, err := GetPluginList(context.TODO(), pcs, ns, &dsQuery)
 	if err != nil {
 		t.Errorf("error while fetching plugins: %s", err)
 	}
@@ -0,0 +1,247 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulk
+
+import (
+	"context"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/deployment"
+	"github.com/kubernetes/dashboard/src/app/backend/scaling"
+)
+
+// MaxTargets is the maximum number of targets of a single bulk operation.
+const MaxTargets = 500
+
+// maxConcurrentRequests limits the number of requests sent to the apiserver at the same time.
+const maxConcurrentRequests = 10
+
+// Action is an operation executed on every target of a bulk operation.
+type Action string
+
+// List of supported bulk actions.
+const (
+	// ActionDelete deletes the targets.
+	ActionDelete Action = "delete"
+
+	// ActionScale sets the number of replicas of the targets.
+	ActionScale Action = "scale"
+
+	// ActionRestart restarts the pods of the targets. Only deployments can be restarted.
+	ActionRestart Action = "restart"
+
+	// ActionLabel adds and removes labels of the targets.
+	ActionLabel Action = "label"
+
+	// ActionAnnotate adds and removes annotations of the targets.
+	ActionAnnotate Action = "annotate"
+)
+
+// Target identifies a single resource of a bulk operation.
+type Target struct {
+	Kind string `json:"kind"`
+
+	// Namespace of the resource. Empty for cluster-scoped kinds.
+	Namespace string `json:"namespace,omitempty"`
+
+	Name string `json:"name"`
+}
+
+// Operation is a specification of a bulk operation.
+type Operation struct {
+	Action  Action   `json:"action"`
+	Targets []Target `json:"targets"`
+
+	// PropagationPolicy of the delete action. Foreground deletion is used when nil.
+	PropagationPolicy *metaV1.DeletionPropagation `json:"propagationPolicy,omitempty"`
+
+	// Replicas is the desired number of replicas of the scale action.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Add contains labels or annotations set by the label and annotate actions.
+	Add map[string]string `json:"add,omitempty"`
+
+	// Remove contains keys of labels or annotations removed by the label and annotate actions.
+	Remove []string `json:"remove,omitempty"`
+}
+
+// ItemResult is a result of the bulk action on a single target.
+type ItemResult struct {
+	Target `json:",inline"`
+
+	Success bool `json:"success"`
+
+	// Error message of the failed action. Empty on success.
+	Error string `json:"error,omitempty"`
+}
+
+// Result is a result of a bulk operation.
+type Result struct {
+	Action Action `json:"action"`
+
+	// Results of the action in the order of the targets.
+	Items []ItemResult `json:"items"`
+
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+}
+
+// scaleResource is replaced in tests, as scaling requires a discovery of the scale subresources.
+var scaleResource = scaling.ScaleResource
+
+// Execute runs the action of the operation on all of its targets with bounded concurrency. An error is
+// returned only if the operation is invalid or targets a Dashboard protected resource, failures of single
+// targets are reported in the result.
+func Execute(ctx context.Context, client kubernetes.Interface, verber clientapi.ResourceVerber, cfg *rest.Config,
+	operation *Operation) (*Result, error) {
+	if err := validate(operation); err != nil {
+		return nil, err
+	}
+
+	patch, err := getMetadataPatch(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Action: operation.Action, Items: make([]ItemResult, len(operation.Targets))}
+	semaphore := make(chan struct{}, maxConcurrentRequests)
+	var wg sync.WaitGroup
+	for i, target := range operation.Targets {
+		wg.Add(1)
+		go func(i int, target Target) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			result.Items[i] = ItemResult{Target: target, Success: true}
+			if err := execute(ctx, client, verber, cfg, operation, patch, target); err != nil {
+				result.Items[i].Success = false
+				result.Items[i].Error = err.Error()
+			}
+		}(i, target)
+	}
+	wg.Wait()
+
+	for _, item := range result.Items {
+		if item.Success {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+
+	return result, nil
+}
+
+func execute(ctx context.Context, client kubernetes.Interface, verber clientapi.ResourceVerber, cfg *rest.Config,
+	operation *Operation, patch []byte, target Target) error {
+	namespaceSet := len(target.Namespace) > 0
+	switch operation.Action {
+	case ActionDelete:
+		return verber.Delete(ctx, target.Kind, namespaceSet, target.Namespace, target.Name,
+			clientapi.DeleteOptions{PropagationPolicy: operation.PropagationPolicy})
+	case ActionScale:
+		// The scale client modifies the config, so every request gets its own copy.
+		_, err := scaleResource(ctx, rest.CopyConfig(cfg), target.Kind, target.Namespace, target.Name,
+			strconv.Itoa(int(*operation.Replicas)))
+		return err
+	case ActionRestart:
+		if target.Kind != api.ResourceKindDeployment {
+			return errors.NewBadRequest(fmt.Sprintf("restart is not supported for kind %s", target.Kind))
+		}
+		_, err := deployment.RestartDeployment(ctx, client, target.Namespace, target.Name)
+		return err
+	default:
+		_, err := verber.Patch(ctx, target.Kind, namespaceSet, target.Namespace, target.Name, types.MergePatchType,
+			patch, clientapi.PatchOptions{})
+		return err
+	}
+}
+
+func validate(operation *Operation) error {
+	if len(operation.Targets) == 0 {
+		return errors.NewBadRequest("at least one target is required")
+	}
+	if len(operation.Targets) > MaxTargets {
+		return errors.NewBadRequest(fmt.Sprintf("number of targets exceeds the maximum of %d", MaxTargets))
+	}
+	for _, target := range operation.Targets {
+		if len(target.Kind) == 0 || len(target.Name) == 0 {
+			return errors.NewBadRequest("kind and name of every target are required")
+		}
+		// Targets are not part of the request url, so they are not checked by the restricted resources filter.
+		if authApi.ShouldRejectResource(target.Name, target.Namespace) {
+			return k8serrors.NewForbidden(schema.GroupResource{Resource: target.Kind}, target.Name,
+				goerrors.New(errors.MsgDashboardExclusiveResourceError))
+		}
+	}
+
+	switch operation.Action {
+	case ActionDelete, ActionRestart:
+		return nil
+	case ActionScale:
+		if operation.Replicas == nil || *operation.Replicas < 0 {
+			return errors.NewBadRequest("scale action requires a non-negative number of replicas")
+		}
+		return nil
+	case ActionLabel, ActionAnnotate:
+		if len(operation.Add) == 0 && len(operation.Remove) == 0 {
+			return errors.NewBadRequest(fmt.Sprintf("%s action requires keys to add or remove", operation.Action))
+		}
+		return nil
+	}
+
+	return errors.NewBadRequest(fmt.Sprintf("unsupported bulk action: %s", operation.Action))
+}
+
+// getMetadataPatch returns a JSON merge patch that adds and removes labels or annotations. Removed keys are
+// set to null. It returns nil for other actions.
+func getMetadataPatch(operation *Operation) ([]byte, error) {
+	field := "labels"
+	switch operation.Action {
+	case ActionLabel:
+	case ActionAnnotate:
+		field = "annotations"
+	default:
+		return nil, nil
+	}
+
+	values := make(map[string]interface{})
+	for _, key := range operation.Remove {
+		values[key] = nil
+	}
+	for key, value := range operation.Add {
+		values[key] = value
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{field: values},
+	})
+}
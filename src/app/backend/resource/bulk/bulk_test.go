@@ -0,0 +1,255 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulk
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/deployment"
+	"github.com/kubernetes/dashboard/src/app/backend/scaling"
+)
+
+type fakeVerber struct {
+	mux     sync.Mutex
+	calls   []string
+	missing string
+}
+
+func (self *fakeVerber) record(call, name string) error {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	self.calls = append(self.calls, call)
+	if name == self.missing {
+		return k8serrors.NewNotFound(schema.GroupResource{Resource: "jobs"}, name)
+	}
+	return nil
+}
+
+func (self *fakeVerber) Put(ctx context.Context, kind string, namespaceSet bool, namespace string, name string,
+	object *runtime.Unknown, options clientapi.PutOptions) (runtime.Object, error) {
+	return nil, nil
+}
+
+func (self *fakeVerber) Get(ctx context.Context, kind string, namespaceSet bool, namespace string, name string) (runtime.Object, error) {
+	return nil, nil
+}
+
+func (self *fakeVerber) List(ctx context.Context, kind string, namespaceSet bool, namespace string) (runtime.Object, error) {
+	return nil, nil
+}
+
+func (self *fakeVerber) Delete(ctx context.Context, kind string, namespaceSet bool, namespace string, name string,
+	options clientapi.DeleteOptions) error {
+	policy := ""
+	if options.PropagationPolicy != nil {
+		policy = string(*options.PropagationPolicy)
+	}
+	return self.record("delete "+kind+"/"+namespace+"/"+name+" "+policy, name)
+}
+
+func (self *fakeVerber) Patch(ctx context.Context, kind string, namespaceSet bool, namespace string, name string,
+	patchType types.PatchType, data []byte, options clientapi.PatchOptions) (runtime.Object, error) {
+	return nil, self.record("patch "+kind+"/"+namespace+"/"+name+" "+string(patchType)+" "+string(data), name)
+}
+
+func TestExecute(t *testing.T) {
+	background := metaV1.DeletePropagationBackground
+	replicas := int32(3)
+	cases := []struct {
+		info      string
+		operation *Operation
+		expected  []string
+		failed    []string
+	}{
+		{
+			info: "should delete targets with propagation policy",
+			operation: &Operation{
+				Action:            ActionDelete,
+				PropagationPolicy: &background,
+				Targets: []Target{{Kind: api.ResourceKindJob, Namespace: "ns", Name: "a"},
+					{Kind: api.ResourceKindJob, Namespace: "ns", Name: "missing"}},
+			},
+			expected: []string{"delete job/ns/a Background", "delete job/ns/missing Background"},
+			failed:   []string{"missing"},
+		},
+		{
+			info: "should add and remove labels",
+			operation: &Operation{
+				Action:  ActionLabel,
+				Add:     map[string]string{"team": "web"},
+				Remove:  []string{"old"},
+				Targets: []Target{{Kind: api.ResourceKindNamespace, Name: "ns"}},
+			},
+			expected: []string{`patch namespace//ns application/merge-patch+json ` +
+				`{"metadata":{"labels":{"old":null,"team":"web"}}}`},
+		},
+		{
+			info: "should add annotations",
+			operation: &Operation{
+				Action:  ActionAnnotate,
+				Add:     map[string]string{"owner": "me"},
+				Targets: []Target{{Kind: api.ResourceKindService, Namespace: "ns", Name: "svc"}},
+			},
+			expected: []string{`patch service/ns/svc application/merge-patch+json ` +
+				`{"metadata":{"annotations":{"owner":"me"}}}`},
+		},
+		{
+			info: "should scale targets",
+			operation: &Operation{
+				Action:   ActionScale,
+				Replicas: &replicas,
+				Targets:  []Target{{Kind: api.ResourceKindDeployment, Namespace: "ns", Name: "web"}},
+			},
+			expected: []string{"scale deployment/ns/web 3"},
+		},
+		{
+			info: "should restart deployments only",
+			operation: &Operation{
+				Action: ActionRestart,
+				Targets: []Target{{Kind: api.ResourceKindDeployment, Namespace: "ns", Name: "web"},
+					{Kind: api.ResourceKindJob, Namespace: "ns", Name: "job"},
+					{Kind: api.ResourceKindDeployment, Namespace: "ns", Name: "missing"}},
+			},
+			failed: []string{"job", "missing"},
+		},
+	}
+
+	for _, c := range cases {
+		verber := &fakeVerber{missing: "missing"}
+		client := fake.NewSimpleClientset(&apps.Deployment{ObjectMeta: metaV1.ObjectMeta{Name: "web", Namespace: "ns"}})
+		scaleResource = func(ctx context.Context, cfg *rest.Config, kind, namespace, name, count string) (*scaling.ReplicaCounts, error) {
+			return nil, verber.record("scale "+kind+"/"+namespace+"/"+name+" "+count, name)
+		}
+
+		result, err := Execute(context.TODO(), client, verber, &rest.Config{}, c.operation)
+		if err != nil {
+			t.Errorf("Test Case: %s. Unexpected error: %v", c.info, err)
+			continue
+		}
+
+		sort.Strings(verber.calls)
+		if c.expected != nil && !reflect.DeepEqual(verber.calls, c.expected) {
+			t.Errorf("Test Case: %s. Expected calls %v, got %v", c.info, c.expected, verber.calls)
+		}
+
+		failed := make([]string, 0)
+		for i, item := range result.Items {
+			if item.Target != c.operation.Targets[i] {
+				t.Errorf("Test Case: %s. Expected result %d for %v, got %v", c.info, i, c.operation.Targets[i],
+					item.Target)
+			}
+			if !item.Success {
+				failed = append(failed, item.Name)
+				if len(item.Error) == 0 {
+					t.Errorf("Test Case: %s. Expected error message for %s", c.info, item.Name)
+				}
+			}
+		}
+		if c.failed == nil {
+			c.failed = make([]string, 0)
+		}
+		if !reflect.DeepEqual(failed, c.failed) {
+			t.Errorf("Test Case: %s. Expected failed targets %v, got %v", c.info, c.failed, failed)
+		}
+		if result.Failed != len(c.failed) || result.Succeeded != len(c.operation.Targets)-len(c.failed) {
+			t.Errorf("Test Case: %s. Unexpected counts %d succeeded, %d failed", c.info, result.Succeeded,
+				result.Failed)
+		}
+	}
+	scaleResource = scaling.ScaleResource
+}
+
+func TestExecuteShouldRestartDeployment(t *testing.T) {
+	client := fake.NewSimpleClientset(&apps.Deployment{ObjectMeta: metaV1.ObjectMeta{Name: "web", Namespace: "ns"}})
+	operation := &Operation{Action: ActionRestart,
+		Targets: []Target{{Kind: api.ResourceKindDeployment, Namespace: "ns", Name: "web"}}}
+
+	if _, err := Execute(context.TODO(), client, &fakeVerber{}, nil, operation); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	actual, _ := client.AppsV1().Deployments("ns").Get(context.TODO(), "web", metaV1.GetOptions{})
+	if _, ok := actual.Spec.Template.Annotations[deployment.RestartedAtAnnotationKey]; !ok {
+		t.Errorf("Expected deployment to be restarted, got annotations %v", actual.Spec.Template.Annotations)
+	}
+}
+
+func TestExecuteShouldValidateOperation(t *testing.T) {
+	negative := int32(-1)
+	target := []Target{{Kind: api.ResourceKindJob, Namespace: "ns", Name: "a"}}
+	cases := []struct {
+		info      string
+		operation *Operation
+	}{
+		{"no targets", &Operation{Action: ActionDelete}},
+		{"too many targets", &Operation{Action: ActionDelete, Targets: make([]Target, MaxTargets+1)}},
+		{"target without name", &Operation{Action: ActionDelete, Targets: []Target{{Kind: api.ResourceKindJob}}}},
+		{"scale without replicas", &Operation{Action: ActionScale, Targets: target}},
+		{"scale to negative replicas", &Operation{Action: ActionScale, Replicas: &negative, Targets: target}},
+		{"label without keys", &Operation{Action: ActionLabel, Targets: target}},
+		{"unknown action", &Operation{Action: "drain", Targets: target}},
+	}
+
+	for _, c := range cases {
+		verber := &fakeVerber{}
+		if _, err := Execute(context.TODO(), nil, verber, nil, c.operation); err == nil {
+			t.Errorf("Test Case: %s. Expected error, got nil", c.info)
+		}
+		if len(verber.calls) > 0 {
+			t.Errorf("Test Case: %s. Expected no calls, got %v", c.info, verber.calls)
+		}
+	}
+}
+
+func TestExecuteShouldRejectProtectedResources(t *testing.T) {
+	cases := []struct {
+		info   string
+		action Action
+	}{
+		{"delete", ActionDelete},
+		{"label", ActionLabel},
+		{"annotate", ActionAnnotate},
+	}
+
+	for _, c := range cases {
+		verber := &fakeVerber{}
+		operation := &Operation{Action: c.action, Add: map[string]string{"a": "b"}, Targets: []Target{
+			{Kind: api.ResourceKindSecret, Namespace: "ns", Name: "other"},
+			{Kind: api.ResourceKindSecret, Namespace: "kubernetes-dashboard", Name: authApi.EncryptionKeyHolderName},
+		}}
+		if _, err := Execute(context.TODO(), nil, verber, nil, operation); !k8serrors.IsForbidden(err) {
+			t.Errorf("Test Case: %s. Expected forbidden error, got %v", c.info, err)
+		}
+		if len(verber.calls) > 0 {
+			t.Errorf("Test Case: %s. Expected no calls, got %v", c.info, verber.calls)
+		}
+	}
+}
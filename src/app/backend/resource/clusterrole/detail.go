@@ -34,8 +34,8 @@ type ClusterRoleDetail struct {
 }
 
 // GetClusterRoleDetail gets Cluster Role details.
-func GetClusterRoleDetail(client k8sClient.Interface, name string) (*ClusterRoleDetail, error) {
-	rawObject, err := client.RbacV1().ClusterRoles().Get(context.TODO(), name, metaV1.GetOptions{})
+func GetClusterRoleDetail(ctx context.Context, client k8sClient.Interface, name string) (*ClusterRoleDetail, error) {
+	rawObject, err := client.RbacV1().ClusterRoles().Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@
 package clusterrole
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -38,10 +39,10 @@ type ClusterRole struct {
 	TypeMeta   api.TypeMeta   `json:"typeMeta"`
 }
 
-func GetClusterRoleList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*ClusterRoleList, error) {
+func GetClusterRoleList(ctx context.Context, client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*ClusterRoleList, error) {
 	log.Println("Getting list of RBAC roles")
 	channels := &common.ResourceChannels{
-		ClusterRoleList: common.GetClusterRoleListChannel(client, 1),
+		ClusterRoleList: common.GetClusterRoleListChannelWithOptions(ctx, client, dsQuery.ListOptions(), 1),
 	}
 
 	return GetClusterRoleListFromChannels(channels, dsQuery)
@@ -56,6 +57,7 @@ func GetClusterRoleListFromChannels(channels *common.ResourceChannels, dsQuery *
 	}
 
 	result := toClusterRoleLists(clusterRoles.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, clusterRoles)
 	return result, nil
 }
 
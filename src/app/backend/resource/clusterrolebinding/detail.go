@@ -36,8 +36,8 @@ type ClusterRoleBindingDetail struct {
 }
 
 // GetClusterRoleBindingDetail gets ClusterRoleBinding details.
-func GetClusterRoleBindingDetail(client k8sClient.Interface, name string) (*ClusterRoleBindingDetail, error) {
-	rawObject, err := client.RbacV1().ClusterRoleBindings().Get(context.TODO(), name, metaV1.GetOptions{})
+func GetClusterRoleBindingDetail(ctx context.Context, client k8sClient.Interface, name string) (*ClusterRoleBindingDetail, error) {
+	rawObject, err := client.RbacV1().ClusterRoleBindings().Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@
 package clusterrolebinding
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -42,10 +43,10 @@ type ClusterRoleBinding struct {
 }
 
 // GetClusterRoleBindingList returns a list of all ClusterRoleBindings in the cluster.
-func GetClusterRoleBindingList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*ClusterRoleBindingList, error) {
+func GetClusterRoleBindingList(ctx context.Context, client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*ClusterRoleBindingList, error) {
 	log.Print("Getting list of all clusterRoleBindings in the cluster")
 	channels := &common.ResourceChannels{
-		ClusterRoleBindingList: common.GetClusterRoleBindingListChannel(client, 1),
+		ClusterRoleBindingList: common.GetClusterRoleBindingListChannelWithOptions(ctx, client, dsQuery.ListOptions(), 1),
 	}
 
 	return GetClusterRoleBindingListFromChannels(channels, dsQuery)
@@ -61,6 +62,7 @@ func GetClusterRoleBindingListFromChannels(channels *common.ResourceChannels, ds
 		return nil, criticalError
 	}
 	clusterRoleBindingList := toClusterRoleBindingList(clusterRoleBindings.Items, nonCriticalErrors, dsQuery)
+	clusterRoleBindingList.ListMeta = dsQuery.CursorListMeta(clusterRoleBindingList.ListMeta, clusterRoleBindings)
 	return clusterRoleBindingList, nil
 }
 
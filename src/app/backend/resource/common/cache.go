@@ -0,0 +1,44 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"log"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	client "k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/cache"
+)
+
+// listFromCache fills given list with objects of given resource read from the resource cache. It returns false
+// if the list could not be served from the cache and has to be read directly from the apiserver.
+func listFromCache(client client.Interface, resource schema.GroupVersionResource, namespace string,
+	options metaV1.ListOptions, list runtime.Object) bool {
+	objects, ok := cache.List(client, resource, namespace, options)
+	if !ok {
+		return false
+	}
+
+	if err := meta.SetList(list, objects); err != nil {
+		log.Printf("Could not read %s list from cache: %s", resource.Resource, err.Error())
+		return false
+	}
+
+	return true
+}
@@ -136,15 +136,24 @@ type ServiceListChannel struct {
 
 // GetServiceListChannel returns a pair of channels to a Service list and errors that both
 // must be read numReads times.
-func GetServiceListChannel(client client.Interface, nsQuery *NamespaceQuery,
+func GetServiceListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
 	numReads int) ServiceListChannel {
+	return GetServiceListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
 
+// GetServiceListChannelWithOptions is GetServiceListChannel plus list options.
+func GetServiceListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) ServiceListChannel {
 	channel := ServiceListChannel{
 		List:  make(chan *v1.ServiceList, numReads),
 		Error: make(chan error, numReads),
 	}
 	go func() {
-		list, err := client.CoreV1().Services(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list := &v1.ServiceList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("services"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.CoreV1().Services(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []v1.Service
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -169,15 +178,24 @@ type IngressListChannel struct {
 
 // GetIngressListChannel returns a pair of channels to an Ingress list and errors that both
 // must be read numReads times.
-func GetIngressListChannel(client client.Interface, nsQuery *NamespaceQuery,
+func GetIngressListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
 	numReads int) IngressListChannel {
+	return GetIngressListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
 
+// GetIngressListChannelWithOptions is GetIngressListChannel plus list options.
+func GetIngressListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) IngressListChannel {
 	channel := IngressListChannel{
 		List:  make(chan *networkingv1.IngressList, numReads),
 		Error: make(chan error, numReads),
 	}
 	go func() {
-		list, err := client.NetworkingV1().Ingresses(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list := &networkingv1.IngressList{}
+		var err error
+		if !listFromCache(client, networkingv1.SchemeGroupVersion.WithResource("ingresses"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.NetworkingV1().Ingresses(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []networkingv1.Ingress
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -202,16 +220,25 @@ type LimitRangeListChannel struct {
 
 // GetLimitRangeListChannel returns a pair of channels to a LimitRange list and errors that
 // both must be read numReads times.
-func GetLimitRangeListChannel(client client.Interface, nsQuery *NamespaceQuery,
+func GetLimitRangeListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
 	numReads int) LimitRangeListChannel {
+	return GetLimitRangeListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
 
+// GetLimitRangeListChannelWithOptions is GetLimitRangeListChannel plus list options.
+func GetLimitRangeListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) LimitRangeListChannel {
 	channel := LimitRangeListChannel{
 		List:  make(chan *v1.LimitRangeList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().LimitRanges(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list := &v1.LimitRangeList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("limitranges"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.CoreV1().LimitRanges(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -229,14 +256,23 @@ type NodeListChannel struct {
 
 // GetNodeListChannel returns a pair of channels to a Node list and errors that both must be read
 // numReads times.
-func GetNodeListChannel(client client.Interface, numReads int) NodeListChannel {
+func GetNodeListChannel(ctx context.Context, client client.Interface, numReads int) NodeListChannel {
+	return GetNodeListChannelWithOptions(ctx, client, api.ListEverything, numReads)
+}
+
+// GetNodeListChannelWithOptions is GetNodeListChannel plus list options.
+func GetNodeListChannelWithOptions(ctx context.Context, client client.Interface, options metaV1.ListOptions, numReads int) NodeListChannel {
 	channel := NodeListChannel{
 		List:  make(chan *v1.NodeList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().Nodes().List(context.TODO(), api.ListEverything)
+		list := &v1.NodeList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("nodes"), "", options, list) {
+			list, err = client.CoreV1().Nodes().List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -255,14 +291,23 @@ type NamespaceListChannel struct {
 // GetNamespaceListChannel returns a pair of channels to a Namespace list and errors that both must
 // be read
 // numReads times.
-func GetNamespaceListChannel(client client.Interface, numReads int) NamespaceListChannel {
+func GetNamespaceListChannel(ctx context.Context, client client.Interface, numReads int) NamespaceListChannel {
+	return GetNamespaceListChannelWithOptions(ctx, client, api.ListEverything, numReads)
+}
+
+// GetNamespaceListChannelWithOptions is GetNamespaceListChannel plus list options.
+func GetNamespaceListChannelWithOptions(ctx context.Context, client client.Interface, options metaV1.ListOptions, numReads int) NamespaceListChannel {
 	channel := NamespaceListChannel{
 		List:  make(chan *v1.NamespaceList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().Namespaces().List(context.TODO(), api.ListEverything)
+		list := &v1.NamespaceList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("namespaces"), "", options, list) {
+			list, err = client.CoreV1().Namespaces().List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -280,13 +325,13 @@ type EventListChannel struct {
 
 // GetEventListChannel returns a pair of channels to an Event list and errors that both must be read
 // numReads times.
-func GetEventListChannel(client client.Interface,
+func GetEventListChannel(ctx context.Context, client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) EventListChannel {
-	return GetEventListChannelWithOptions(client, nsQuery, api.ListEverything, numReads)
+	return GetEventListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
 }
 
 // GetEventListChannelWithOptions is GetEventListChannel plus list options.
-func GetEventListChannelWithOptions(client client.Interface,
+func GetEventListChannelWithOptions(ctx context.Context, client client.Interface,
 	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) EventListChannel {
 	channel := EventListChannel{
 		List:  make(chan *v1.EventList, numReads),
@@ -294,7 +339,11 @@ func GetEventListChannelWithOptions(client client.Interface,
 	}
 
 	go func() {
-		list, err := client.CoreV1().Events(nsQuery.ToRequestParam()).List(context.TODO(), options)
+		list := &v1.EventList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("events"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.CoreV1().Events(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []v1.Event
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -317,12 +366,12 @@ type EndpointListChannel struct {
 	Error chan error
 }
 
-func GetEndpointListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) EndpointListChannel {
-	return GetEndpointListChannelWithOptions(client, nsQuery, api.ListEverything, numReads)
+func GetEndpointListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery, numReads int) EndpointListChannel {
+	return GetEndpointListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
 }
 
 // GetEndpointListChannelWithOptions is GetEndpointListChannel plus list options.
-func GetEndpointListChannelWithOptions(client client.Interface,
+func GetEndpointListChannelWithOptions(ctx context.Context, client client.Interface,
 	nsQuery *NamespaceQuery, opt metaV1.ListOptions, numReads int) EndpointListChannel {
 	channel := EndpointListChannel{
 		List:  make(chan *v1.EndpointsList, numReads),
@@ -330,7 +379,11 @@ func GetEndpointListChannelWithOptions(client client.Interface,
 	}
 
 	go func() {
-		list, err := client.CoreV1().Endpoints(nsQuery.ToRequestParam()).List(context.TODO(), opt)
+		list := &v1.EndpointsList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("endpoints"), nsQuery.ToRequestParam(), opt, list) {
+			list, err = client.CoreV1().Endpoints(nsQuery.ToRequestParam()).List(ctx, opt)
+		}
 
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
@@ -349,13 +402,13 @@ type PodListChannel struct {
 
 // GetPodListChannel returns a pair of channels to a Pod list and errors that both must be read
 // numReads times.
-func GetPodListChannel(client client.Interface,
+func GetPodListChannel(ctx context.Context, client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) PodListChannel {
-	return GetPodListChannelWithOptions(client, nsQuery, api.ListEverything, numReads)
+	return GetPodListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
 }
 
 // GetPodListChannelWithOptions is GetPodListChannel plus listing options.
-func GetPodListChannelWithOptions(client client.Interface, nsQuery *NamespaceQuery,
+func GetPodListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
 	options metaV1.ListOptions, numReads int) PodListChannel {
 
 	channel := PodListChannel{
@@ -364,7 +417,11 @@ func GetPodListChannelWithOptions(client client.Interface, nsQuery *NamespaceQue
 	}
 
 	go func() {
-		list, err := client.CoreV1().Pods(nsQuery.ToRequestParam()).List(context.TODO(), options)
+		list := &v1.PodList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("pods"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.CoreV1().Pods(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []v1.Pod
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -390,17 +447,25 @@ type ReplicationControllerListChannel struct {
 // GetReplicationControllerListChannel Returns a pair of channels to a
 // Replication Controller list and errors that both must be read
 // numReads times.
-func GetReplicationControllerListChannel(client client.Interface,
+func GetReplicationControllerListChannel(ctx context.Context, client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) ReplicationControllerListChannel {
+	return GetReplicationControllerListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
 
+// GetReplicationControllerListChannelWithOptions is GetReplicationControllerListChannel plus list options.
+func GetReplicationControllerListChannelWithOptions(ctx context.Context, client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) ReplicationControllerListChannel {
 	channel := ReplicationControllerListChannel{
 		List:  make(chan *v1.ReplicationControllerList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().ReplicationControllers(nsQuery.ToRequestParam()).
-			List(context.TODO(), api.ListEverything)
+		list := &v1.ReplicationControllerList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("replicationcontrollers"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.CoreV1().ReplicationControllers(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []v1.ReplicationController
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -425,17 +490,25 @@ type DeploymentListChannel struct {
 
 // GetDeploymentListChannel returns a pair of channels to a Deployment list and errors
 // that both must be read numReads times.
-func GetDeploymentListChannel(client client.Interface,
+func GetDeploymentListChannel(ctx context.Context, client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) DeploymentListChannel {
+	return GetDeploymentListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
 
+// GetDeploymentListChannelWithOptions is GetDeploymentListChannel plus list options.
+func GetDeploymentListChannelWithOptions(ctx context.Context, client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) DeploymentListChannel {
 	channel := DeploymentListChannel{
 		List:  make(chan *apps.DeploymentList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.AppsV1().Deployments(nsQuery.ToRequestParam()).
-			List(context.TODO(), api.ListEverything)
+		list := &apps.DeploymentList{}
+		var err error
+		if !listFromCache(client, apps.SchemeGroupVersion.WithResource("deployments"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.AppsV1().Deployments(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []apps.Deployment
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -460,14 +533,14 @@ type ReplicaSetListChannel struct {
 
 // GetReplicaSetListChannel returns a pair of channels to a ReplicaSet list and
 // errors that both must be read numReads times.
-func GetReplicaSetListChannel(client client.Interface,
+func GetReplicaSetListChannel(ctx context.Context, client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) ReplicaSetListChannel {
-	return GetReplicaSetListChannelWithOptions(client, nsQuery, api.ListEverything, numReads)
+	return GetReplicaSetListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
 }
 
 // GetReplicaSetListChannelWithOptions returns a pair of channels to a ReplicaSet list filtered
 // by provided options and errors that both must be read numReads times.
-func GetReplicaSetListChannelWithOptions(client client.Interface, nsQuery *NamespaceQuery,
+func GetReplicaSetListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
 	options metaV1.ListOptions, numReads int) ReplicaSetListChannel {
 	channel := ReplicaSetListChannel{
 		List:  make(chan *apps.ReplicaSetList, numReads),
@@ -475,8 +548,11 @@ func GetReplicaSetListChannelWithOptions(client client.Interface, nsQuery *Names
 	}
 
 	go func() {
-		list, err := client.AppsV1().ReplicaSets(nsQuery.ToRequestParam()).
-			List(context.TODO(), options)
+		list := &apps.ReplicaSetList{}
+		var err error
+		if !listFromCache(client, apps.SchemeGroupVersion.WithResource("replicasets"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.AppsV1().ReplicaSets(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []apps.ReplicaSet
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -501,14 +577,23 @@ type DaemonSetListChannel struct {
 
 // GetDaemonSetListChannel returns a pair of channels to a DaemonSet list and errors that both must be read
 // numReads times.
-func GetDaemonSetListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) DaemonSetListChannel {
+func GetDaemonSetListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery, numReads int) DaemonSetListChannel {
+	return GetDaemonSetListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetDaemonSetListChannelWithOptions is GetDaemonSetListChannel plus list options.
+func GetDaemonSetListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) DaemonSetListChannel {
 	channel := DaemonSetListChannel{
 		List:  make(chan *apps.DaemonSetList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.AppsV1().DaemonSets(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list := &apps.DaemonSetList{}
+		var err error
+		if !listFromCache(client, apps.SchemeGroupVersion.WithResource("daemonsets"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.AppsV1().DaemonSets(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []apps.DaemonSet
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -532,15 +617,25 @@ type JobListChannel struct {
 }
 
 // GetJobListChannel returns a pair of channels to a Job list and errors that both must be read numReads times.
-func GetJobListChannel(client client.Interface,
+func GetJobListChannel(ctx context.Context, client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) JobListChannel {
+	return GetJobListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetJobListChannelWithOptions is GetJobListChannel plus list options.
+func GetJobListChannelWithOptions(ctx context.Context, client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) JobListChannel {
 	channel := JobListChannel{
 		List:  make(chan *batch.JobList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.BatchV1().Jobs(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list := &batch.JobList{}
+		var err error
+		if !listFromCache(client, batch.SchemeGroupVersion.WithResource("jobs"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.BatchV1().Jobs(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []batch.Job
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -564,14 +659,23 @@ type CronJobListChannel struct {
 }
 
 // GetCronJobListChannel returns a pair of channels to a Cron Job list and errors that both must be read numReads times.
-func GetCronJobListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) CronJobListChannel {
+func GetCronJobListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery, numReads int) CronJobListChannel {
+	return GetCronJobListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetCronJobListChannelWithOptions is GetCronJobListChannel plus list options.
+func GetCronJobListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) CronJobListChannel {
 	channel := CronJobListChannel{
 		List:  make(chan *batch2.CronJobList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.BatchV1beta1().CronJobs(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list := &batch2.CronJobList{}
+		var err error
+		if !listFromCache(client, batch2.SchemeGroupVersion.WithResource("cronjobs"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.BatchV1beta1().CronJobs(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []batch2.CronJob
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -596,15 +700,25 @@ type StatefulSetListChannel struct {
 
 // GetStatefulSetListChannel returns a pair of channels to a StatefulSet list and errors that both must be read
 // numReads times.
-func GetStatefulSetListChannel(client client.Interface,
+func GetStatefulSetListChannel(ctx context.Context, client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) StatefulSetListChannel {
+	return GetStatefulSetListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetStatefulSetListChannelWithOptions is GetStatefulSetListChannel plus list options.
+func GetStatefulSetListChannelWithOptions(ctx context.Context, client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) StatefulSetListChannel {
 	channel := StatefulSetListChannel{
 		List:  make(chan *apps.StatefulSetList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		statefulSets, err := client.AppsV1().StatefulSets(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		statefulSets := &apps.StatefulSetList{}
+		var err error
+		if !listFromCache(client, apps.SchemeGroupVersion.WithResource("statefulsets"), nsQuery.ToRequestParam(), options, statefulSets) {
+			statefulSets, err = client.AppsV1().StatefulSets(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []apps.StatefulSet
 		for _, item := range statefulSets.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -629,16 +743,25 @@ type ConfigMapListChannel struct {
 
 // GetConfigMapListChannel returns a pair of channels to a ConfigMap list and errors that both must be read
 // numReads times.
-func GetConfigMapListChannel(client client.Interface, nsQuery *NamespaceQuery,
+func GetConfigMapListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
 	numReads int) ConfigMapListChannel {
+	return GetConfigMapListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
 
+// GetConfigMapListChannelWithOptions is GetConfigMapListChannel plus list options.
+func GetConfigMapListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) ConfigMapListChannel {
 	channel := ConfigMapListChannel{
 		List:  make(chan *v1.ConfigMapList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().ConfigMaps(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list := &v1.ConfigMapList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("configmaps"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.CoreV1().ConfigMaps(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		var filteredItems []v1.ConfigMap
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -663,16 +786,21 @@ type SecretListChannel struct {
 
 // GetSecretListChannel returns a pair of channels to a Secret list and errors that
 // both must be read numReads times.
-func GetSecretListChannel(client client.Interface, nsQuery *NamespaceQuery,
+func GetSecretListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
 	numReads int) SecretListChannel {
+	return GetSecretListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
 
+// GetSecretListChannelWithOptions is GetSecretListChannel plus list options.
+func GetSecretListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) SecretListChannel {
 	channel := SecretListChannel{
 		List:  make(chan *v1.SecretList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().Secrets(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list, err := client.CoreV1().Secrets(nsQuery.ToRequestParam()).List(ctx, options)
 		var filteredItems []v1.Secret
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -697,14 +825,23 @@ type RoleListChannel struct {
 
 // GetRoleListChannel returns a pair of channels to a Role list for a namespace and errors that
 // both must be read numReads times.
-func GetRoleListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) RoleListChannel {
+func GetRoleListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery, numReads int) RoleListChannel {
+	return GetRoleListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetRoleListChannelWithOptions is GetRoleListChannel plus list options.
+func GetRoleListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) RoleListChannel {
 	channel := RoleListChannel{
 		List:  make(chan *rbac.RoleList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.RbacV1().Roles(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list := &rbac.RoleList{}
+		var err error
+		if !listFromCache(client, rbac.SchemeGroupVersion.WithResource("roles"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.RbacV1().Roles(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -722,14 +859,23 @@ type ClusterRoleListChannel struct {
 
 // GetClusterRoleListChannel returns a pair of channels to a ClusterRole list and errors that
 // both must be read numReads times.
-func GetClusterRoleListChannel(client client.Interface, numReads int) ClusterRoleListChannel {
+func GetClusterRoleListChannel(ctx context.Context, client client.Interface, numReads int) ClusterRoleListChannel {
+	return GetClusterRoleListChannelWithOptions(ctx, client, api.ListEverything, numReads)
+}
+
+// GetClusterRoleListChannelWithOptions is GetClusterRoleListChannel plus list options.
+func GetClusterRoleListChannelWithOptions(ctx context.Context, client client.Interface, options metaV1.ListOptions, numReads int) ClusterRoleListChannel {
 	channel := ClusterRoleListChannel{
 		List:  make(chan *rbac.ClusterRoleList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.RbacV1().ClusterRoles().List(context.TODO(), api.ListEverything)
+		list := &rbac.ClusterRoleList{}
+		var err error
+		if !listFromCache(client, rbac.SchemeGroupVersion.WithResource("clusterroles"), "", options, list) {
+			list, err = client.RbacV1().ClusterRoles().List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -747,14 +893,23 @@ type RoleBindingListChannel struct {
 
 // GetRoleBindingListChannel returns a pair of channels to a RoleBinding list for a namespace and errors that
 // both must be read numReads times.
-func GetRoleBindingListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) RoleBindingListChannel {
+func GetRoleBindingListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery, numReads int) RoleBindingListChannel {
+	return GetRoleBindingListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetRoleBindingListChannelWithOptions is GetRoleBindingListChannel plus list options.
+func GetRoleBindingListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) RoleBindingListChannel {
 	channel := RoleBindingListChannel{
 		List:  make(chan *rbac.RoleBindingList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.RbacV1().RoleBindings(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list := &rbac.RoleBindingList{}
+		var err error
+		if !listFromCache(client, rbac.SchemeGroupVersion.WithResource("rolebindings"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.RbacV1().RoleBindings(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -772,15 +927,25 @@ type ClusterRoleBindingListChannel struct {
 
 // GetClusterRoleBindingListChannel returns a pair of channels to a ClusterRoleBinding list and
 // errors that both must be read numReads times.
-func GetClusterRoleBindingListChannel(client client.Interface,
+func GetClusterRoleBindingListChannel(ctx context.Context, client client.Interface,
 	numReads int) ClusterRoleBindingListChannel {
+	return GetClusterRoleBindingListChannelWithOptions(ctx, client, api.ListEverything, numReads)
+}
+
+// GetClusterRoleBindingListChannelWithOptions is GetClusterRoleBindingListChannel plus list options.
+func GetClusterRoleBindingListChannelWithOptions(ctx context.Context, client client.Interface,
+	options metaV1.ListOptions, numReads int) ClusterRoleBindingListChannel {
 	channel := ClusterRoleBindingListChannel{
 		List:  make(chan *rbac.ClusterRoleBindingList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.RbacV1().ClusterRoleBindings().List(context.TODO(), api.ListEverything)
+		list := &rbac.ClusterRoleBindingList{}
+		var err error
+		if !listFromCache(client, rbac.SchemeGroupVersion.WithResource("clusterrolebindings"), "", options, list) {
+			list, err = client.RbacV1().ClusterRoleBindings().List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -798,15 +963,25 @@ type PersistentVolumeListChannel struct {
 
 // GetPersistentVolumeListChannel returns a pair of channels to a PersistentVolume list and errors
 // that both must be read numReads times.
-func GetPersistentVolumeListChannel(client client.Interface,
+func GetPersistentVolumeListChannel(ctx context.Context, client client.Interface,
 	numReads int) PersistentVolumeListChannel {
+	return GetPersistentVolumeListChannelWithOptions(ctx, client, api.ListEverything, numReads)
+}
+
+// GetPersistentVolumeListChannelWithOptions is GetPersistentVolumeListChannel plus list options.
+func GetPersistentVolumeListChannelWithOptions(ctx context.Context, client client.Interface,
+	options metaV1.ListOptions, numReads int) PersistentVolumeListChannel {
 	channel := PersistentVolumeListChannel{
 		List:  make(chan *v1.PersistentVolumeList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().PersistentVolumes().List(context.TODO(), api.ListEverything)
+		list := &v1.PersistentVolumeList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("persistentvolumes"), "", options, list) {
+			list, err = client.CoreV1().PersistentVolumes().List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -824,16 +999,25 @@ type PersistentVolumeClaimListChannel struct {
 
 // GetPersistentVolumeClaimListChannel returns a pair of channels to a PersistentVolumeClaim list
 // and errors that both must be read numReads times.
-func GetPersistentVolumeClaimListChannel(client client.Interface, nsQuery *NamespaceQuery,
+func GetPersistentVolumeClaimListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
 	numReads int) PersistentVolumeClaimListChannel {
+	return GetPersistentVolumeClaimListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
 
+// GetPersistentVolumeClaimListChannelWithOptions is GetPersistentVolumeClaimListChannel plus list options.
+func GetPersistentVolumeClaimListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) PersistentVolumeClaimListChannel {
 	channel := PersistentVolumeClaimListChannel{
 		List:  make(chan *v1.PersistentVolumeClaimList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().PersistentVolumeClaims(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list := &v1.PersistentVolumeClaimList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("persistentvolumeclaims"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.CoreV1().PersistentVolumeClaims(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -851,14 +1035,20 @@ type CustomResourceDefinitionChannelV1 struct {
 
 // GetCustomResourceDefinitionChannelV1 returns a pair of channels to a CustomResourceDefinition list and errors
 // that both must be read numReads times.
-func GetCustomResourceDefinitionChannelV1(client apiextensionsclientset.Interface, numReads int) CustomResourceDefinitionChannelV1 {
+func GetCustomResourceDefinitionChannelV1(ctx context.Context, client apiextensionsclientset.Interface, numReads int) CustomResourceDefinitionChannelV1 {
+	return GetCustomResourceDefinitionChannelV1WithOptions(ctx, client, api.ListEverything, numReads)
+}
+
+// GetCustomResourceDefinitionChannelV1WithOptions is GetCustomResourceDefinitionChannelV1 plus list options.
+func GetCustomResourceDefinitionChannelV1WithOptions(ctx context.Context, client apiextensionsclientset.Interface,
+	options metaV1.ListOptions, numReads int) CustomResourceDefinitionChannelV1 {
 	channel := CustomResourceDefinitionChannelV1{
 		List:  make(chan *apiextensions.CustomResourceDefinitionList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.ApiextensionsV1().CustomResourceDefinitions().List(context.TODO(), api.ListEverything)
+		list, err := client.ApiextensionsV1().CustomResourceDefinitions().List(ctx, options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -876,16 +1066,25 @@ type ResourceQuotaListChannel struct {
 
 // GetResourceQuotaListChannel returns a pair of channels to a ResourceQuota list and errors that
 // both must be read numReads times.
-func GetResourceQuotaListChannel(client client.Interface, nsQuery *NamespaceQuery,
+func GetResourceQuotaListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
 	numReads int) ResourceQuotaListChannel {
+	return GetResourceQuotaListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
 
+// GetResourceQuotaListChannelWithOptions is GetResourceQuotaListChannel plus list options.
+func GetResourceQuotaListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) ResourceQuotaListChannel {
 	channel := ResourceQuotaListChannel{
 		List:  make(chan *v1.ResourceQuotaList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().ResourceQuotas(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list := &v1.ResourceQuotaList{}
+		var err error
+		if !listFromCache(client, v1.SchemeGroupVersion.WithResource("resourcequotas"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.CoreV1().ResourceQuotas(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -903,16 +1102,25 @@ type HorizontalPodAutoscalerListChannel struct {
 
 // GetHorizontalPodAutoscalerListChannel returns a pair of channels to MetricsByPod and errors that
 // both must be read numReads times.
-func GetHorizontalPodAutoscalerListChannel(client client.Interface, nsQuery *NamespaceQuery,
+func GetHorizontalPodAutoscalerListChannel(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
 	numReads int) HorizontalPodAutoscalerListChannel {
+	return GetHorizontalPodAutoscalerListChannelWithOptions(ctx, client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetHorizontalPodAutoscalerListChannelWithOptions is GetHorizontalPodAutoscalerListChannel plus list options.
+func GetHorizontalPodAutoscalerListChannelWithOptions(ctx context.Context, client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) HorizontalPodAutoscalerListChannel {
 	channel := HorizontalPodAutoscalerListChannel{
 		List:  make(chan *autoscaling.HorizontalPodAutoscalerList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.AutoscalingV1().HorizontalPodAutoscalers(nsQuery.ToRequestParam()).
-			List(context.TODO(), api.ListEverything)
+		list := &autoscaling.HorizontalPodAutoscalerList{}
+		var err error
+		if !listFromCache(client, autoscaling.SchemeGroupVersion.WithResource("horizontalpodautoscalers"), nsQuery.ToRequestParam(), options, list) {
+			list, err = client.AutoscalingV1().HorizontalPodAutoscalers(nsQuery.ToRequestParam()).List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -930,14 +1138,23 @@ type StorageClassListChannel struct {
 
 // GetStorageClassListChannel returns a pair of channels to a storage class list and
 // errors that both must be read numReads times.
-func GetStorageClassListChannel(client client.Interface, numReads int) StorageClassListChannel {
+func GetStorageClassListChannel(ctx context.Context, client client.Interface, numReads int) StorageClassListChannel {
+	return GetStorageClassListChannelWithOptions(ctx, client, api.ListEverything, numReads)
+}
+
+// GetStorageClassListChannelWithOptions is GetStorageClassListChannel plus list options.
+func GetStorageClassListChannelWithOptions(ctx context.Context, client client.Interface, options metaV1.ListOptions, numReads int) StorageClassListChannel {
 	channel := StorageClassListChannel{
 		List:  make(chan *storage.StorageClassList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.StorageV1().StorageClasses().List(context.TODO(), api.ListEverything)
+		list := &storage.StorageClassList{}
+		var err error
+		if !listFromCache(client, storage.SchemeGroupVersion.WithResource("storageclasses"), "", options, list) {
+			list, err = client.StorageV1().StorageClasses().List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -955,14 +1172,23 @@ type IngressClassListChannel struct {
 
 // GetIngressClassListChannel returns a pair of channels to a ingress class list and
 // errors that both must be read numReads times.
-func GetIngressClassListChannel(client client.Interface, numReads int) IngressClassListChannel {
+func GetIngressClassListChannel(ctx context.Context, client client.Interface, numReads int) IngressClassListChannel {
+	return GetIngressClassListChannelWithOptions(ctx, client, api.ListEverything, numReads)
+}
+
+// GetIngressClassListChannelWithOptions is GetIngressClassListChannel plus list options.
+func GetIngressClassListChannelWithOptions(ctx context.Context, client client.Interface, options metaV1.ListOptions, numReads int) IngressClassListChannel {
 	channel := IngressClassListChannel{
 		List:  make(chan *networkingv1.IngressClassList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.NetworkingV1().IngressClasses().List(context.TODO(), api.ListEverything)
+		list := &networkingv1.IngressClassList{}
+		var err error
+		if !listFromCache(client, networkingv1.SchemeGroupVersion.WithResource("ingressclasses"), "", options, list) {
+			list, err = client.NetworkingV1().IngressClasses().List(ctx, options)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -0,0 +1,68 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetServiceListChannelWithOptions(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1.Service{ObjectMeta: metaV1.ObjectMeta{Name: "svc-1", Namespace: "ns-1", Labels: map[string]string{"app": "foo"}}},
+		&v1.Service{ObjectMeta: metaV1.ObjectMeta{Name: "svc-2", Namespace: "ns-1", Labels: map[string]string{"app": "bar"}}},
+		&v1.Service{ObjectMeta: metaV1.ObjectMeta{Name: "svc-3", Namespace: "ns-2", Labels: map[string]string{"app": "foo"}}},
+	)
+
+	cases := []struct {
+		nsQuery  *NamespaceQuery
+		options  metaV1.ListOptions
+		expected []string
+	}{
+		{NewNamespaceQuery(nil), metaV1.ListOptions{}, []string{"svc-1", "svc-2", "svc-3"}},
+		{NewNamespaceQuery(nil), metaV1.ListOptions{LabelSelector: "app=foo"}, []string{"svc-1", "svc-3"}},
+		{NewSameNamespaceQuery("ns-1"), metaV1.ListOptions{LabelSelector: "app=foo"}, []string{"svc-1"}},
+	}
+
+	for _, c := range cases {
+		channel := GetServiceListChannelWithOptions(context.TODO(), client, c.nsQuery, c.options, 1)
+		list, err := <-channel.List, <-channel.Error
+		if err != nil {
+			t.Fatalf("GetServiceListChannelWithOptions(%#v) returned unexpected error: %s", c.options, err.Error())
+		}
+
+		actual := make([]string, 0)
+		for _, item := range list.Items {
+			actual = append(actual, item.Name)
+		}
+
+		if len(actual) != len(c.expected) {
+			t.Errorf("GetServiceListChannelWithOptions(%#v) returned %v, expected %v", c.options, actual, c.expected)
+			continue
+		}
+
+		for i := range actual {
+			if actual[i] != c.expected[i] {
+				t.Errorf("GetServiceListChannelWithOptions(%#v) returned %v, expected %v", c.options, actual,
+					c.expected)
+				break
+			}
+		}
+	}
+}
@@ -35,10 +35,10 @@ type ConfigMapDetail struct {
 }
 
 // GetConfigMapDetail returns detailed information about a config map
-func GetConfigMapDetail(client kubernetes.Interface, namespace, name string) (*ConfigMapDetail, error) {
+func GetConfigMapDetail(ctx context.Context, client kubernetes.Interface, namespace, name string) (*ConfigMapDetail, error) {
 	log.Printf("Getting details of %s config map in %s namespace", name, namespace)
 
-	rawConfigMap, err := client.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	rawConfigMap, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, name, metaV1.GetOptions{})
 
 	if err != nil {
 		return nil, err
@@ -15,6 +15,7 @@
 package configmap
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -45,10 +46,10 @@ type ConfigMap struct {
 }
 
 // GetConfigMapList returns a list of all ConfigMaps in the cluster.
-func GetConfigMapList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*ConfigMapList, error) {
+func GetConfigMapList(ctx context.Context, client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*ConfigMapList, error) {
 	log.Printf("Getting list config maps in the namespace %s", nsQuery.ToRequestParam())
 	channels := &common.ResourceChannels{
-		ConfigMapList: common.GetConfigMapListChannel(client, nsQuery, 1),
+		ConfigMapList: common.GetConfigMapListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
 	}
 
 	return GetConfigMapListFromChannels(channels, dsQuery)
@@ -65,6 +66,7 @@ func GetConfigMapListFromChannels(channels *common.ResourceChannels, dsQuery *da
 
 	result := toConfigMapList(configMaps.Items, nonCriticalErrors, dsQuery)
 
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, configMaps)
 	return result, nil
 }
 
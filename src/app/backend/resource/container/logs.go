@@ -37,8 +37,8 @@ type PodContainerList struct {
 }
 
 // GetPodContainers returns containers that a pod has.
-func GetPodContainers(client kubernetes.Interface, namespace, podID string) (*PodContainerList, error) {
-	pod, err := client.CoreV1().Pods(namespace).Get(context.TODO(), podID, metaV1.GetOptions{})
+func GetPodContainers(ctx context.Context, client kubernetes.Interface, namespace, podID string) (*PodContainerList, error) {
+	pod, err := client.CoreV1().Pods(namespace).Get(ctx, podID, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -54,9 +54,9 @@ func GetPodContainers(client kubernetes.Interface, namespace, podID string) (*Po
 
 // GetLogDetails returns logs for particular pod and container. When container is null, logs for the first one
 // are returned. Previous indicates to read archived logs created by log rotation or container crash
-func GetLogDetails(client kubernetes.Interface, namespace, podID string, container string,
+func GetLogDetails(ctx context.Context, client kubernetes.Interface, namespace, podID string, container string,
 	logSelector *logs.Selection, usePreviousLogs bool) (*logs.LogDetails, error) {
-	pod, err := client.CoreV1().Pods(namespace).Get(context.TODO(), podID, metaV1.GetOptions{})
+	pod, err := client.CoreV1().Pods(namespace).Get(ctx, podID, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +66,7 @@ func GetLogDetails(client kubernetes.Interface, namespace, podID string, contain
 	}
 
 	logOptions := mapToLogOptions(container, logSelector, usePreviousLogs)
-	rawLogs, err := readRawLogs(client, namespace, podID, logOptions)
+	rawLogs, err := readRawLogs(ctx, client, namespace, podID, logOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -94,9 +94,9 @@ func mapToLogOptions(container string, logSelector *logs.Selection, previous boo
 }
 
 // Construct a request for getting the logs for a pod and retrieves the logs.
-func readRawLogs(client kubernetes.Interface, namespace, podID string, logOptions *v1.PodLogOptions) (
+func readRawLogs(ctx context.Context, client kubernetes.Interface, namespace, podID string, logOptions *v1.PodLogOptions) (
 	string, error) {
-	readCloser, err := openStream(client, namespace, podID, logOptions)
+	readCloser, err := openStream(ctx, client, namespace, podID, logOptions)
 	if err != nil {
 		return err.Error(), nil
 	}
@@ -113,24 +113,24 @@ func readRawLogs(client kubernetes.Interface, namespace, podID string, logOption
 
 // GetLogFile returns a stream to the log file which can be piped directly to the response. This avoids out of memory
 // issues. Previous indicates to read archived logs created by log rotation or container crash
-func GetLogFile(client kubernetes.Interface, namespace, podID string, container string, opts *v1.PodLogOptions) (io.ReadCloser, error) {
+func GetLogFile(ctx context.Context, client kubernetes.Interface, namespace, podID string, container string, opts *v1.PodLogOptions) (io.ReadCloser, error) {
 	logOptions := &v1.PodLogOptions{
 		Container:  container,
 		Follow:     false,
 		Previous:   opts.Previous,
 		Timestamps: opts.Timestamps,
 	}
-	logStream, err := openStream(client, namespace, podID, logOptions)
+	logStream, err := openStream(ctx, client, namespace, podID, logOptions)
 	return logStream, err
 }
 
-func openStream(client kubernetes.Interface, namespace, podID string, logOptions *v1.PodLogOptions) (io.ReadCloser, error) {
+func openStream(ctx context.Context, client kubernetes.Interface, namespace, podID string, logOptions *v1.PodLogOptions) (io.ReadCloser, error) {
 	return client.CoreV1().RESTClient().Get().
 		Namespace(namespace).
 		Name(podID).
 		Resource("pods").
 		SubResource("log").
-		VersionedParams(logOptions, scheme.ParameterCodec).Stream(context.TODO())
+		VersionedParams(logOptions, scheme.ParameterCodec).Stream(ctx)
 }
 
 // ConstructLogDetails creates a new log details structure for given parameters.
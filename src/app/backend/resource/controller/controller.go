@@ -62,41 +62,41 @@ type ResourceController interface {
 
 // NewResourceController creates instance of ResourceController based on given reference. It allows
 // to convert owner/created by references to real objects.
-func NewResourceController(ref meta.OwnerReference, namespace string, client client.Interface) (
+func NewResourceController(ctx context.Context, ref meta.OwnerReference, namespace string, client client.Interface) (
 	ResourceController, error) {
 	switch strings.ToLower(ref.Kind) {
 	case api.ResourceKindJob:
-		job, err := client.BatchV1().Jobs(namespace).Get(context.TODO(), ref.Name, meta.GetOptions{})
+		job, err := client.BatchV1().Jobs(namespace).Get(ctx, ref.Name, meta.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
 		return JobController(*job), nil
 	case api.ResourceKindPod:
-		pod, err := client.CoreV1().Pods(namespace).Get(context.TODO(), ref.Name, meta.GetOptions{})
+		pod, err := client.CoreV1().Pods(namespace).Get(ctx, ref.Name, meta.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
 		return PodController(*pod), nil
 	case api.ResourceKindReplicaSet:
-		rs, err := client.AppsV1().ReplicaSets(namespace).Get(context.TODO(), ref.Name, meta.GetOptions{})
+		rs, err := client.AppsV1().ReplicaSets(namespace).Get(ctx, ref.Name, meta.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
 		return ReplicaSetController(*rs), nil
 	case api.ResourceKindReplicationController:
-		rc, err := client.CoreV1().ReplicationControllers(namespace).Get(context.TODO(), ref.Name, meta.GetOptions{})
+		rc, err := client.CoreV1().ReplicationControllers(namespace).Get(ctx, ref.Name, meta.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
 		return ReplicationControllerController(*rc), nil
 	case api.ResourceKindDaemonSet:
-		ds, err := client.AppsV1().DaemonSets(namespace).Get(context.TODO(), ref.Name, meta.GetOptions{})
+		ds, err := client.AppsV1().DaemonSets(namespace).Get(ctx, ref.Name, meta.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
 		return DaemonSetController(*ds), nil
 	case api.ResourceKindStatefulSet:
-		ss, err := client.AppsV1().StatefulSets(namespace).Get(context.TODO(), ref.Name, meta.GetOptions{})
+		ss, err := client.AppsV1().StatefulSets(namespace).Get(ctx, ref.Name, meta.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -15,6 +15,7 @@
 package controller
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -61,7 +62,7 @@ func TestNewResourceController(t *testing.T) {
 		}}
 	cli := fake.NewSimpleClientset(&pod)
 
-	ctrl, err := NewResourceController(
+	ctrl, err := NewResourceController(context.TODO(),
 		meta.OwnerReference{
 			Kind: api.ResourceKindPod,
 			Name: "test-name",
@@ -77,7 +78,7 @@ func TestNewResourceController(t *testing.T) {
 	if podCtrl.Name != "test-name" {
 		t.Fatal("Returned invalid pod name")
 	}
-	NewResourceController(
+	NewResourceController(context.TODO(),
 		meta.OwnerReference{
 			Kind: api.ResourceKindPod,
 			Name: "test-name",
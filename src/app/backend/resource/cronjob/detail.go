@@ -35,9 +35,9 @@ type CronJobDetail struct {
 }
 
 // GetCronJobDetail gets Cron Job details.
-func GetCronJobDetail(client k8sClient.Interface, namespace, name string) (*CronJobDetail, error) {
+func GetCronJobDetail(ctx context.Context, client k8sClient.Interface, namespace, name string) (*CronJobDetail, error) {
 
-	rawObject, err := client.BatchV1beta1().CronJobs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	rawObject, err := client.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
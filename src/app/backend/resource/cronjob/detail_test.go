@@ -15,6 +15,7 @@
 package cronjob_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -65,7 +66,7 @@ func TestGetCronJobDetail(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.raw)
 		dataselect.DefaultDataSelectWithMetrics.MetricQuery = dataselect.NoMetrics
-		actual, _ := cronjob.GetCronJobDetail(fakeClient, c.namespace, c.name)
+		actual, _ := cronjob.GetCronJobDetail(context.TODO(), fakeClient, c.namespace, c.name)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -15,6 +15,7 @@
 package cronjob
 
 import (
+	"context"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
@@ -22,10 +23,10 @@ import (
 )
 
 // GetCronJobEvents gets events associated to cron job.
-func GetCronJobEvents(client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
+func GetCronJobEvents(ctx context.Context, client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
 	*common.EventList, error) {
 
-	raw, err := event.GetEvents(client, namespace, name)
+	raw, err := event.GetEvents(ctx, client, namespace, name)
 	if err != nil {
 		return event.EmptyEventList, err
 	}
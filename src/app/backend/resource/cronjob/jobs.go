@@ -39,18 +39,18 @@ var emptyJobList = &job.JobList{
 }
 
 // GetCronJobJobs returns list of jobs owned by cron job.
-func GetCronJobJobs(client client.Interface, metricClient metricapi.MetricClient,
+func GetCronJobJobs(ctx context.Context, client client.Interface, metricClient metricapi.MetricClient,
 	dsQuery *dataselect.DataSelectQuery, namespace, name string, active bool) (*job.JobList, error) {
 
-	cronJob, err := client.BatchV1beta1().CronJobs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	cronJob, err := client.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return emptyJobList, err
 	}
 
 	channels := &common.ResourceChannels{
-		JobList:   common.GetJobListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
-		PodList:   common.GetPodListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
-		EventList: common.GetEventListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		JobList:   common.GetJobListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
+		PodList:   common.GetPodListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
+		EventList: common.GetEventListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
 	jobs := <-channels.JobList.List
@@ -77,14 +77,14 @@ func GetCronJobJobs(client client.Interface, metricClient metricapi.MetricClient
 	jobs.Items = filterJobsByOwnerUID(cronJob.UID, jobs.Items)
 	jobs.Items = filterJobsByState(active, jobs.Items)
 
-	return job.ToJobList(jobs.Items, pods.Items, events.Items, nonCriticalErrors, dsQuery, metricClient), nil
+	return job.ToJobList(ctx, jobs.Items, pods.Items, events.Items, nonCriticalErrors, dsQuery, metricClient), nil
 }
 
 // TriggerCronJob manually triggers a cron job and creates a new job.
-func TriggerCronJob(client client.Interface,
+func TriggerCronJob(ctx context.Context, client client.Interface,
 	namespace, name string) error {
 
-	cronJob, err := client.BatchV1beta1().CronJobs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	cronJob, err := client.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metaV1.GetOptions{})
 
 	if err != nil {
 		return err
@@ -116,7 +116,7 @@ func TriggerCronJob(client client.Interface,
 		Spec: cronJob.Spec.JobTemplate.Spec,
 	}
 
-	_, err = client.BatchV1().Jobs(namespace).Create(context.TODO(), jobToCreate, metaV1.CreateOptions{})
+	_, err = client.BatchV1().Jobs(namespace).Create(ctx, jobToCreate, metaV1.CreateOptions{})
 
 	if err != nil {
 		return err
@@ -29,7 +29,7 @@ import (
 func TestTriggerCronJobWithInvalidName(t *testing.T) {
 	client := fake.NewSimpleClientset()
 
-	err := cronjob.TriggerCronJob(client, namespace, "invalidName")
+	err := cronjob.TriggerCronJob(context.TODO(), client, namespace, "invalidName")
 	if !errors.IsNotFound(err) {
 		t.Error("TriggerCronJob should return error when invalid name is passed")
 	}
@@ -50,7 +50,7 @@ func TestTriggerCronJobWithLongName(t *testing.T) {
 		}}
 
 	client := fake.NewSimpleClientset(&cron)
-	err := cronjob.TriggerCronJob(client, namespace, longName)
+	err := cronjob.TriggerCronJob(context.TODO(), client, namespace, longName)
 	if err != nil {
 		t.Error(err)
 	}
@@ -78,7 +78,7 @@ func TestTriggerCronJob(t *testing.T) {
 
 	client := fake.NewSimpleClientset(&cron)
 
-	err := cronjob.TriggerCronJob(client, namespace, name)
+	err := cronjob.TriggerCronJob(context.TODO(), client, namespace, name)
 	if err != nil {
 		t.Error(err)
 	}
@@ -15,6 +15,7 @@
 package cronjob
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -54,21 +55,21 @@ type CronJob struct {
 }
 
 // GetCronJobList returns a list of all CronJobs in the cluster.
-func GetCronJobList(client client.Interface, nsQuery *common.NamespaceQuery,
+func GetCronJobList(ctx context.Context, client client.Interface, nsQuery *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*CronJobList, error) {
 	log.Print("Getting list of all cron jobs in the cluster")
 
 	channels := &common.ResourceChannels{
-		CronJobList: common.GetCronJobListChannel(client, nsQuery, 1),
+		CronJobList: common.GetCronJobListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
 	}
 
-	return GetCronJobListFromChannels(channels, dsQuery, metricClient)
+	return GetCronJobListFromChannels(ctx, channels, dsQuery, metricClient)
 }
 
 // GetCronJobListFromChannels returns a list of all CronJobs in the cluster reading required resource
 // list once from the channels.
-func GetCronJobListFromChannels(channels *common.ResourceChannels, dsQuery *dataselect.DataSelectQuery,
-	metricClient metricapi.MetricClient) (*CronJobList, error) {
+func GetCronJobListFromChannels(ctx context.Context, channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*CronJobList, error) {
 
 	cronJobs := <-channels.CronJobList.List
 	err := <-channels.CronJobList.Error
@@ -77,13 +78,14 @@ func GetCronJobListFromChannels(channels *common.ResourceChannels, dsQuery *data
 		return nil, criticalError
 	}
 
-	cronJobList := toCronJobList(cronJobs.Items, nonCriticalErrors, dsQuery, metricClient)
+	cronJobList := toCronJobList(ctx, cronJobs.Items, nonCriticalErrors, dsQuery, metricClient)
 	cronJobList.Status = getStatus(cronJobs)
+	cronJobList.ListMeta = dsQuery.CursorListMeta(cronJobList.ListMeta, cronJobs)
 	return cronJobList, nil
 }
 
-func toCronJobList(cronJobs []v1beta1.CronJob, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery,
-	metricClient metricapi.MetricClient) *CronJobList {
+func toCronJobList(ctx context.Context, cronJobs []v1beta1.CronJob, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) *CronJobList {
 
 	list := &CronJobList{
 		Items:    make([]CronJob, 0),
@@ -93,7 +95,7 @@ func toCronJobList(cronJobs []v1beta1.CronJob, nonCriticalErrors []error, dsQuer
 
 	cachedResources := &metricapi.CachedResources{}
 
-	cronJobCells, metricPromises, filteredTotal := dataselect.GenericDataSelectWithFilterAndMetrics(ToCells(cronJobs),
+	cronJobCells, metricPromises, filteredTotal := dataselect.GenericDataSelectWithFilterAndMetrics(ctx, ToCells(cronJobs),
 		dsQuery, cachedResources, metricClient)
 	cronJobs = FromCells(cronJobCells)
 	list.ListMeta = api.ListMeta{TotalItems: filteredTotal}
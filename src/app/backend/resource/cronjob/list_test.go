@@ -15,6 +15,7 @@
 package cronjob_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -110,12 +111,12 @@ func TestGetCronJobListFromChannels(t *testing.T) {
 		channels.CronJobList.Error <- c.rawError
 		channels.CronJobList.List <- &c.raw
 
-		actual, err := cronjob.GetCronJobListFromChannels(channels, dataselect.NoDataSelect, nil)
+		actual, err := cronjob.GetCronJobListFromChannels(context.TODO(), channels, dataselect.NoDataSelect, nil)
 		if !reflect.DeepEqual(actual, c.expected) {
-			t.Errorf("GetCronJobListFromChannels() ==\n %#v\nExpected: %#v", actual, c.expected)
+			t.Errorf("GetCronJobListFromChannels(context.TODO(), ) ==\n %#v\nExpected: %#v", actual, c.expected)
 		}
 		if !reflect.DeepEqual(err, c.expectedError) {
-			t.Errorf("GetCronJobListFromChannels() ==\n %#v\nExpected: %#v", err, c.expectedError)
+			t.Errorf("GetCronJobListFromChannels(context.TODO(), ) ==\n %#v\nExpected: %#v", err, c.expectedError)
 		}
 	}
 }
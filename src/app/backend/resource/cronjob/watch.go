@@ -0,0 +1,75 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	"context"
+
+	"k8s.io/api/batch/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/listwatch"
+)
+
+// NewCronJobListWatch returns list watch that streams changes of the Cron Job list selected by given data select
+// query.
+func NewCronJobListWatch(client kubernetes.Interface, metricClient metricapi.MetricClient, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+	return &listwatch.ListWatch{
+		NamespaceQuery: nsQuery,
+		ListOptions:    dsQuery.ListOptions(),
+		ListFunc: func(ctx context.Context, options metaV1.ListOptions) (runtime.Object, error) {
+			return client.BatchV1beta1().CronJobs(nsQuery.ToRequestParam()).List(ctx, options)
+		},
+		WatchFunc: func(ctx context.Context, options metaV1.ListOptions) (watch.Interface, error) {
+			return client.BatchV1beta1().CronJobs(nsQuery.ToRequestParam()).Watch(ctx, options)
+		},
+		SelectFunc: func(ctx context.Context, objects []runtime.Object, _ map[string][]runtime.Object) (
+			interface{}, api.ListMeta, []listwatch.Item, error) {
+			list := &v1beta1.CronJobList{}
+			for _, object := range objects {
+				list.Items = append(list.Items, *object.(*v1beta1.CronJob))
+			}
+
+			channels := &common.ResourceChannels{
+				CronJobList: common.CronJobListChannel{
+					List:  make(chan *v1beta1.CronJobList, 1),
+					Error: make(chan error, 1),
+				},
+			}
+			channels.CronJobList.List <- list
+			channels.CronJobList.Error <- nil
+
+			result, err := GetCronJobListFromChannels(ctx, channels, dsQuery, metricClient)
+			if err != nil {
+				return nil, api.ListMeta{}, nil, err
+			}
+
+			items := make([]listwatch.Item, len(result.Items))
+			for i, item := range result.Items {
+				items[i] = listwatch.Item{UID: item.ObjectMeta.UID, Object: item}
+			}
+
+			return result, result.ListMeta, items, nil
+		},
+	}
+}
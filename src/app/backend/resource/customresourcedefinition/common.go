@@ -15,6 +15,7 @@
 package customresourcedefinition
 
 import (
+	"context"
 	"fmt"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -67,7 +68,7 @@ func GetExtensionsAPIRestClient(client clientset.Interface) (rest.Interface, err
 	return nil, errors.NewNotFound(fmt.Sprintf("unsupported extensions api version: %s", version))
 }
 
-func GetCustomResourceDefinitionList(client apiextensionsclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*types.CustomResourceDefinitionList, error) {
+func GetCustomResourceDefinitionList(ctx context.Context, client apiextensionsclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*types.CustomResourceDefinitionList, error) {
 	version, err := GetExtensionsAPIVersion(client)
 	if err != nil {
 		return nil, err
@@ -75,13 +76,13 @@ func GetCustomResourceDefinitionList(client apiextensionsclientset.Interface, ds
 
 	switch version {
 	case v1:
-		return crdv1.GetCustomResourceDefinitionList(client, dsQuery)
+		return crdv1.GetCustomResourceDefinitionList(ctx, client, dsQuery)
 	}
 
 	return nil, errors.NewNotFound(fmt.Sprintf("unsupported extensions api version: %s", version))
 }
 
-func GetCustomResourceDefinitionDetail(client apiextensionsclientset.Interface, config *rest.Config, name string) (*types.CustomResourceDefinitionDetail, error) {
+func GetCustomResourceDefinitionDetail(ctx context.Context, client apiextensionsclientset.Interface, config *rest.Config, name string) (*types.CustomResourceDefinitionDetail, error) {
 	version, err := GetExtensionsAPIVersion(client)
 	if err != nil {
 		return nil, err
@@ -89,13 +90,13 @@ func GetCustomResourceDefinitionDetail(client apiextensionsclientset.Interface,
 
 	switch version {
 	case v1:
-		return crdv1.GetCustomResourceDefinitionDetail(client, config, name)
+		return crdv1.GetCustomResourceDefinitionDetail(ctx, client, config, name)
 	}
 
 	return nil, errors.NewNotFound(fmt.Sprintf("unsupported extensions api versions: %s", version))
 }
 
-func GetCustomResourceObjectList(client apiextensionsclientset.Interface, config *rest.Config, namespace *common.NamespaceQuery,
+func GetCustomResourceObjectList(ctx context.Context, client apiextensionsclientset.Interface, config *rest.Config, namespace *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery, crdName string) (*types.CustomResourceObjectList, error) {
 	version, err := GetExtensionsAPIVersion(client)
 	if err != nil {
@@ -104,13 +105,13 @@ func GetCustomResourceObjectList(client apiextensionsclientset.Interface, config
 
 	switch version {
 	case v1:
-		return crdv1.GetCustomResourceObjectList(client, config, namespace, dsQuery, crdName)
+		return crdv1.GetCustomResourceObjectList(ctx, client, config, namespace, dsQuery, crdName)
 	}
 
 	return nil, errors.NewNotFound(fmt.Sprintf("unsupported extensions api versions: %s", version))
 }
 
-func GetCustomResourceObjectDetail(client apiextensionsclientset.Interface, namespace *common.NamespaceQuery, config *rest.Config, crdName string, name string) (*types.CustomResourceObjectDetail, error) {
+func GetCustomResourceObjectDetail(ctx context.Context, client apiextensionsclientset.Interface, namespace *common.NamespaceQuery, config *rest.Config, crdName string, name string) (*types.CustomResourceObjectDetail, error) {
 	version, err := GetExtensionsAPIVersion(client)
 	if err != nil {
 		return nil, err
@@ -118,7 +119,7 @@ func GetCustomResourceObjectDetail(client apiextensionsclientset.Interface, name
 
 	switch version {
 	case v1:
-		return crdv1.GetCustomResourceObjectDetail(client, namespace, config, crdName, name)
+		return crdv1.GetCustomResourceObjectDetail(ctx, client, namespace, config, crdName, name)
 	}
 
 	return nil, errors.NewNotFound(fmt.Sprintf("unsupported extensions api versions: %s", version))
@@ -15,6 +15,7 @@
 package customresourcedefinition
 
 import (
+	"context"
 	client "k8s.io/client-go/kubernetes"
 
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
@@ -23,7 +24,7 @@ import (
 )
 
 // GetEventsForCustomResourceObject gets events that are associated with this CR object.
-func GetEventsForCustomResourceObject(client client.Interface, dsQuery *dataselect.DataSelectQuery,
+func GetEventsForCustomResourceObject(ctx context.Context, client client.Interface, dsQuery *dataselect.DataSelectQuery,
 	namespace, name string) (*common.EventList, error) {
-	return event.GetResourceEvents(client, dsQuery, namespace, name)
+	return event.GetResourceEvents(ctx, client, dsQuery, namespace, name)
 }
@@ -15,6 +15,7 @@
 package customresourcedefinition
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -77,7 +78,7 @@ func TestGetEventsForCustomResourceObject(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.eventList, c.objectList)
 
-		actual, _ := GetEventsForCustomResourceObject(fakeClient, dataselect.NoDataSelect, c.namespace, c.objectName)
+		actual, _ := GetEventsForCustomResourceObject(context.TODO(), fakeClient, dataselect.NoDataSelect, c.namespace, c.objectName)
 
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetEventsForCustomResourceObject == \ngot %#v, \nexpected %#v", actual,
@@ -15,6 +15,7 @@
 package v1
 
 import (
+	"context"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
@@ -27,8 +28,8 @@ import (
 )
 
 // GetCustomResourceDefinitionList returns all the custom resource definitions in the cluster.
-func GetCustomResourceDefinitionList(client apiextensionsclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*types.CustomResourceDefinitionList, error) {
-	channel := common.GetCustomResourceDefinitionChannelV1(client, 1)
+func GetCustomResourceDefinitionList(ctx context.Context, client apiextensionsclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*types.CustomResourceDefinitionList, error) {
+	channel := common.GetCustomResourceDefinitionChannelV1WithOptions(ctx, client, dsQuery.ListOptions(), 1)
 	crdList := <-channel.List
 	err := <-channel.Error
 
@@ -37,7 +38,9 @@ func GetCustomResourceDefinitionList(client apiextensionsclientset.Interface, ds
 		return nil, criticalError
 	}
 
-	return toCustomResourceDefinitionList(crdList.Items, nonCriticalErrors, dsQuery), nil
+	result := toCustomResourceDefinitionList(crdList.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, crdList)
+	return result, nil
 }
 
 func toCustomResourceDefinitionList(crds []apiextensionsv1.CustomResourceDefinition, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *types.CustomResourceDefinitionList {
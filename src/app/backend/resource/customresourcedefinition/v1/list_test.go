@@ -15,6 +15,7 @@
 package v1
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -118,7 +119,7 @@ func TestGetCustomResourceDefinition(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.crdList)
 
-		actual, _ := GetCustomResourceDefinitionList(fakeClient, dataselect.DefaultDataSelect)
+		actual, _ := GetCustomResourceDefinitionList(context.TODO(), fakeClient, dataselect.DefaultDataSelect)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -32,13 +32,13 @@ import (
 )
 
 // GetCustomResourceObjectList gets objects for a CR.
-func GetCustomResourceObjectList(client apiextensionsclientset.Interface, config *rest.Config, namespace *common.NamespaceQuery,
+func GetCustomResourceObjectList(ctx context.Context, client apiextensionsclientset.Interface, config *rest.Config, namespace *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery, crdName string) (*types.CustomResourceObjectList, error) {
 	var list *types.CustomResourceObjectList
 
 	customResourceDefinition, err := client.ApiextensionsV1().
 		CustomResourceDefinitions().
-		Get(context.TODO(), crdName, metav1.GetOptions{})
+		Get(ctx, crdName, metav1.GetOptions{})
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
@@ -56,10 +56,12 @@ func GetCustomResourceObjectList(client apiextensionsclientset.Interface, config
 		return nil, criticalError
 	}
 
+	options := dsQuery.ListOptions()
 	raw, err := restClient.Get().
 		NamespaceIfScoped(namespace.ToRequestParam(), customResourceDefinition.Spec.Scope == apiextensionsv1.NamespaceScoped).
 		Resource(customResourceDefinition.Spec.Names.Plural).
-		Do(context.TODO()).Raw()
+		VersionedParams(&options, metav1.ParameterCodec).
+		Do(ctx).Raw()
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return nil, criticalError
@@ -72,10 +74,18 @@ func GetCustomResourceObjectList(client apiextensionsclientset.Interface, config
 	}
 	list.Errors = nonCriticalErrors
 
+	// Continue token of the list is needed for cursor pagination.
+	rawList := &metav1.List{}
+	err = json.Unmarshal(raw, rawList)
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
 	// Return only slice of data, pagination is done here.
 	crdObjectCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toObjectCells(list.Items), dsQuery)
 	list.Items = fromObjectCells(crdObjectCells)
-	list.ListMeta = api.ListMeta{TotalItems: filteredTotal}
+	list.ListMeta = dsQuery.CursorListMeta(api.ListMeta{TotalItems: filteredTotal}, rawList)
 
 	for i := range list.Items {
 		toCRDObject(&list.Items[i], customResourceDefinition)
@@ -85,12 +95,12 @@ func GetCustomResourceObjectList(client apiextensionsclientset.Interface, config
 }
 
 // GetCustomResourceObjectDetail returns details of a single object in a CR.
-func GetCustomResourceObjectDetail(client apiextensionsclientset.Interface, namespace *common.NamespaceQuery, config *rest.Config, crdName string, name string) (*types.CustomResourceObjectDetail, error) {
+func GetCustomResourceObjectDetail(ctx context.Context, client apiextensionsclientset.Interface, namespace *common.NamespaceQuery, config *rest.Config, crdName string, name string) (*types.CustomResourceObjectDetail, error) {
 	var detail *types.CustomResourceObjectDetail
 
 	customResourceDefinition, err := client.ApiextensionsV1().
 		CustomResourceDefinitions().
-		Get(context.TODO(), crdName, metav1.GetOptions{})
+		Get(ctx, crdName, metav1.GetOptions{})
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
@@ -105,7 +115,7 @@ func GetCustomResourceObjectDetail(client apiextensionsclientset.Interface, name
 	raw, err := restClient.Get().
 		NamespaceIfScoped(namespace.ToRequestParam(), customResourceDefinition.Spec.Scope == apiextensionsv1.NamespaceScoped).
 		Resource(customResourceDefinition.Spec.Names.Plural).
-		Name(name).Do(context.TODO()).Raw()
+		Name(name).Do(ctx).Raw()
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return nil, criticalError
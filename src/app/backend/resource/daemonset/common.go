@@ -33,10 +33,10 @@ import (
 // GetServicesForDSDeletion is based on given selector returns list of services that are candidates for deletion.
 // Services are matched by daemon sets' label selector. They are deleted if given
 // label selector is targeting only 1 daemon set.
-func GetServicesForDSDeletion(client client.Interface, labelSelector labels.Selector,
+func GetServicesForDSDeletion(ctx context.Context, client client.Interface, labelSelector labels.Selector,
 	namespace string) ([]v1.Service, error) {
 
-	daemonSet, err := client.AppsV1().DaemonSets(namespace).List(context.TODO(), metaV1.ListOptions{
+	daemonSet, err := client.AppsV1().DaemonSets(namespace).List(ctx, metaV1.ListOptions{
 		LabelSelector: labelSelector.String(),
 		FieldSelector: fields.Everything().String(),
 	})
@@ -51,7 +51,7 @@ func GetServicesForDSDeletion(client client.Interface, labelSelector labels.Sele
 		return []v1.Service{}, nil
 	}
 
-	services, err := client.CoreV1().Services(namespace).List(context.TODO(), metaV1.ListOptions{
+	services, err := client.CoreV1().Services(namespace).List(ctx, metaV1.ListOptions{
 		LabelSelector: labelSelector.String(),
 		FieldSelector: fields.Everything().String(),
 	})
@@ -15,6 +15,7 @@
 package daemonset
 
 import (
+	"context"
 	"testing"
 
 	apps "k8s.io/api/apps/v1"
@@ -95,7 +96,7 @@ func TestGetServicesForDeletionforDS(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.DaemonSetList, c.expected)
 
-		GetServicesForDSDeletion(fakeClient, c.labelSelector, TestNamespace)
+		GetServicesForDSDeletion(context.TODO(), fakeClient, c.labelSelector, TestNamespace)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -37,18 +37,18 @@ type DaemonSetDetail struct {
 }
 
 // GetDaemonSetDetail Returns detailed information about the given daemon set in the given namespace.
-func GetDaemonSetDetail(client k8sClient.Interface, metricClient metricapi.MetricClient,
+func GetDaemonSetDetail(ctx context.Context, client k8sClient.Interface, metricClient metricapi.MetricClient,
 	namespace, name string) (*DaemonSetDetail, error) {
 
 	log.Printf("Getting details of %s daemon set in %s namespace", name, namespace)
-	daemonSet, err := client.AppsV1().DaemonSets(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	daemonSet, err := client.AppsV1().DaemonSets(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	channels := &common.ResourceChannels{
-		EventList: common.GetEventListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
-		PodList:   common.GetPodListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		EventList: common.GetEventListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
+		PodList:   common.GetPodListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
 	eventList := <-channels.EventList.List
@@ -15,6 +15,7 @@
 package daemonset
 
 import (
+	"context"
 	"github.com/kubernetes/dashboard/src/app/backend/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
@@ -47,22 +48,22 @@ type DaemonSet struct {
 }
 
 // GetDaemonSetList returns a list of all Daemon Set in the cluster.
-func GetDaemonSetList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery,
+func GetDaemonSetList(ctx context.Context, client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery,
 	metricClient metricapi.MetricClient) (*DaemonSetList, error) {
 	channels := &common.ResourceChannels{
-		DaemonSetList: common.GetDaemonSetListChannel(client, nsQuery, 1),
-		ServiceList:   common.GetServiceListChannel(client, nsQuery, 1),
-		PodList:       common.GetPodListChannel(client, nsQuery, 1),
-		EventList:     common.GetEventListChannel(client, nsQuery, 1),
+		DaemonSetList: common.GetDaemonSetListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
+		ServiceList:   common.GetServiceListChannel(ctx, client, nsQuery, 1),
+		PodList:       common.GetPodListChannel(ctx, client, nsQuery, 1),
+		EventList:     common.GetEventListChannel(ctx, client, nsQuery, 1),
 	}
 
-	return GetDaemonSetListFromChannels(channels, dsQuery, metricClient)
+	return GetDaemonSetListFromChannels(ctx, channels, dsQuery, metricClient)
 }
 
 // GetDaemonSetListFromChannels returns a list of all Daemon Set in the cluster
 // reading required resource list once from the channels.
-func GetDaemonSetListFromChannels(channels *common.ResourceChannels, dsQuery *dataselect.DataSelectQuery,
-	metricClient metricapi.MetricClient) (*DaemonSetList, error) {
+func GetDaemonSetListFromChannels(ctx context.Context, channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*DaemonSetList, error) {
 
 	daemonSets := <-channels.DaemonSetList.List
 	err := <-channels.DaemonSetList.Error
@@ -85,13 +86,15 @@ func GetDaemonSetListFromChannels(channels *common.ResourceChannels, dsQuery *da
 		return nil, criticalError
 	}
 
-	dsList := toDaemonSetList(daemonSets.Items, pods.Items, events.Items, nonCriticalErrors, dsQuery, metricClient)
+	dsList := toDaemonSetList(ctx, daemonSets.Items, pods.Items, events.Items, nonCriticalErrors, dsQuery, metricClient)
 	dsList.Status = getStatus(daemonSets, pods.Items, events.Items)
+	dsList.ListMeta = dsQuery.CursorListMeta(dsList.ListMeta, daemonSets)
 	return dsList, nil
 }
 
-func toDaemonSetList(daemonSets []apps.DaemonSet, pods []v1.Pod, events []v1.Event, nonCriticalErrors []error,
-	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) *DaemonSetList {
+func toDaemonSetList(ctx context.Context, daemonSets []apps.DaemonSet, pods []v1.Pod, events []v1.Event,
+	nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery,
+	metricClient metricapi.MetricClient) *DaemonSetList {
 
 	daemonSetList := &DaemonSetList{
 		DaemonSets: make([]DaemonSet, 0),
@@ -103,7 +106,7 @@ func toDaemonSetList(daemonSets []apps.DaemonSet, pods []v1.Pod, events []v1.Eve
 		Pods: pods,
 	}
 
-	dsCells, metricPromises, filteredTotal := dataselect.GenericDataSelectWithFilterAndMetrics(ToCells(daemonSets),
+	dsCells, metricPromises, filteredTotal := dataselect.GenericDataSelectWithFilterAndMetrics(ctx, ToCells(daemonSets),
 		dsQuery, cachedResources, metricClient)
 	daemonSets = FromCells(dsCells)
 	daemonSetList.ListMeta = api.ListMeta{TotalItems: filteredTotal}
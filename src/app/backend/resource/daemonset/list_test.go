@@ -15,6 +15,7 @@
 package daemonset
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -152,7 +153,7 @@ func TestGetDaemonSetListFromChannels(t *testing.T) {
 		channels.EventList.List <- &v1.EventList{}
 		channels.EventList.Error <- nil
 
-		actual, err := GetDaemonSetListFromChannels(channels, dataselect.NoDataSelect, nil)
+		actual, err := GetDaemonSetListFromChannels(context.TODO(), channels, dataselect.NoDataSelect, nil)
 
 		// Rewrite address of desired number of pods.
 		if actual != nil {
@@ -162,10 +163,10 @@ func TestGetDaemonSetListFromChannels(t *testing.T) {
 		}
 
 		if !reflect.DeepEqual(actual, c.expected) {
-			t.Errorf("GetDaemonSetListFromChannels() ==\n          %#v\nExpected: %#v", actual, c.expected)
+			t.Errorf("GetDaemonSetListFromChannels(context.TODO(), ) ==\n          %#v\nExpected: %#v", actual, c.expected)
 		}
 		if !reflect.DeepEqual(err, c.expectedError) {
-			t.Errorf("GetDaemonSetListFromChannels() ==\n          %#v\nExpected: %#v", err, c.expectedError)
+			t.Errorf("GetDaemonSetListFromChannels(context.TODO(), ) ==\n          %#v\nExpected: %#v", err, c.expectedError)
 		}
 	}
 }
@@ -393,7 +394,7 @@ func TestToDaemonSetList(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		actual := toDaemonSetList(c.daemonSets, c.pods, events, nil, dataselect.NoDataSelect, nil)
+		actual := toDaemonSetList(context.TODO(), c.daemonSets, c.pods, events, nil, dataselect.NoDataSelect, nil)
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("toDaemonSetList(%#v, %#v, %#v) == \n%#v\nexpected \n%#v\n", c.daemonSets, c.services, events, actual, c.expected)
 		}
@@ -30,28 +30,28 @@ import (
 )
 
 // GetDaemonSetPods return list of pods targeting daemon set.
-func GetDaemonSetPods(client k8sClient.Interface, metricClient metricapi.MetricClient,
+func GetDaemonSetPods(ctx context.Context, client k8sClient.Interface, metricClient metricapi.MetricClient,
 	dsQuery *dataselect.DataSelectQuery, daemonSetName, namespace string) (*pod.PodList, error) {
 	log.Printf("Getting replication controller %s pods in namespace %s", daemonSetName, namespace)
 
-	pods, err := getRawDaemonSetPods(client, daemonSetName, namespace)
+	pods, err := getRawDaemonSetPods(ctx, client, daemonSetName, namespace)
 	if err != nil {
 		return pod.EmptyPodList, err
 	}
 
-	events, err := event.GetPodsEvents(client, namespace, pods)
+	events, err := event.GetPodsEvents(ctx, client, namespace, pods)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	podList := pod.ToPodList(pods, events, nonCriticalErrors, dsQuery, metricClient)
+	podList := pod.ToPodList(ctx, pods, events, nonCriticalErrors, dsQuery, metricClient)
 	return &podList, nil
 }
 
 // Returns array of api pods targeting daemon set with given name.
-func getRawDaemonSetPods(client k8sClient.Interface, daemonSetName, namespace string) ([]api.Pod, error) {
-	daemonSet, err := client.AppsV1().DaemonSets(namespace).Get(context.TODO(), daemonSetName, metaV1.GetOptions{})
+func getRawDaemonSetPods(ctx context.Context, client k8sClient.Interface, daemonSetName, namespace string) ([]api.Pod, error) {
+	daemonSet, err := client.AppsV1().DaemonSets(namespace).Get(ctx, daemonSetName, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +63,7 @@ func getRawDaemonSetPods(client k8sClient.Interface, daemonSetName, namespace st
 	options := metaV1.ListOptions{LabelSelector: selector.String()}
 
 	channels := &common.ResourceChannels{
-		PodList: common.GetPodListChannelWithOptions(client, common.NewSameNamespaceQuery(namespace), options, 1),
+		PodList: common.GetPodListChannelWithOptions(ctx, client, common.NewSameNamespaceQuery(namespace), options, 1),
 	}
 
 	podList := <-channels.PodList.List
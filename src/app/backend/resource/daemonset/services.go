@@ -27,16 +27,16 @@ import (
 
 // GetDaemonSetServices returns list of services that are related to daemon set targeted by given
 // name.
-func GetDaemonSetServices(client client.Interface, dsQuery *dataselect.DataSelectQuery,
+func GetDaemonSetServices(ctx context.Context, client client.Interface, dsQuery *dataselect.DataSelectQuery,
 	namespace, name string) (*service.ServiceList, error) {
 
-	daemonSet, err := client.AppsV1().DaemonSets(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	daemonSet, err := client.AppsV1().DaemonSets(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	channels := &common.ResourceChannels{
-		ServiceList: common.GetServiceListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		ServiceList: common.GetServiceListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
 	services := <-channels.ServiceList.List
@@ -0,0 +1,103 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+)
+
+// CursorQuery holds settings of cursor pagination. Unlike PaginationQuery it is applied by the apiserver with
+// limit and continue list options, so only a single page of objects is fetched. Since the apiserver returns
+// objects in a fixed order, it can not be combined with sorting or filtering.
+type CursorQuery struct {
+	// Limit is a maximum number of items on the page.
+	Limit int64
+	// Continue is the apiserver token of the page that should be returned. Empty for the first page.
+	Continue string
+	// Offset is a number of items on all previous pages.
+	Offset int
+}
+
+// cursor is the content of opaque cursor returned to the client. Apart from the apiserver token it keeps the
+// offset of the next page, which is needed to estimate the total number of items.
+type cursor struct {
+	Continue string `json:"continue"`
+	Offset   int    `json:"offset"`
+}
+
+// NewCursorQuery creates CursorQuery object from limit and cursor returned by the previous page. Empty cursor
+// means the first page.
+func NewCursorQuery(limit int64, rawCursor string) (*CursorQuery, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit has to be greater than 0, got %d", limit)
+	}
+
+	query := &CursorQuery{Limit: limit}
+	if len(rawCursor) == 0 {
+		return query, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(rawCursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %s", err.Error())
+	}
+
+	c := cursor{}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %s", err.Error())
+	}
+
+	query.Continue, query.Offset = c.Continue, c.Offset
+	return query, nil
+}
+
+// next returns cursor of the page that follows the current page. It is empty when the current page is the
+// last one.
+func (self *CursorQuery) next(continueToken string, items int) string {
+	if len(continueToken) == 0 {
+		return ""
+	}
+
+	data, _ := json.Marshal(cursor{Continue: continueToken, Offset: self.Offset + items})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// CursorListMeta returns given list meta completed with cursor pagination details read from the list returned
+// by the apiserver. Total items are estimated from the number of items remaining on the apiserver. When the
+// apiserver does not report it, e.g. for lists filtered with label selector, the total only indicates whether
+// there is a next page. List meta is returned unchanged when cursor pagination is not used.
+func (self *DataSelectQuery) CursorListMeta(listMeta api.ListMeta, list metaV1.ListInterface) api.ListMeta {
+	if self == nil || self.CursorQuery == nil || list == nil {
+		return listMeta
+	}
+
+	continueToken := list.GetContinue()
+	remaining := 0
+	if count := list.GetRemainingItemCount(); count != nil {
+		remaining = int(*count)
+	} else if len(continueToken) > 0 {
+		remaining = 1
+	}
+
+	listMeta.Continue = self.CursorQuery.next(continueToken, listMeta.TotalItems)
+	listMeta.TotalItems = self.CursorQuery.Offset + listMeta.TotalItems + remaining
+	return listMeta
+}
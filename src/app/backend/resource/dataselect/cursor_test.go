@@ -0,0 +1,105 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+)
+
+func TestNewCursorQuery(t *testing.T) {
+	if _, err := NewCursorQuery(0, ""); err == nil {
+		t.Error("NewCursorQuery(0, \"\") expected error, got nil")
+	}
+
+	if _, err := NewCursorQuery(10, "not a cursor"); err == nil {
+		t.Error("NewCursorQuery(10, \"not a cursor\") expected error, got nil")
+	}
+
+	first, err := NewCursorQuery(10, "")
+	if err != nil {
+		t.Fatalf("NewCursorQuery(10, \"\") returned unexpected error: %s", err.Error())
+	}
+
+	expected := &CursorQuery{Limit: 10, Continue: "token", Offset: 10}
+	actual, err := NewCursorQuery(10, first.next("token", 10))
+	if err != nil {
+		t.Fatalf("NewCursorQuery() returned unexpected error: %s", err.Error())
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("NewCursorQuery() == %#v, expected %#v", actual, expected)
+	}
+}
+
+func TestCursorListMeta(t *testing.T) {
+	remaining := int64(25)
+	cases := []struct {
+		info        string
+		cursorQuery *CursorQuery
+		list        *v1.PodList
+		total       int
+		continued   bool
+	}{
+		{
+			"no cursor pagination",
+			nil,
+			&v1.PodList{ListMeta: metaV1.ListMeta{Continue: "token", RemainingItemCount: &remaining}},
+			10, false,
+		},
+		{
+			"first page",
+			&CursorQuery{Limit: 10},
+			&v1.PodList{ListMeta: metaV1.ListMeta{Continue: "token", RemainingItemCount: &remaining}},
+			35, true,
+		},
+		{
+			"next page",
+			&CursorQuery{Limit: 10, Continue: "token", Offset: 20},
+			&v1.PodList{ListMeta: metaV1.ListMeta{Continue: "token", RemainingItemCount: &remaining}},
+			55, true,
+		},
+		{
+			"unknown remaining items",
+			&CursorQuery{Limit: 10},
+			&v1.PodList{ListMeta: metaV1.ListMeta{Continue: "token"}},
+			11, true,
+		},
+		{
+			"last page",
+			&CursorQuery{Limit: 10, Continue: "token", Offset: 20},
+			&v1.PodList{},
+			30, false,
+		},
+	}
+
+	for _, c := range cases {
+		dsQuery := &DataSelectQuery{CursorQuery: c.cursorQuery}
+		actual := dsQuery.CursorListMeta(api.ListMeta{TotalItems: 10}, c.list)
+		if actual.TotalItems != c.total {
+			t.Errorf("%s: CursorListMeta() returned %d total items, expected %d", c.info, actual.TotalItems, c.total)
+		}
+
+		if (len(actual.Continue) > 0) != c.continued {
+			t.Errorf("%s: CursorListMeta() returned cursor %q, expected next page: %t", c.info, actual.Continue,
+				c.continued)
+		}
+	}
+}
@@ -15,6 +15,7 @@
 package dataselect
 
 import (
+	"context"
 	"log"
 	"sort"
 
@@ -46,6 +47,8 @@ type ComparableValue interface {
 	Compare(ComparableValue) int
 	// Returns true if self value contains or is equal to other value, false otherwise.
 	Contains(ComparableValue) bool
+	// Returns true if self value is in relation described by the operator with filter value, false otherwise.
+	Match(FilterOperator, ComparableValue) bool
 }
 
 // SelectableData contains all the required data to perform data selection.
@@ -110,7 +113,7 @@ func (self *DataSelector) Filter() *DataSelector {
 		matches := true
 		for _, filterBy := range self.DataSelectQuery.FilterQuery.FilterByList {
 			v := c.GetProperty(filterBy.Property)
-			if v == nil || !v.Contains(filterBy.Value) {
+			if v == nil || !v.Match(filterBy.Operator, filterBy.Value) {
 				matches = false
 				break
 			}
@@ -124,7 +127,7 @@ func (self *DataSelector) Filter() *DataSelector {
 	return self
 }
 
-func (self *DataSelector) getMetrics(metricClient metricapi.MetricClient) (
+func (self *DataSelector) getMetrics(ctx context.Context, metricClient metricapi.MetricClient) (
 	[]metricapi.MetricPromises, error) {
 	metricPromises := make([]metricapi.MetricPromises, 0)
 
@@ -150,7 +153,7 @@ func (self *DataSelector) getMetrics(metricClient metricapi.MetricClient) (
 	}
 
 	for _, metricName := range metricNames {
-		promises := metricClient.DownloadMetric(selectors, metricName, self.CachedResources)
+		promises := metricClient.DownloadMetric(ctx, selectors, metricName, self.CachedResources)
 		metricPromises = append(metricPromises, promises)
 	}
 
@@ -159,8 +162,8 @@ func (self *DataSelector) getMetrics(metricClient metricapi.MetricClient) (
 
 // GetMetrics downloads metrics for data cells currently present in self.GenericDataList as instructed
 // by MetricQuery and inserts resulting MetricPromises to self.MetricsPromises.
-func (self *DataSelector) GetMetrics(metricClient metricapi.MetricClient) *DataSelector {
-	metricPromisesList, err := self.getMetrics(metricClient)
+func (self *DataSelector) GetMetrics(ctx context.Context, metricClient metricapi.MetricClient) *DataSelector {
+	metricPromisesList, err := self.getMetrics(ctx, metricClient)
 	if err != nil {
 		log.Print(err)
 		return self
@@ -177,8 +180,9 @@ func (self *DataSelector) GetMetrics(metricClient metricapi.MetricClient) *DataS
 
 // GetCumulativeMetrics downloads and aggregates metrics for data cells currently present in self.GenericDataList as instructed
 // by MetricQuery and inserts resulting MetricPromises to self.CumulativeMetricsPromises.
-func (self *DataSelector) GetCumulativeMetrics(metricClient metricapi.MetricClient) *DataSelector {
-	metricPromisesList, err := self.getMetrics(metricClient)
+func (self *DataSelector) GetCumulativeMetrics(ctx context.Context,
+	metricClient metricapi.MetricClient) *DataSelector {
+	metricPromisesList, err := self.getMetrics(ctx, metricClient)
 	if err != nil {
 		log.Print(err)
 		return self
@@ -248,7 +252,7 @@ func GenericDataSelectWithFilter(dataList []DataCell, dsQuery *DataSelectQuery)
 }
 
 // GenericDataSelect takes a list of GenericDataCells and DataSelectQuery and returns selected data as instructed by dsQuery.
-func GenericDataSelectWithMetrics(dataList []DataCell, dsQuery *DataSelectQuery,
+func GenericDataSelectWithMetrics(ctx context.Context, dataList []DataCell, dsQuery *DataSelectQuery,
 	cachedResources *metricapi.CachedResources, metricClient metricapi.MetricClient) (
 	[]DataCell, metricapi.MetricPromises) {
 	SelectableData := DataSelector{
@@ -256,13 +260,14 @@ func GenericDataSelectWithMetrics(dataList []DataCell, dsQuery *DataSelectQuery,
 		DataSelectQuery: dsQuery,
 		CachedResources: cachedResources,
 	}
-	// Pipeline is Filter -> Sort -> CollectMetrics -> Paginate
-	processed := SelectableData.Sort().GetCumulativeMetrics(metricClient).Paginate()
+	// Pipeline is GetPropertyMetrics -> Sort -> CollectMetrics -> Paginate
+	processed := SelectableData.GetPropertyMetrics(ctx, metricClient).Sort().UnwrapCells().
+		GetCumulativeMetrics(ctx, metricClient).Paginate()
 	return processed.GenericDataList, processed.CumulativeMetricsPromises
 }
 
 // GenericDataSelect takes a list of GenericDataCells and DataSelectQuery and returns selected data as instructed by dsQuery.
-func GenericDataSelectWithFilterAndMetrics(dataList []DataCell, dsQuery *DataSelectQuery,
+func GenericDataSelectWithFilterAndMetrics(ctx context.Context, dataList []DataCell, dsQuery *DataSelectQuery,
 	cachedResources *metricapi.CachedResources, metricClient metricapi.MetricClient) (
 	[]DataCell, metricapi.MetricPromises, int) {
 	SelectableData := DataSelector{
@@ -270,15 +275,15 @@ func GenericDataSelectWithFilterAndMetrics(dataList []DataCell, dsQuery *DataSel
 		DataSelectQuery: dsQuery,
 		CachedResources: cachedResources,
 	}
-	// Pipeline is Filter -> Sort -> CollectMetrics -> Paginate
-	filtered := SelectableData.Filter()
+	// Pipeline is GetPropertyMetrics -> Filter -> Sort -> CollectMetrics -> Paginate
+	filtered := SelectableData.GetPropertyMetrics(ctx, metricClient).Filter()
 	filteredTotal := len(filtered.GenericDataList)
-	processed := filtered.Sort().GetCumulativeMetrics(metricClient).Paginate()
+	processed := filtered.Sort().UnwrapCells().GetCumulativeMetrics(ctx, metricClient).Paginate()
 	return processed.GenericDataList, processed.CumulativeMetricsPromises, filteredTotal
 }
 
 // PodListMetrics returns metrics for every resource on the dataList without aggregating data.
-func PodListMetrics(dataList []DataCell, dsQuery *DataSelectQuery,
+func PodListMetrics(ctx context.Context, dataList []DataCell, dsQuery *DataSelectQuery,
 	metricClient metricapi.MetricClient) metricapi.MetricPromises {
 	selectableData := DataSelector{
 		GenericDataList: dataList,
@@ -286,6 +291,6 @@ func PodListMetrics(dataList []DataCell, dsQuery *DataSelectQuery,
 		CachedResources: metricapi.NoResourceCache,
 	}
 
-	processed := selectableData.GetMetrics(metricClient)
+	processed := selectableData.GetMetrics(ctx, metricClient)
 	return processed.MetricsPromises
 }
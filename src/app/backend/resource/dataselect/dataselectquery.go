@@ -15,6 +15,9 @@
 package dataselect
 
 import (
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
 	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
 )
 
@@ -26,6 +29,54 @@ type DataSelectQuery struct {
 	SortQuery       *SortQuery
 	FilterQuery     *FilterQuery
 	MetricQuery     *MetricQuery
+	SelectorQuery   *SelectorQuery
+	// CursorQuery is set when cursor pagination is used instead of PaginationQuery.
+	CursorQuery *CursorQuery
+}
+
+// UsesProperty returns true if the query sorts or filters data by given property.
+func (self *DataSelectQuery) UsesProperty(name PropertyName) bool {
+	if self == nil {
+		return false
+	}
+
+	if self.SortQuery != nil {
+		for _, sortBy := range self.SortQuery.SortByList {
+			if sortBy.Property == name {
+				return true
+			}
+		}
+	}
+
+	if self.FilterQuery != nil {
+		for _, filterBy := range self.FilterQuery.FilterByList {
+			if filterBy.Property == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ListOptions returns options that should be used to list the primary resource of the query, so that selectors
+// and cursor pagination are applied by the apiserver.
+func (self *DataSelectQuery) ListOptions() metaV1.ListOptions {
+	if self == nil {
+		return api.ListEverything
+	}
+
+	options := api.ListEverything
+	if self.SelectorQuery != nil {
+		options = self.SelectorQuery.ToListOptions()
+	}
+
+	if self.CursorQuery != nil {
+		options.Limit = self.CursorQuery.Limit
+		options.Continue = self.CursorQuery.Continue
+	}
+
+	return options
 }
 
 var NoMetrics = NewMetricQuery(nil, nil)
@@ -75,8 +126,11 @@ type FilterQuery struct {
 	FilterByList []FilterBy
 }
 
+// FilterBy holds a single filter expression. Item matches it if value of its property is in relation described
+// by the operator with filter value.
 type FilterBy struct {
 	Property PropertyName
+	Operator FilterOperator
 	Value    ComparableValue
 }
 
@@ -84,6 +138,37 @@ var NoFilter = &FilterQuery{
 	FilterByList: []FilterBy{},
 }
 
+// SelectorQuery holds label and field selectors. Unlike filters they are not applied by data select, but passed
+// to the apiserver, so only matching objects are fetched.
+type SelectorQuery struct {
+	LabelSelector string
+	FieldSelector string
+}
+
+// NoSelector is an option for no selectors, all objects are fetched.
+var NoSelector = &SelectorQuery{}
+
+// NewSelectorQuery creates SelectorQuery object from raw label and field selectors.
+func NewSelectorQuery(labelSelector, fieldSelector string) *SelectorQuery {
+	return &SelectorQuery{
+		LabelSelector: labelSelector,
+		FieldSelector: fieldSelector,
+	}
+}
+
+// ToListOptions returns list options with the selectors set.
+func (self *SelectorQuery) ToListOptions() metaV1.ListOptions {
+	options := api.ListEverything
+	if len(self.LabelSelector) > 0 {
+		options.LabelSelector = self.LabelSelector
+	}
+	if len(self.FieldSelector) > 0 {
+		options.FieldSelector = self.FieldSelector
+	}
+
+	return options
+}
+
 // NoDataSelect is an option for no data select (same data will be returned).
 var NoDataSelect = NewDataSelectQuery(NoPagination, NoSort, NoFilter, NoMetrics)
 
@@ -103,6 +188,7 @@ func NewDataSelectQuery(paginationQuery *PaginationQuery, sortQuery *SortQuery,
 		SortQuery:       sortQuery,
 		FilterQuery:     filterQuery,
 		MetricQuery:     graphQuery,
+		SelectorQuery:   NoSelector,
 	}
 }
 
@@ -144,7 +230,7 @@ func NewSortQuery(sortByListRaw []string) *SortQuery {
 
 // NewFilterQuery takes raw filter options list and returns FilterQuery object. For example:
 // ["parameter1", "value1", "parameter2", "value2"] - means that the data should be filtered by
-// parameter1 equals value1 and parameter2 equals value2
+// parameter1 contains value1 and parameter2 contains value2. Properties that are not strings have to be equal.
 func NewFilterQuery(filterByListRaw []string) *FilterQuery {
 	if filterByListRaw == nil || len(filterByListRaw)%2 == 1 {
 		return NoFilter
@@ -155,6 +241,7 @@ func NewFilterQuery(filterByListRaw []string) *FilterQuery {
 		propertyValue := filterByListRaw[i+1]
 		filterBy := FilterBy{
 			Property: PropertyName(propertyName),
+			Operator: LegacyOperator,
 			Value:    StdComparableString(propertyValue),
 		}
 		// Add to the filter options.
@@ -0,0 +1,58 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"reflect"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+)
+
+func TestDataSelectQueryListOptions(t *testing.T) {
+	cases := []struct {
+		info     string
+		dsQuery  *DataSelectQuery
+		expected metaV1.ListOptions
+	}{
+		{"nil query", nil, api.ListEverything},
+		{"query without selectors", &DataSelectQuery{}, api.ListEverything},
+		{"no selector", NoDataSelect, api.ListEverything},
+		{
+			"label selector",
+			&DataSelectQuery{SelectorQuery: NewSelectorQuery("app=foo", "")},
+			metaV1.ListOptions{LabelSelector: "app=foo"},
+		},
+		{
+			"label and field selector",
+			&DataSelectQuery{SelectorQuery: NewSelectorQuery("app=foo", "status.phase=Running")},
+			metaV1.ListOptions{LabelSelector: "app=foo", FieldSelector: "status.phase=Running"},
+		},
+		{
+			"cursor pagination",
+			&DataSelectQuery{SelectorQuery: NoSelector, CursorQuery: &CursorQuery{Limit: 10, Continue: "token"}},
+			metaV1.ListOptions{Limit: 10, Continue: "token"},
+		},
+	}
+
+	for _, c := range cases {
+		actual := c.dsQuery.ListOptions()
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("%s: ListOptions() == %#v, expected %#v", c.info, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FilterOperator describes how property value is compared with the value of filter expression.
+type FilterOperator string
+
+const (
+	// ContainsOperator matches values that contain filter value.
+	ContainsOperator FilterOperator = "~"
+	// LegacyOperator is used by legacy 'property,value' filters. Strings match if they contain filter value, other
+	// values, e.g. numbers and times, match if they are equal to it. It has no symbol in filter expressions.
+	LegacyOperator FilterOperator = ","
+	// EqualOperator matches values equal to filter value.
+	EqualOperator FilterOperator = "="
+	// NotEqualOperator matches values not equal to filter value.
+	NotEqualOperator FilterOperator = "!="
+	// PrefixOperator matches values that start with filter value.
+	PrefixOperator FilterOperator = "^="
+	// RegexOperator matches values that match regular expression given as filter value.
+	RegexOperator FilterOperator = "=~"
+	// NotRegexOperator matches values that do not match regular expression given as filter value.
+	NotRegexOperator FilterOperator = "!~"
+	// GreaterOperator matches values greater than filter value.
+	GreaterOperator FilterOperator = ">"
+	// GreaterOrEqualOperator matches values greater than or equal to filter value.
+	GreaterOrEqualOperator FilterOperator = ">="
+	// LessOperator matches values less than filter value.
+	LessOperator FilterOperator = "<"
+	// LessOrEqualOperator matches values less than or equal to filter value.
+	LessOrEqualOperator FilterOperator = "<="
+)
+
+// FilterOperators lists all supported operators. Operators that are prefixes of other operators come after them,
+// so the list can be used to find the longest operator at the beginning of a string.
+var FilterOperators = []FilterOperator{
+	NotEqualOperator, PrefixOperator, RegexOperator, NotRegexOperator, GreaterOrEqualOperator, LessOrEqualOperator,
+	EqualOperator, GreaterOperator, LessOperator, ContainsOperator,
+}
+
+// StdComparableRegexp is a filter value used by regular expression operators. It keeps the expression compiled,
+// so it is not compiled again for every filtered item.
+type StdComparableRegexp struct {
+	*regexp.Regexp
+}
+
+// NewStdComparableRegexp compiles given regular expression into filter value.
+func NewStdComparableRegexp(expr string) (StdComparableRegexp, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return StdComparableRegexp{}, err
+	}
+
+	return StdComparableRegexp{re}, nil
+}
+
+func (self StdComparableRegexp) Compare(otherV ComparableValue) int {
+	return strings.Compare(self.String(), filterValueString(otherV))
+}
+
+func (self StdComparableRegexp) Contains(otherV ComparableValue) bool {
+	return self.MatchString(filterValueString(otherV))
+}
+
+func (self StdComparableRegexp) Match(operator FilterOperator, value ComparableValue) bool {
+	return matchString(self.String(), operator, value)
+}
+
+// filterValueString returns string representation of filter value.
+func filterValueString(value ComparableValue) string {
+	switch v := value.(type) {
+	case StdComparableString:
+		return string(v)
+	case StdComparableRegexp:
+		return v.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// matchString matches string representation of a property value with the filter value.
+func matchString(s string, operator FilterOperator, value ComparableValue) bool {
+	switch operator {
+	case ContainsOperator, LegacyOperator:
+		return strings.Contains(s, filterValueString(value))
+	case PrefixOperator:
+		return strings.HasPrefix(s, filterValueString(value))
+	case RegexOperator, NotRegexOperator:
+		re, ok := value.(StdComparableRegexp)
+		if !ok {
+			var err error
+			if re, err = NewStdComparableRegexp(filterValueString(value)); err != nil {
+				return false
+			}
+		}
+		return re.MatchString(s) == (operator == RegexOperator)
+	default:
+		return matchCompare(strings.Compare(s, filterValueString(value)), operator)
+	}
+}
+
+// matchCompare tells if result of comparing property value with the filter value satisfies given operator.
+func matchCompare(cmp int, operator FilterOperator) bool {
+	switch operator {
+	case EqualOperator:
+		return cmp == 0
+	case NotEqualOperator:
+		return cmp != 0
+	case GreaterOperator:
+		return cmp > 0
+	case GreaterOrEqualOperator:
+		return cmp >= 0
+	case LessOperator:
+		return cmp < 0
+	case LessOrEqualOperator:
+		return cmp <= 0
+	default:
+		return false
+	}
+}
+
+// legacyToEqual returns equal operator for legacy filters on values that are not strings, so they keep matching
+// only equal values. Other operators are returned unchanged.
+func legacyToEqual(operator FilterOperator) FilterOperator {
+	if operator == LegacyOperator {
+		return EqualOperator
+	}
+	return operator
+}
+
+// isOrderOperator returns true for operators that compare values rather than their string representations.
+func isOrderOperator(operator FilterOperator) bool {
+	switch operator {
+	case EqualOperator, NotEqualOperator, GreaterOperator, GreaterOrEqualOperator, LessOperator, LessOrEqualOperator:
+		return true
+	default:
+		return false
+	}
+}
+
+// filterTimeLayouts are layouts accepted as values of filters on time properties.
+var filterTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"}
+
+// parseFilterTime parses filter value as time. Dates without time zone are treated as UTC.
+func parseFilterTime(value ComparableValue) (time.Time, bool) {
+	for _, layout := range filterTimeLayouts {
+		if t, err := time.Parse(layout, filterValueString(value)); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// parseFilterInt parses filter value as integer.
+func parseFilterInt(value ComparableValue) (int, bool) {
+	i, err := strconv.Atoi(filterValueString(value))
+	return i, err == nil
+}
+
+// parseFilterFloat parses filter value as floating point number.
+func parseFilterFloat(value ComparableValue) (float64, bool) {
+	f, err := strconv.ParseFloat(filterValueString(value), 64)
+	return f, err == nil
+}
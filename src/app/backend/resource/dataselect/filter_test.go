@@ -0,0 +1,62 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestMatch(t *testing.T) {
+	created := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		value    ComparableValue
+		operator FilterOperator
+		filter   ComparableValue
+		expected bool
+	}{
+		{StdComparableString("api-server"), ContainsOperator, StdComparableString("server"), true},
+		{StdComparableString("api-server"), EqualOperator, StdComparableString("api"), false},
+		{StdComparableString("api-server"), EqualOperator, StdComparableString("api-server"), true},
+		{StdComparableString("Running"), NotEqualOperator, StdComparableString("Running"), false},
+		{StdComparableString("api-server"), PrefixOperator, StdComparableString("api-"), true},
+		{StdComparableString("api-server"), RegexOperator, StdComparableRegexp{regexp.MustCompile("^api-")}, true},
+		{StdComparableString("web"), RegexOperator, StdComparableRegexp{regexp.MustCompile("^api-")}, false},
+		{StdComparableString("web"), NotRegexOperator, StdComparableRegexp{regexp.MustCompile("^api-")}, true},
+		{StdComparableString("b"), GreaterOperator, StdComparableString("a"), true},
+		{StdComparableInt(10), GreaterOperator, StdComparableString("9"), true},
+		{StdComparableInt(10), LessOrEqualOperator, StdComparableString("9"), false},
+		{StdComparableInt(10), ContainsOperator, StdComparableString("1"), true},
+		{StdComparableTime(created), GreaterOperator, StdComparableString("2024-01-01"), true},
+		{StdComparableTime(created), LessOperator, StdComparableString("2024-03-10T11:00:00Z"), false},
+		{StdComparableTime(created), GreaterOrEqualOperator, StdComparableString("2024-03-10T12:00:00"), true},
+		{StdComparableTime(created), ContainsOperator, StdComparableString("2024-03"), true},
+		{StdComparableRFC3339Timestamp("2024-03-10T12:00:00Z"), LessOperator, StdComparableString("2024-04-01"), true},
+		{StdComparableRFC3339Timestamp("invalid"), EqualOperator, StdComparableString("invalid"), true},
+		{StdComparableString("api-server"), LegacyOperator, StdComparableString("server"), true},
+		{StdComparableInt(10), LegacyOperator, StdComparableString("1"), false},
+		{StdComparableInt(10), LegacyOperator, StdComparableString("10"), true},
+		{StdComparableTime(created), LegacyOperator, StdComparableString("2024-03"), false},
+		{StdComparableTime(created), LegacyOperator, StdComparableString("2024-03-10T12:00:00Z"), true},
+	}
+
+	for _, c := range cases {
+		actual := c.value.Match(c.operator, c.filter)
+		if actual != c.expected {
+			t.Errorf("%#v.Match(%q, %#v) == %t, expected %t", c.value, c.operator, c.filter, actual, c.expected)
+		}
+	}
+}
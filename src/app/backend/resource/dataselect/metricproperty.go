@@ -0,0 +1,136 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"context"
+	"log"
+
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+)
+
+// metricProperties maps properties backed by metrics to names of the metrics.
+var metricProperties = map[PropertyName]string{
+	CPUUsageProperty:    metricapi.CpuUsage,
+	MemoryUsageProperty: metricapi.MemoryUsage,
+}
+
+// metricCell exposes most recent values of metrics downloaded for a data cell as its properties, so the data can
+// be filtered and sorted by them.
+type metricCell struct {
+	DataCell
+	metrics map[PropertyName]ComparableValue
+}
+
+// GetProperty returns metric value for properties backed by metrics and delegates to the data cell otherwise.
+func (self metricCell) GetProperty(name PropertyName) ComparableValue {
+	if value, exists := self.metrics[name]; exists {
+		return value
+	}
+
+	return self.DataCell.GetProperty(name)
+}
+
+// GetPropertyMetrics downloads metrics that back properties used by filter and sort queries for all data cells
+// currently present in self.GenericDataList, so they are available before the data is paginated. Data cells
+// are wrapped and have to be unwrapped with UnwrapCells once they are filtered and sorted.
+func (self *DataSelector) GetPropertyMetrics(ctx context.Context,
+	metricClient metricapi.MetricClient) *DataSelector {
+	metricNames := make(map[PropertyName]string)
+	for property, metricName := range metricProperties {
+		if self.DataSelectQuery.UsesProperty(property) {
+			metricNames[property] = metricName
+		}
+	}
+
+	if len(metricNames) == 0 {
+		return self
+	}
+
+	// Missing metrics are zero, so every cell has a value to compare and sorting stays consistent.
+	cells := make([]metricCell, len(self.GenericDataList))
+	for i, dataCell := range self.GenericDataList {
+		cells[i] = metricCell{DataCell: dataCell, metrics: make(map[PropertyName]ComparableValue)}
+		for property := range metricNames {
+			cells[i].metrics[property] = StdComparableInt(0)
+		}
+	}
+
+	if metricClient == nil {
+		log.Print("No metric client provided. Skipping metrics used to sort and filter data.")
+	} else {
+		self.downloadPropertyMetrics(ctx, metricClient, metricNames, cells)
+	}
+
+	for i := range cells {
+		self.GenericDataList[i] = cells[i]
+	}
+
+	return self
+}
+
+// downloadPropertyMetrics sets most recent values of given metrics as properties of the cells.
+func (self *DataSelector) downloadPropertyMetrics(ctx context.Context, metricClient metricapi.MetricClient,
+	metricNames map[PropertyName]string, cells []metricCell) {
+	selectors := make([]metricapi.ResourceSelector, len(self.GenericDataList))
+	for i, dataCell := range self.GenericDataList {
+		if metricDataCell, ok := dataCell.(MetricDataCell); ok {
+			selectors[i] = *metricDataCell.GetResourceSelector()
+		}
+	}
+
+	cachedResources := self.CachedResources
+	if cachedResources == nil {
+		cachedResources = metricapi.NoResourceCache
+	}
+
+	for property, metricName := range metricNames {
+		promises := metricClient.DownloadMetric(ctx, selectors, metricName, cachedResources)
+		for i, promise := range promises {
+			metric, err := promise.GetMetric()
+			if err != nil || metric == nil {
+				continue
+			}
+
+			if value, ok := latestMetricValue(metric); ok {
+				cells[i].metrics[property] = StdComparableInt(value)
+			}
+		}
+	}
+}
+
+// UnwrapCells replaces data cells wrapped by GetPropertyMetrics with the original ones.
+func (self *DataSelector) UnwrapCells() *DataSelector {
+	for i, dataCell := range self.GenericDataList {
+		if cell, ok := dataCell.(metricCell); ok {
+			self.GenericDataList[i] = cell.DataCell
+		}
+	}
+
+	return self
+}
+
+// latestMetricValue returns the most recent value of given metric.
+func latestMetricValue(metric *metricapi.Metric) (int, bool) {
+	if len(metric.MetricPoints) > 0 {
+		return int(metric.MetricPoints[len(metric.MetricPoints)-1].Value), true
+	}
+
+	if len(metric.DataPoints) > 0 {
+		return int(metric.DataPoints[len(metric.DataPoints)-1].Y), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,164 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	integrationapi "github.com/kubernetes/dashboard/src/app/backend/integration/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+)
+
+type testMetricCell struct {
+	Name string
+}
+
+func (self testMetricCell) GetProperty(name PropertyName) ComparableValue {
+	if name == NameProperty {
+		return StdComparableString(self.Name)
+	}
+	return nil
+}
+
+func (self testMetricCell) GetResourceSelector() *metricapi.ResourceSelector {
+	return &metricapi.ResourceSelector{ResourceName: self.Name, UID: types.UID(self.Name)}
+}
+
+// fakeMetricClient returns metrics with values taken from the map by resource name.
+type fakeMetricClient struct {
+	values map[string]uint64
+}
+
+func (self fakeMetricClient) ID() integrationapi.IntegrationID {
+	return "fake"
+}
+
+func (self fakeMetricClient) HealthCheck() error {
+	return nil
+}
+
+func (self fakeMetricClient) DownloadMetric(ctx context.Context, selectors []metricapi.ResourceSelector,
+	metricName string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	promises := metricapi.NewMetricPromises(len(selectors))
+	metrics := make([]metricapi.Metric, len(selectors))
+	for i, selector := range selectors {
+		metrics[i] = metricapi.Metric{MetricName: metricName}
+		if value, ok := self.values[selector.ResourceName]; ok {
+			metrics[i].MetricPoints = []metricapi.MetricPoint{{Value: 0}, {Value: value}}
+		}
+	}
+	promises.PutMetrics(metrics, nil)
+	return promises
+}
+
+func (self fakeMetricClient) DownloadMetrics(ctx context.Context, selectors []metricapi.ResourceSelector,
+	metricNames []string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	return nil
+}
+
+func (self fakeMetricClient) AggregateMetrics(metrics metricapi.MetricPromises, metricName string,
+	aggregations metricapi.AggregationModes) metricapi.MetricPromises {
+	return nil
+}
+
+func TestGenericDataSelectWithFilterAndMetricProperties(t *testing.T) {
+	metricClient := fakeMetricClient{values: map[string]uint64{"a": 30, "b": 10, "c": 20, "d": 40}}
+	cases := []struct {
+		info     string
+		dsQuery  *DataSelectQuery
+		expected []string
+		total    int
+	}{
+		{
+			"sort by cpu usage",
+			NewDataSelectQuery(NoPagination, NewSortQuery([]string{"d", CPUUsageProperty}), NoFilter, NoMetrics),
+			[]string{"d", "a", "c", "b"},
+			4,
+		},
+		{
+			"sort by memory usage and paginate",
+			NewDataSelectQuery(NewPaginationQuery(2, 0), NewSortQuery([]string{"a", MemoryUsageProperty}), NoFilter,
+				NoMetrics),
+			[]string{"b", "c"},
+			4,
+		},
+		{
+			"filter by cpu usage",
+			NewDataSelectQuery(NoPagination, NoSort, &FilterQuery{FilterByList: []FilterBy{
+				{Property: CPUUsageProperty, Operator: GreaterOrEqualOperator, Value: StdComparableString("20")},
+			}}, NoMetrics),
+			[]string{"a", "c", "d"},
+			3,
+		},
+	}
+
+	for _, c := range cases {
+		cells := []DataCell{testMetricCell{"a"}, testMetricCell{"b"}, testMetricCell{"c"}, testMetricCell{"d"}}
+		selected, _, total := GenericDataSelectWithFilterAndMetrics(context.TODO(), cells, c.dsQuery,
+			metricapi.NoResourceCache, metricClient)
+
+		actual := make([]string, len(selected))
+		for i, cell := range selected {
+			testCell, ok := cell.(testMetricCell)
+			if !ok {
+				t.Fatalf("%s: selected data cell %#v was not unwrapped", c.info, cell)
+			}
+			actual[i] = testCell.Name
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) || total != c.total {
+			t.Errorf("%s: selected %v of %d, expected %v of %d", c.info, actual, total, c.expected, c.total)
+		}
+	}
+}
+
+func TestGenericDataSelectWithMissingMetricProperties(t *testing.T) {
+	sortQuery := NewSortQuery([]string{"d", CPUUsageProperty, "a", NameProperty})
+	cases := []struct {
+		info         string
+		metricClient metricapi.MetricClient
+		expected     []string
+	}{
+		{
+			"cells without metrics are sorted as zero",
+			fakeMetricClient{values: map[string]uint64{"a": 30, "c": 20}},
+			[]string{"a", "c", "b", "d"},
+		},
+		{
+			"all cells are sorted as zero without metric client",
+			nil,
+			[]string{"a", "b", "c", "d"},
+		},
+	}
+
+	for _, c := range cases {
+		cells := []DataCell{testMetricCell{"d"}, testMetricCell{"b"}, testMetricCell{"c"}, testMetricCell{"a"}}
+		selected, _ := GenericDataSelectWithMetrics(context.TODO(), cells,
+			NewDataSelectQuery(NoPagination, sortQuery, NoFilter, NoMetrics), metricapi.NoResourceCache, c.metricClient)
+
+		actual := make([]string, len(selected))
+		for i, cell := range selected {
+			actual[i] = cell.(testMetricCell).Name
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("%s: selected %v, expected %v", c.info, actual, c.expected)
+		}
+	}
+}
@@ -28,4 +28,15 @@ const (
 	FirstSeenProperty         = "firstSeen"
 	LastSeenProperty          = "lastSeen"
 	ReasonProperty            = "reason"
+	RestartsProperty          = "restarts"
+	NodeNameProperty          = "nodeName"
+	QOSClassProperty          = "qosClass"
+	IPProperty                = "ip"
+	ReadyRatioProperty        = "readyRatio"
+	AllocatedCPUProperty      = "allocatedCPU"
+	AllocatedMemoryProperty   = "allocatedMemory"
+
+	// Properties backed by metrics. Data cells do not provide them, their values are downloaded by DataSelector.
+	CPUUsageProperty    = "cpuUsage"
+	MemoryUsageProperty = "memoryUsage"
 )
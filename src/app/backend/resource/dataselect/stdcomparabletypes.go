@@ -15,6 +15,7 @@
 package dataselect
 
 import (
+	"strconv"
 	"strings"
 	"time"
 )
@@ -36,6 +37,34 @@ func (self StdComparableInt) Contains(otherV ComparableValue) bool {
 	return self.Compare(otherV) == 0
 }
 
+func (self StdComparableInt) Match(operator FilterOperator, value ComparableValue) bool {
+	operator = legacyToEqual(operator)
+	if other, ok := parseFilterInt(value); ok && isOrderOperator(operator) {
+		return matchCompare(intsCompare(int(self), other), operator)
+	}
+	return matchString(strconv.Itoa(int(self)), operator, value)
+}
+
+// StdComparableFloat compares floating point numbers, e.g. ratios.
+type StdComparableFloat float64
+
+func (self StdComparableFloat) Compare(otherV ComparableValue) int {
+	other := otherV.(StdComparableFloat)
+	return floatsCompare(float64(self), float64(other))
+}
+
+func (self StdComparableFloat) Contains(otherV ComparableValue) bool {
+	return self.Compare(otherV) == 0
+}
+
+func (self StdComparableFloat) Match(operator FilterOperator, value ComparableValue) bool {
+	operator = legacyToEqual(operator)
+	if other, ok := parseFilterFloat(value); ok && isOrderOperator(operator) {
+		return matchCompare(floatsCompare(float64(self), other), operator)
+	}
+	return matchString(strconv.FormatFloat(float64(self), 'f', -1, 64), operator, value)
+}
+
 type StdComparableString string
 
 func (self StdComparableString) Compare(otherV ComparableValue) int {
@@ -48,6 +77,10 @@ func (self StdComparableString) Contains(otherV ComparableValue) bool {
 	return strings.Contains(string(self), string(other))
 }
 
+func (self StdComparableString) Match(operator FilterOperator, value ComparableValue) bool {
+	return matchString(string(self), operator, value)
+}
+
 // StdComparableRFC3339Timestamp takes RFC3339 Timestamp strings and compares them as TIMES. In case of time parsing error compares values as strings.
 type StdComparableRFC3339Timestamp string
 
@@ -68,6 +101,14 @@ func (self StdComparableRFC3339Timestamp) Contains(otherV ComparableValue) bool
 	return self.Compare(otherV) == 0
 }
 
+func (self StdComparableRFC3339Timestamp) Match(operator FilterOperator, value ComparableValue) bool {
+	selfTime, err := time.Parse(time.RFC3339, string(self))
+	if err == nil {
+		return StdComparableTime(selfTime).Match(operator, value)
+	}
+	return matchString(string(self), operator, value)
+}
+
 type StdComparableTime time.Time
 
 func (self StdComparableTime) Compare(otherV ComparableValue) int {
@@ -79,6 +120,14 @@ func (self StdComparableTime) Contains(otherV ComparableValue) bool {
 	return self.Compare(otherV) == 0
 }
 
+func (self StdComparableTime) Match(operator FilterOperator, value ComparableValue) bool {
+	operator = legacyToEqual(operator)
+	if other, ok := parseFilterTime(value); ok && isOrderOperator(operator) {
+		return matchCompare(ints64Compare(time.Time(self).Unix(), other.Unix()), operator)
+	}
+	return matchString(time.Time(self).UTC().Format(time.RFC3339), operator, value)
+}
+
 // Int comparison functions. Similar to strings.Compare.
 func intsCompare(a, b int) int {
 	if a > b {
@@ -89,6 +138,15 @@ func intsCompare(a, b int) int {
 	return -1
 }
 
+func floatsCompare(a, b float64) int {
+	if a > b {
+		return 1
+	} else if a == b {
+		return 0
+	}
+	return -1
+}
+
 func ints64Compare(a, b int64) int {
 	if a > b {
 		return 1
@@ -0,0 +1,252 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"io"
+	"log"
+	"sort"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// ApplyOperation is the outcome of applying a single object from a file.
+type ApplyOperation string
+
+// List of outcomes that can be reported for an object.
+const (
+	ApplyOperationCreated    ApplyOperation = "created"
+	ApplyOperationUpdated    ApplyOperation = "updated"
+	ApplyOperationUnchanged  ApplyOperation = "unchanged"
+	ApplyOperationFailed     ApplyOperation = "failed"
+	ApplyOperationSkipped    ApplyOperation = "skipped"
+	ApplyOperationRolledBack ApplyOperation = "rolledBack"
+)
+
+// ObjectResult is the result of deploying a single object from a file.
+type ObjectResult struct {
+	APIVersion string         `json:"apiVersion"`
+	Kind       string         `json:"kind"`
+	Namespace  string         `json:"namespace,omitempty"`
+	Name       string         `json:"name"`
+	Operation  ApplyOperation `json:"operation"`
+
+	// Error returned for this object, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// applyOrder ranks kinds, so that objects are applied after the objects they depend on. Kinds that are not
+// listed, like custom resources, are applied last.
+var applyOrder = map[string]int{
+	"Namespace":                0,
+	"CustomResourceDefinition": 1,
+	"PriorityClass":            2,
+	"StorageClass":             2,
+	"ServiceAccount":           3,
+	"ClusterRole":              3,
+	"Role":                     3,
+	"ClusterRoleBinding":       4,
+	"RoleBinding":              4,
+	"ResourceQuota":            5,
+	"LimitRange":               5,
+	"ConfigMap":                5,
+	"Secret":                   5,
+	"PersistentVolume":         5,
+	"PersistentVolumeClaim":    6,
+	"Service":                  7,
+	"Pod":                      8,
+	"ReplicationController":    8,
+	"ReplicaSet":               8,
+	"Deployment":               8,
+	"StatefulSet":              8,
+	"DaemonSet":                8,
+	"Job":                      8,
+	"CronJob":                  8,
+}
+
+// defaultApplyOrder is the rank of kinds that are not listed in applyOrder.
+const defaultApplyOrder = 9
+
+func newObjectResult(object *unstructured.Unstructured, operation ApplyOperation) ObjectResult {
+	return ObjectResult{
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Namespace:  object.GetNamespace(),
+		Name:       object.GetName(),
+		Operation:  operation,
+	}
+}
+
+// applyAppFromFile creates or updates every object of the file. The whole file is parsed before any change is
+// made. If an object fails, remaining objects are skipped and objects created so far are deleted when rollback
+// is enabled.
+func applyAppFromFile(ctx context.Context, discoveryClient discovery.DiscoveryInterface, dynamicClient dynamic.Interface,
+	spec *AppDeploymentFromFileSpec) ([]ObjectResult, error) {
+	log.Printf("Applying file %s in namespace %s", spec.Name, spec.Namespace)
+	objects, err := decodeObjects(spec.Content)
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+	sortByApplyOrder(objects)
+
+	results := make([]ObjectResult, len(objects))
+	for i, object := range objects {
+		results[i] = newObjectResult(object, ApplyOperationSkipped)
+	}
+
+	var created []dynamic.ResourceInterface
+	for i, object := range objects {
+		var applied *unstructured.Unstructured
+		resourceClient, err := getResourceClient(discoveryClient, dynamicClient, object, spec)
+		if err == nil {
+			applied, _, results[i].Operation, err = applyObject(ctx, resourceClient, object, spec.DryRun)
+		}
+
+		if err != nil {
+			err = errors.LocalizeError(err)
+			results[i].Operation = ApplyOperationFailed
+			results[i].Error = err.Error()
+			if spec.Rollback && !spec.DryRun {
+				rollback(ctx, created, objects[:i], results[:i])
+			}
+			return results, err
+		}
+
+		// Generated name and default namespace are known only after the object was stored.
+		results[i] = newObjectResult(applied, results[i].Operation)
+		objects[i] = applied
+		if results[i].Operation == ApplyOperationCreated {
+			created = append(created, resourceClient)
+		} else {
+			created = append(created, nil)
+		}
+	}
+
+	return results, nil
+}
+
+// applyObject creates the object if it doesn't exist yet. Otherwise the object is updated with a forced
+// server-side apply, so that the file becomes the source of truth for all fields it sets, while fields set by
+// controllers are kept. The object returned by the apiserver is returned together with the live object, which
+// is nil if the object was created.
+func applyObject(ctx context.Context, resourceClient dynamic.ResourceInterface, object *unstructured.Unstructured,
+	dryRun bool) (*unstructured.Unstructured, *unstructured.Unstructured, ApplyOperation, error) {
+	var dryRunOption []string
+	if dryRun {
+		dryRunOption = []string{metaV1.DryRunAll}
+	}
+
+	createOptions := metaV1.CreateOptions{DryRun: dryRunOption, FieldManager: clientapi.DashboardFieldManager}
+	if len(object.GetName()) == 0 {
+		// Objects with generated names are always new.
+		created, err := resourceClient.Create(ctx, object, createOptions)
+		return created, nil, ApplyOperationCreated, err
+	}
+
+	live, err := resourceClient.Get(ctx, object.GetName(), metaV1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		created, err := resourceClient.Create(ctx, object, createOptions)
+		return created, nil, ApplyOperationCreated, err
+	}
+	if err != nil {
+		return nil, nil, ApplyOperationFailed, err
+	}
+
+	body, err := object.MarshalJSON()
+	if err != nil {
+		return nil, nil, ApplyOperationFailed, err
+	}
+
+	force := true
+	applied, err := resourceClient.Patch(ctx, object.GetName(), types.ApplyPatchType, body,
+		metaV1.PatchOptions{
+			DryRun:       dryRunOption,
+			FieldManager: clientapi.DashboardFieldManager,
+			Force:        &force,
+		})
+	if err != nil {
+		return nil, nil, ApplyOperationFailed, err
+	}
+
+	if applied.GetResourceVersion() == live.GetResourceVersion() {
+		return applied, live, ApplyOperationUnchanged, nil
+	}
+	return applied, live, ApplyOperationUpdated, nil
+}
+
+// rollback deletes created objects in the reverse order of their creation. Clients of objects that were not
+// created are nil.
+func rollback(ctx context.Context, clients []dynamic.ResourceInterface, objects []*unstructured.Unstructured, results []ObjectResult) {
+	for i := len(clients) - 1; i >= 0; i-- {
+		if clients[i] == nil {
+			continue
+		}
+
+		propagationPolicy := metaV1.DeletePropagationForeground
+		err := clients[i].Delete(ctx, objects[i].GetName(), metaV1.DeleteOptions{
+			PropagationPolicy: &propagationPolicy,
+		})
+		if err != nil {
+			log.Printf("Could not roll back %s %s: %s", objects[i].GetKind(), objects[i].GetName(), err)
+			results[i].Error = errors.LocalizeError(err).Error()
+			continue
+		}
+		results[i].Operation = ApplyOperationRolledBack
+	}
+}
+
+// decodeObjects parses all documents of a yaml or json file. Empty documents are skipped.
+func decodeObjects(content string) ([]*unstructured.Unstructured, error) {
+	d := yaml.NewYAMLOrJSONDecoder(strings.NewReader(content), 4096)
+	objects := make([]*unstructured.Unstructured, 0)
+	for {
+		data := &unstructured.Unstructured{}
+		if err := d.Decode(data); err != nil {
+			if err == io.EOF {
+				return objects, nil
+			}
+			return nil, err
+		}
+		if len(data.Object) == 0 {
+			continue
+		}
+		objects = append(objects, data)
+	}
+}
+
+// sortByApplyOrder sorts objects by the rank of their kind and keeps the file order within the same rank.
+func sortByApplyOrder(objects []*unstructured.Unstructured) {
+	sort.SliceStable(objects, func(i, j int) bool {
+		return getApplyOrder(objects[i]) < getApplyOrder(objects[j])
+	})
+}
+
+func getApplyOrder(object *unstructured.Unstructured) int {
+	if order, ok := applyOrder[object.GetKind()]; ok {
+		return order
+	}
+	return defaultApplyOrder
+}
@@ -0,0 +1,171 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newApplyClients(objects ...runtime.Object) (*fakediscovery.FakeDiscovery, *fakedynamic.FakeDynamicClient) {
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: []*metaV1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metaV1.APIResource{
+				{Name: "namespaces", Kind: "Namespace"},
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metaV1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}},
+		},
+	}}}
+
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+	// The fake client doesn't support server-side apply, so the submitted object is stored as it is.
+	dynamicClient.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		object := &unstructured.Unstructured{}
+		if err := object.UnmarshalJSON(action.(k8stesting.PatchAction).GetPatch()); err != nil {
+			return true, nil, err
+		}
+		object.SetNamespace(action.GetNamespace())
+		object.SetResourceVersion("2")
+		return true, object, nil
+	})
+
+	return discoveryClient, dynamicClient
+}
+
+func TestSortByApplyOrder(t *testing.T) {
+	objects := []*unstructured.Unstructured{
+		newUnstructured("apps/v1", "Deployment", "web"),
+		newUnstructured("example.com/v1", "Widget", "widget"),
+		newUnstructured("v1", "Service", "web"),
+		newUnstructured("v1", "ConfigMap", "first"),
+		newUnstructured("v1", "Namespace", "shop"),
+		newUnstructured("v1", "ConfigMap", "second"),
+		newUnstructured("apiextensions.k8s.io/v1", "CustomResourceDefinition", "widgets.example.com"),
+	}
+
+	sortByApplyOrder(objects)
+
+	actual := make([]string, 0)
+	for _, object := range objects {
+		actual = append(actual, object.GetKind()+"/"+object.GetName())
+	}
+	expected := []string{"Namespace/shop", "CustomResourceDefinition/widgets.example.com", "ConfigMap/first",
+		"ConfigMap/second", "Service/web", "Deployment/web", "Widget/widget"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("sortByApplyOrder() == %v, expected %v", actual, expected)
+	}
+}
+
+func TestApplyAppFromFile(t *testing.T) {
+	existing := newUnstructuredConfigMap("settings", "41")
+	existing.SetResourceVersion("1")
+	discoveryClient, dynamicClient := newApplyClients(existing)
+
+	spec := &AppDeploymentFromFileSpec{
+		Name:      "file",
+		Namespace: "default",
+		Apply:     true,
+		Content: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n  namespace: shop\n---\n" +
+			"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\ndata:\n  answer: \"42\"\n---\n" +
+			"apiVersion: v1\nkind: Namespace\nmetadata:\n  name: shop\n",
+	}
+
+	actual, err := applyAppFromFile(context.TODO(), discoveryClient, dynamicClient, spec)
+	if err != nil {
+		t.Fatalf("applyAppFromFile() returned error: %s", err)
+	}
+
+	expected := []ObjectResult{
+		{APIVersion: "v1", Kind: "Namespace", Name: "shop", Operation: ApplyOperationCreated},
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "settings", Operation: ApplyOperationUpdated},
+		{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "shop", Name: "web", Operation: ApplyOperationCreated},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("applyAppFromFile() == \n%#v\nexpected \n%#v", actual, expected)
+	}
+}
+
+func TestApplyAppFromFileRollback(t *testing.T) {
+	discoveryClient, dynamicClient := newApplyClients()
+
+	spec := &AppDeploymentFromFileSpec{
+		Name:      "file",
+		Namespace: "default",
+		Apply:     true,
+		Rollback:  true,
+		Content: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n---\n" +
+			"apiVersion: example.com/v1\nkind: Widget\nmetadata:\n  name: widget\n---\n" +
+			"apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n",
+	}
+
+	actual, err := applyAppFromFile(context.TODO(), discoveryClient, dynamicClient, spec)
+	if err == nil {
+		t.Fatal("applyAppFromFile() should fail for an unknown kind")
+	}
+
+	operations := make([]ApplyOperation, 0)
+	for _, result := range actual {
+		operations = append(operations, result.Operation)
+	}
+	expected := []ApplyOperation{ApplyOperationRolledBack, ApplyOperationRolledBack, ApplyOperationFailed}
+	if !reflect.DeepEqual(operations, expected) {
+		t.Errorf("applyAppFromFile() operations == %v, expected %v", operations, expected)
+	}
+
+	configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	if _, err := dynamicClient.Tracker().Get(configMaps, "default", "settings"); err == nil {
+		t.Error("applyAppFromFile() should delete created objects on rollback")
+	}
+}
+
+func TestApplyAppFromFileInvalidDocument(t *testing.T) {
+	discoveryClient, dynamicClient := newApplyClients()
+
+	spec := &AppDeploymentFromFileSpec{
+		Namespace: "default",
+		Apply:     true,
+		Content:   "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n---\n: invalid\n",
+	}
+
+	if _, err := applyAppFromFile(context.TODO(), discoveryClient, dynamicClient, spec); err == nil {
+		t.Fatal("applyAppFromFile() should fail for an invalid document")
+	}
+	if len(dynamicClient.Actions()) != 0 {
+		t.Errorf("applyAppFromFile() should not change anything if the file is invalid, got %v",
+			dynamicClient.Actions())
+	}
+}
+
+func newUnstructured(apiVersion, kind, name string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion(apiVersion)
+	object.SetKind(kind)
+	object.SetName(name)
+	return object
+}
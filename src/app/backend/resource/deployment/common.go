@@ -37,12 +37,29 @@ func (self DeploymentCell) GetProperty(name dataselect.PropertyName) dataselect.
 		return dataselect.StdComparableTime(self.ObjectMeta.CreationTimestamp.Time)
 	case dataselect.NamespaceProperty:
 		return dataselect.StdComparableString(self.ObjectMeta.Namespace)
+	case dataselect.ReadyRatioProperty:
+		return dataselect.StdComparableFloat(getReadyRatio(apps.Deployment(self)))
 	default:
 		// if name is not supported then just return a constant dummy value, sort will have no effect.
 		return nil
 	}
 }
 
+// getReadyRatio returns the ratio of ready pods to desired pods of the deployment. Deployments scaled to zero
+// are considered fully ready.
+func getReadyRatio(deployment apps.Deployment) float64 {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	if desired == 0 {
+		return 1
+	}
+
+	return float64(deployment.Status.ReadyReplicas) / float64(desired)
+}
+
 func (self DeploymentCell) GetResourceSelector() *metricapi.ResourceSelector {
 	return &metricapi.ResourceSelector{
 		Namespace:    self.ObjectMeta.Namespace,
@@ -106,6 +106,17 @@ type AppDeploymentFromFileSpec struct {
 
 	// Whether validate content before creation or not
 	Validate bool `json:"validate"`
+
+	// Whether objects should only be validated and admitted by the apiserver without being persisted.
+	DryRun bool `json:"dryRun"`
+
+	// Whether existing objects should be updated instead of failing the deployment. In this mode all
+	// documents are parsed before any change is made and they are applied in dependency order.
+	Apply bool `json:"apply"`
+
+	// Whether objects created by this deployment should be deleted when one of the objects fails.
+	// Updated objects are not reverted. Only used together with Apply.
+	Rollback bool `json:"rollback"`
 }
 
 // AppDeploymentFromFileResponse is a specification for deployment from file
@@ -118,6 +129,9 @@ type AppDeploymentFromFileResponse struct {
 
 	// Error after create resource
 	Error string `json:"error"`
+
+	// Results of the objects in the order they were processed.
+	Results []ObjectResult `json:"results"`
 }
 
 // PortMapping is a specification of port mapping for an application deployment.
@@ -159,7 +173,7 @@ type Protocols struct {
 // DeployApp deploys an app based on the given configuration. The app is deployed using the given
 // client. App deployment consists of a deployment and an optional service. Both of them
 // share common labels.
-func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
+func DeployApp(ctx context.Context, spec *AppDeploymentSpec, client client.Interface) error {
 	log.Printf("Deploying %s application into %s namespace", spec.Name, spec.Namespace)
 
 	annotations := map[string]string{}
@@ -220,7 +234,7 @@ func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
 			},
 		},
 	}
-	_, err := client.AppsV1().Deployments(spec.Namespace).Create(context.TODO(), deployment, metaV1.CreateOptions{})
+	_, err := client.AppsV1().Deployments(spec.Namespace).Create(ctx, deployment, metaV1.CreateOptions{})
 
 	if err != nil {
 		return err
@@ -254,7 +268,7 @@ func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
 			service.Spec.Ports = append(service.Spec.Ports, servicePort)
 		}
 
-		_, err = client.CoreV1().Services(spec.Namespace).Create(context.TODO(), service, metaV1.CreateOptions{})
+		_, err = client.CoreV1().Services(spec.Namespace).Create(ctx, service, metaV1.CreateOptions{})
 		return err
 	}
 
@@ -300,69 +314,107 @@ func getLabelsMap(labels []Label) map[string]string {
 	return result
 }
 
-// DeployAppFromFile deploys an app based on the given yaml or json file.
-func DeployAppFromFile(cfg *rest.Config, spec *AppDeploymentFromFileSpec) (bool, error) {
+// DeployAppFromFile deploys an app based on the given yaml or json file and returns results of the processed
+// objects. Unless the spec enables apply mode, objects are created one by one and the deployment stops at the
+// first error.
+func DeployAppFromFile(ctx context.Context, cfg *rest.Config, spec *AppDeploymentFromFileSpec) ([]ObjectResult, error) {
+	discoveryClient, dynamicClient, err := newFileClients(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Apply {
+		return applyAppFromFile(ctx, discoveryClient, dynamicClient, spec)
+	}
+	return deployAppFromFile(ctx, discoveryClient, dynamicClient, spec)
+}
+
+func deployAppFromFile(ctx context.Context, discoveryClient discovery.DiscoveryInterface, dynamicClient dynamic.Interface,
+	spec *AppDeploymentFromFileSpec) ([]ObjectResult, error) {
 	reader := strings.NewReader(spec.Content)
 	log.Printf("Namespace for deploy from file: %s\n", spec.Namespace)
 	d := yaml.NewYAMLOrJSONDecoder(reader, 4096)
+	results := make([]ObjectResult, 0)
 	for {
 		data := &unstructured.Unstructured{}
 		if err := d.Decode(data); err != nil {
 			if err == io.EOF {
-				return true, nil
+				return results, nil
 			}
-			return false, err
+			return results, err
 		}
 
-		version := data.GetAPIVersion()
-		kind := data.GetKind()
-
-		gv, err := schema.ParseGroupVersion(version)
+		resourceClient, err := getResourceClient(discoveryClient, dynamicClient, data, spec)
 		if err != nil {
-			gv = schema.GroupVersion{Version: version}
+			return results, err
 		}
 
-		discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+		created, err := resourceClient.Create(ctx, data, getCreateOptions(spec))
 		if err != nil {
-			return false, err
+			return results, errors.LocalizeError(err)
 		}
+		results = append(results, newObjectResult(created, ApplyOperationCreated))
+	}
+}
 
-		apiResourceList, err := discoveryClient.ServerResourcesForGroupVersion(version)
-		if err != nil {
-			return false, err
-		}
-		apiResources := apiResourceList.APIResources
-		var resource *metaV1.APIResource
-		for _, apiResource := range apiResources {
-			if apiResource.Kind == kind && !strings.Contains(apiResource.Name, "/") {
-				resource = &apiResource
-				break
-			}
-		}
-		if resource == nil {
-			return false, fmt.Errorf("unknown resource kind: %s", kind)
-		}
+func getCreateOptions(spec *AppDeploymentFromFileSpec) metaV1.CreateOptions {
+	if spec.DryRun {
+		return metaV1.CreateOptions{DryRun: []string{metaV1.DryRunAll}}
+	}
+	return metaV1.CreateOptions{}
+}
 
-		dynamicClient, err := dynamic.NewForConfig(cfg)
-		if err != nil {
-			return false, err
-		}
+func newFileClients(cfg *rest.Config) (discovery.DiscoveryInterface, dynamic.Interface, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		groupVersionResource := schema.GroupVersionResource{Group: gv.Group, Version: gv.Version, Resource: resource.Name}
-		namespace := spec.Namespace
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		if strings.Compare(spec.Namespace, "_all") == 0 {
-			namespace = data.GetNamespace()
-		}
+	return discoveryClient, dynamicClient, nil
+}
 
-		if resource.Namespaced {
-			_, err = dynamicClient.Resource(groupVersionResource).Namespace(namespace).Create(context.TODO(), data, metaV1.CreateOptions{})
-		} else {
-			_, err = dynamicClient.Resource(groupVersionResource).Create(context.TODO(), data, metaV1.CreateOptions{})
-		}
+// getResourceClient returns dynamic client of the resource described by the object from the file. Objects of
+// namespaced kinds go to the namespace from the spec, or to their own namespace if "_all" was selected. In apply
+// mode the namespace of the object takes precedence and the spec only provides the default.
+func getResourceClient(discoveryClient discovery.DiscoveryInterface, dynamicClient dynamic.Interface,
+	data *unstructured.Unstructured, spec *AppDeploymentFromFileSpec) (dynamic.ResourceInterface, error) {
+	version := data.GetAPIVersion()
+	kind := data.GetKind()
 
-		if err != nil {
-			return false, errors.LocalizeError(err)
+	gv, err := schema.ParseGroupVersion(version)
+	if err != nil {
+		gv = schema.GroupVersion{Version: version}
+	}
+
+	apiResourceList, err := discoveryClient.ServerResourcesForGroupVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	apiResources := apiResourceList.APIResources
+	var resource *metaV1.APIResource
+	for _, apiResource := range apiResources {
+		if apiResource.Kind == kind && !strings.Contains(apiResource.Name, "/") {
+			resource = &apiResource
+			break
 		}
 	}
+	if resource == nil {
+		return nil, fmt.Errorf("unknown resource kind: %s", kind)
+	}
+
+	groupVersionResource := schema.GroupVersionResource{Group: gv.Group, Version: gv.Version, Resource: resource.Name}
+	if !resource.Namespaced {
+		return dynamicClient.Resource(groupVersionResource), nil
+	}
+
+	namespace := spec.Namespace
+	if strings.Compare(spec.Namespace, "_all") == 0 || (spec.Apply && len(data.GetNamespace()) > 0) {
+		namespace = data.GetNamespace()
+	}
+	return dynamicClient.Resource(groupVersionResource).Namespace(namespace), nil
 }
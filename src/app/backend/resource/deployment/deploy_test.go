@@ -15,6 +15,7 @@
 package deployment
 
 import (
+	"context"
 	"reflect"
 	"regexp"
 	"strings"
@@ -71,7 +72,7 @@ func TestDeployApp(t *testing.T) {
 
 	testClient := fake.NewSimpleClientset()
 
-	DeployApp(spec, testClient)
+	DeployApp(context.TODO(), spec, testClient)
 
 	createAction := testClient.Actions()[0].(core.CreateActionImpl)
 	if len(testClient.Actions()) != 1 {
@@ -100,7 +101,7 @@ func TestDeployAppContainerCommands(t *testing.T) {
 	}
 	testClient := fake.NewSimpleClientset()
 
-	DeployApp(spec, testClient)
+	DeployApp(context.TODO(), spec, testClient)
 	createAction := testClient.Actions()[0].(core.CreateActionImpl)
 
 	rc := createAction.GetObject().(*apps.Deployment)
@@ -128,7 +129,7 @@ func TestDeployShouldPopulateEnvVars(t *testing.T) {
 	}
 	testClient := fake.NewSimpleClientset()
 
-	DeployApp(spec, testClient)
+	DeployApp(context.TODO(), spec, testClient)
 
 	createAction := testClient.Actions()[0].(core.CreateActionImpl)
 
@@ -170,7 +171,7 @@ func TestDeployWithResourceRequirements(t *testing.T) {
 	}
 	testClient := fake.NewSimpleClientset()
 
-	DeployApp(spec, testClient)
+	DeployApp(context.TODO(), spec, testClient)
 
 	createAction := testClient.Actions()[0].(core.CreateActionImpl)
 
@@ -80,11 +80,11 @@ type DeploymentDetail struct {
 }
 
 // GetDeploymentDetail returns model object of deployment and error, if any.
-func GetDeploymentDetail(client client.Interface, namespace string, deploymentName string) (*DeploymentDetail, error) {
+func GetDeploymentDetail(ctx context.Context, client client.Interface, namespace string, deploymentName string) (*DeploymentDetail, error) {
 
 	log.Printf("Getting details of %s deployment in %s namespace", deploymentName, namespace)
 
-	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), deploymentName, metaV1.GetOptions{})
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -96,11 +96,11 @@ func GetDeploymentDetail(client client.Interface, namespace string, deploymentNa
 	options := metaV1.ListOptions{LabelSelector: selector.String()}
 
 	channels := &common.ResourceChannels{
-		ReplicaSetList: common.GetReplicaSetListChannelWithOptions(client,
+		ReplicaSetList: common.GetReplicaSetListChannelWithOptions(ctx, client,
 			common.NewSameNamespaceQuery(namespace), options, 1),
-		PodList: common.GetPodListChannelWithOptions(client,
+		PodList: common.GetPodListChannelWithOptions(ctx, client,
 			common.NewSameNamespaceQuery(namespace), options, 1),
-		EventList: common.GetEventListChannelWithOptions(client,
+		EventList: common.GetEventListChannelWithOptions(ctx, client,
 			common.NewSameNamespaceQuery(namespace), options, 1),
 	}
 
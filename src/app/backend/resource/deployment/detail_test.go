@@ -15,6 +15,7 @@
 package deployment
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -147,7 +148,7 @@ func TestGetDeploymentDetail(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.deployment, replicaSetList, podList, eventList)
 		dataselect.DefaultDataSelectWithMetrics.MetricQuery = dataselect.NoMetrics
-		actual, _ := GetDeploymentDetail(fakeClient, c.namespace, c.name)
+		actual, _ := GetDeploymentDetail(context.TODO(), fakeClient, c.namespace, c.name)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -0,0 +1,397 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	yamlv2 "gopkg.in/yaml.v2"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// diffContextLines is the number of unchanged lines shown around every change in a diff.
+const diffContextLines = 3
+
+// maxDiffLines is the maximum number of lines of both versions of an object that are compared. Time needed to
+// compare them grows with the product of their size and the number of differences.
+const maxDiffLines = 10000
+
+// DiffOperation describes what would happen to an object if the file was applied.
+type DiffOperation string
+
+// List of operations that can be reported in an object diff.
+const (
+	DiffOperationCreate    DiffOperation = "create"
+	DiffOperationUpdate    DiffOperation = "update"
+	DiffOperationUnchanged DiffOperation = "unchanged"
+)
+
+// ObjectDiff is a difference between a live object and an object from the submitted file.
+type ObjectDiff struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+
+	// Operation that applying the object would perform.
+	Operation DiffOperation `json:"operation,omitempty"`
+
+	// Unified diff between the live object and the object as it would be stored by the apiserver.
+	Diff string `json:"diff"`
+
+	// Error returned by the apiserver for this object. Other objects of the file are still compared.
+	Error string `json:"error,omitempty"`
+}
+
+// AppDeploymentFromFileDiff contains differences of all objects of a file.
+type AppDeploymentFromFileDiff struct {
+	// Name of the file
+	Name string `json:"name"`
+
+	// Differences of the objects in the order they appear in the file.
+	Items []ObjectDiff `json:"items"`
+}
+
+// DiffAppFromFile compares every object of the given yaml or json file with its live version. The object that
+// would be stored is computed by the apiserver with a dry run of the same request the deployment would make, so
+// defaults, admission and fields owned by other managers are taken into account. Nothing is persisted.
+func DiffAppFromFile(ctx context.Context, cfg *rest.Config, spec *AppDeploymentFromFileSpec) (*AppDeploymentFromFileDiff, error) {
+	discoveryClient, dynamicClient, err := newFileClients(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffAppFromFile(ctx, discoveryClient, dynamicClient, spec)
+}
+
+func diffAppFromFile(ctx context.Context, discoveryClient discovery.DiscoveryInterface, dynamicClient dynamic.Interface,
+	spec *AppDeploymentFromFileSpec) (*AppDeploymentFromFileDiff, error) {
+	objects, err := decodeObjects(spec.Content)
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+
+	result := &AppDeploymentFromFileDiff{Name: spec.Name, Items: make([]ObjectDiff, 0, len(objects))}
+	for _, object := range objects {
+		result.Items = append(result.Items, diffObject(ctx, discoveryClient, dynamicClient, object, spec))
+	}
+	return result, nil
+}
+
+func diffObject(ctx context.Context, discoveryClient discovery.DiscoveryInterface, dynamicClient dynamic.Interface,
+	data *unstructured.Unstructured, spec *AppDeploymentFromFileSpec) ObjectDiff {
+	objectDiff := ObjectDiff{
+		APIVersion: data.GetAPIVersion(),
+		Kind:       data.GetKind(),
+		Namespace:  data.GetNamespace(),
+		Name:       data.GetName(),
+	}
+
+	resourceClient, err := getResourceClient(discoveryClient, dynamicClient, data, spec)
+	if err != nil {
+		objectDiff.Error = errors.LocalizeError(err).Error()
+		return objectDiff
+	}
+
+	var live, desired *unstructured.Unstructured
+	if spec.Apply {
+		desired, live, _, err = applyObject(ctx, resourceClient, data, true)
+	} else {
+		// Without apply mode every object is created, so existing objects make the deployment fail.
+		desired, err = resourceClient.Create(ctx, data, metaV1.CreateOptions{DryRun: []string{metaV1.DryRunAll}})
+	}
+	if err != nil {
+		objectDiff.Error = errors.LocalizeError(err).Error()
+		return objectDiff
+	}
+
+	objectDiff.Name = desired.GetName()
+	objectDiff.Namespace = desired.GetNamespace()
+	objectDiff.Operation, objectDiff.Diff, err = toObjectDiff(live, desired)
+	if err != nil {
+		objectDiff.Error = err.Error()
+	}
+	return objectDiff
+}
+
+// toObjectDiff returns a unified diff between the live object, which is nil if it doesn't exist yet, and the
+// desired object.
+func toObjectDiff(live, desired *unstructured.Unstructured) (DiffOperation, string, error) {
+	name := fmt.Sprintf("%s/%s", strings.ToLower(desired.GetKind()), desired.GetName())
+
+	to, err := toDiffYAML(desired)
+	if err != nil {
+		return "", "", err
+	}
+
+	from := ""
+	if live != nil {
+		if from, err = toDiffYAML(live); err != nil {
+			return "", "", err
+		}
+	}
+
+	if lines := len(splitLines(from)) + len(splitLines(to)); lines > maxDiffLines {
+		return "", "", fmt.Errorf("object is too large to compare, both its versions have %d lines while at "+
+			"most %d are supported", lines, maxDiffLines)
+	}
+
+	if live == nil {
+		return DiffOperationCreate, unifiedDiff("/dev/null", "desired/"+name, "", to), nil
+	}
+
+	diff := unifiedDiff("live/"+name, "desired/"+name, from, to)
+	if len(diff) == 0 {
+		return DiffOperationUnchanged, diff, nil
+	}
+	return DiffOperationUpdate, diff, nil
+}
+
+// toDiffYAML serializes the object without metadata that is changed on every write, so that it doesn't show
+// up in every diff.
+func toDiffYAML(object *unstructured.Unstructured) (string, error) {
+	object = object.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp",
+		"selfLink"} {
+		unstructured.RemoveNestedField(object.Object, "metadata", field)
+	}
+
+	out, err := yamlv2.Marshal(object.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// unifiedDiff returns differences between two texts in the unified diff format or an empty string if they are
+// equal.
+func unifiedDiff(fromName, toName, from, to string) string {
+	lines := diffLines(splitLines(from), splitLines(to))
+
+	var changes []int
+	for i, line := range lines {
+		if line.op != ' ' {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	// fromBefore[i] and toBefore[i] count lines of each text that precede lines[i].
+	fromBefore := make([]int, len(lines)+1)
+	toBefore := make([]int, len(lines)+1)
+	for i, line := range lines {
+		fromBefore[i+1], toBefore[i+1] = fromBefore[i], toBefore[i]
+		if line.op != '+' {
+			fromBefore[i+1]++
+		}
+		if line.op != '-' {
+			toBefore[i+1]++
+		}
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromName, toName)
+
+	for i := 0; i < len(changes); {
+		start := max(changes[i]-diffContextLines, 0)
+		end := changes[i]
+		for i < len(changes) && changes[i]-end <= 2*diffContextLines {
+			end = changes[i]
+			i++
+		}
+		end = min(end+diffContextLines+1, len(lines))
+
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(fromBefore[start], fromBefore[end]-fromBefore[start]),
+			hunkRange(toBefore[start], toBefore[end]-toBefore[start]))
+		for _, line := range lines[start:end] {
+			out.WriteByte(line.op)
+			out.WriteString(line.text)
+			out.WriteByte('\n')
+		}
+	}
+
+	return out.String()
+}
+
+func hunkRange(before, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", before)
+	}
+	return fmt.Sprintf("%d,%d", before+1, count)
+}
+
+// diffLines returns the shortest edit script between two lists of lines. It uses the linear space variant of
+// the Myers algorithm, so memory grows linearly with the size of the input and time with the product of the
+// size and the number of differences.
+func diffLines(from, to []string) []diffLine {
+	return appendDiffLines(make([]diffLine, 0, len(from)+len(to)), from, to)
+}
+
+// appendDiffLines appends the edit script between given lines to the result. The problem is split at the middle
+// snake of the edit script and both halves are solved recursively.
+func appendDiffLines(result []diffLine, a, b []string) []diffLine {
+	// Common prefix and suffix are never part of a change.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	for _, line := range a[:prefix] {
+		result = append(result, diffLine{' ', line})
+	}
+	a, b = a[prefix:], b[prefix:]
+
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	common := a[len(a)-suffix:]
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	// A split at either end would not make the problem smaller.
+	if x, y, ok := middleSnake(a, b); ok && x+y > 0 && x+y < len(a)+len(b) {
+		result = appendDiffLines(result, a[:x], b[:y])
+		result = appendDiffLines(result, a[x:], b[y:])
+	} else {
+		for _, line := range a {
+			result = append(result, diffLine{'-', line})
+		}
+		for _, line := range b {
+			result = append(result, diffLine{'+', line})
+		}
+	}
+
+	for _, line := range common {
+		result = append(result, diffLine{' ', line})
+	}
+	return result
+}
+
+// middleSnake searches for the shortest edit script from both ends at once and returns the point where the
+// paths meet. It returns false when the lines can not be split, i.e. when one of them is empty.
+func middleSnake(a, b []string) (int, int, bool) {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return 0, 0, false
+	}
+
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	// forward[offset+k] and backward[offset+k] hold the furthest x reached on diagonal k from the start and from
+	// the end respectively.
+	forward := make([]int, 2*maxD+2)
+	backward := make([]int, 2*maxD+2)
+	for i := range forward {
+		forward[i], backward[i] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	delta := n - m
+	// When delta is odd the paths can meet only while extending the forward path and vice versa.
+	odd := delta%2 != 0
+	forwardStart, forwardEnd, backwardStart, backwardEnd := 0, 0, 0, 0
+	for d := 0; d < maxD; d++ {
+		for k := -d + forwardStart; k <= d-forwardEnd; k += 2 {
+			i := offset + k
+			var x int
+			if k == -d || (k != d && forward[i-1] < forward[i+1]) {
+				x = forward[i+1]
+			} else {
+				x = forward[i-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			forward[i] = x
+
+			if x > n {
+				forwardEnd += 2
+			} else if y > m {
+				forwardStart += 2
+			} else if odd {
+				j := offset + delta - k
+				if j >= 0 && j < len(backward) && backward[j] != -1 && x >= n-backward[j] {
+					return x, y, true
+				}
+			}
+		}
+
+		for k := -d + backwardStart; k <= d-backwardEnd; k += 2 {
+			i := offset + k
+			var x int
+			if k == -d || (k != d && backward[i-1] < backward[i+1]) {
+				x = backward[i+1]
+			} else {
+				x = backward[i-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[n-x-1] == b[m-y-1] {
+				x++
+				y++
+			}
+			backward[i] = x
+
+			if x > n {
+				backwardEnd += 2
+			} else if y > m {
+				backwardStart += 2
+			} else if !odd {
+				j := offset + delta - k
+				if j >= 0 && j < len(forward) && forward[j] != -1 && forward[j] >= n-x {
+					return forward[j], forward[j] - (delta - k), true
+				}
+			}
+		}
+	}
+
+	return 0, 0, false
+}
+
+func splitLines(text string) []string {
+	if len(text) == 0 {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,249 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	cases := []struct {
+		from     string
+		to       string
+		expected string
+	}{
+		{"a\nb\n", "a\nb\n", ""},
+		{"", "a\nb\n", "--- from\n+++ to\n@@ -0,0 +1,2 @@\n+a\n+b\n"},
+		{"a\nb\nc\n", "a\nx\nc\n", "--- from\n+++ to\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n"},
+		{
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			"0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n",
+			"--- from\n+++ to\n@@ -1,3 +1,4 @@\n+0\n 1\n 2\n 3\n@@ -9,4 +10,3 @@\n 9\n 10\n 11\n-12\n",
+		},
+		{
+			"1\n2\n3\n4\n5\n",
+			"1\n2\n3\nx\n5\n",
+			"--- from\n+++ to\n@@ -1,5 +1,5 @@\n 1\n 2\n 3\n-4\n+x\n 5\n",
+		},
+	}
+
+	for _, c := range cases {
+		actual := unifiedDiff("from", "to", c.from, c.to)
+		if actual != c.expected {
+			t.Errorf("unifiedDiff(%q, %q) == \n%s\nexpected \n%s", c.from, c.to, actual, c.expected)
+		}
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	randomLines := func() []string {
+		lines := make([]string, random.Intn(30))
+		for i := range lines {
+			lines[i] = string(rune('a' + random.Intn(4)))
+		}
+		return lines
+	}
+
+	for i := 0; i < 500; i++ {
+		from, to := randomLines(), randomLines()
+		script := diffLines(from, to)
+
+		var gotFrom, gotTo []string
+		changes := 0
+		for _, line := range script {
+			if line.op != '+' {
+				gotFrom = append(gotFrom, line.text)
+			}
+			if line.op != '-' {
+				gotTo = append(gotTo, line.text)
+			}
+			if line.op != ' ' {
+				changes++
+			}
+		}
+
+		if strings.Join(gotFrom, "") != strings.Join(from, "") || strings.Join(gotTo, "") != strings.Join(to, "") {
+			t.Fatalf("diffLines(%q, %q) == %v does not transform the lines", from, to, script)
+		}
+
+		if expected := len(from) + len(to) - 2*longestCommonSubsequence(from, to); changes != expected {
+			t.Fatalf("diffLines(%q, %q) has %d changes, expected %d", from, to, changes, expected)
+		}
+	}
+}
+
+func longestCommonSubsequence(a, b []string) int {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	return lcs[0][0]
+}
+
+func TestToObjectDiff(t *testing.T) {
+	desired := newUnstructuredConfigMap("cm", "42")
+	desired.SetResourceVersion("2")
+
+	operation, diff, err := toObjectDiff(nil, desired)
+	if err != nil || operation != DiffOperationCreate {
+		t.Errorf("toObjectDiff() of a new object == (%s, %v), expected create", operation, err)
+	}
+	if diff != "--- /dev/null\n+++ desired/configmap/cm\n@@ -0,0 +1,7 @@\n+apiVersion: v1\n+data:\n"+
+		"+  answer: \"42\"\n+kind: ConfigMap\n+metadata:\n+  name: cm\n+  namespace: default\n" {
+		t.Errorf("toObjectDiff() of a new object == \n%s", diff)
+	}
+
+	live := newUnstructuredConfigMap("cm", "42")
+	live.SetResourceVersion("1")
+	operation, diff, err = toObjectDiff(live, desired)
+	if err != nil || operation != DiffOperationUnchanged || diff != "" {
+		t.Errorf("toObjectDiff() of an unchanged object == (%s, %q, %v), expected unchanged", operation, diff, err)
+	}
+
+	live = newUnstructuredConfigMap("cm", "41")
+	operation, diff, err = toObjectDiff(live, desired)
+	if err != nil || operation != DiffOperationUpdate {
+		t.Errorf("toObjectDiff() of a changed object == (%s, %v), expected update", operation, err)
+	}
+	if diff != "--- live/configmap/cm\n+++ desired/configmap/cm\n@@ -1,6 +1,6 @@\n apiVersion: v1\n data:\n"+
+		"-  answer: \"41\"\n+  answer: \"42\"\n kind: ConfigMap\n metadata:\n   name: cm\n" {
+		t.Errorf("toObjectDiff() of a changed object == \n%s", diff)
+	}
+
+	large := newUnstructuredConfigMap("cm", strings.Repeat("line\n", maxDiffLines))
+	if _, _, err = toObjectDiff(live, large); err == nil {
+		t.Error("toObjectDiff() of an object with too many lines should fail")
+	}
+}
+
+func TestDiffAppFromFile(t *testing.T) {
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: []*metaV1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metaV1.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}},
+	}}}}
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), newUnstructuredConfigMap("changed", "41"))
+	// The fake client doesn't support server-side apply, so the submitted object is returned as it is.
+	dynamicClient.PrependReactor("patch", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		object := &unstructured.Unstructured{}
+		err := object.UnmarshalJSON(action.(k8stesting.PatchAction).GetPatch())
+		object.SetNamespace(action.GetNamespace())
+		return true, object, err
+	})
+	// Nor dry runs, so created objects are returned without being stored.
+	configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	dynamicClient.PrependReactor("create", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		object := action.(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured)
+		if _, err := dynamicClient.Tracker().Get(configMaps, action.GetNamespace(), object.GetName()); err == nil {
+			return true, nil, k8serrors.NewAlreadyExists(configMaps.GroupResource(), object.GetName())
+		}
+		return true, object, nil
+	})
+
+	content := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: changed\ndata:\n  answer: \"42\"\n---\n" +
+		"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: new\n---\n" +
+		"apiVersion: v1\nkind: Unknown\nmetadata:\n  name: unknown\n"
+
+	type expectedItem struct {
+		name      string
+		operation DiffOperation
+		hasError  bool
+	}
+	cases := []struct {
+		info     string
+		apply    bool
+		expected []expectedItem
+	}{
+		{
+			"create mode fails on existing objects", false,
+			[]expectedItem{{"changed", "", true}, {"new", DiffOperationCreate, false}, {"unknown", "", true}},
+		},
+		{
+			"apply mode updates existing objects", true,
+			[]expectedItem{{"changed", DiffOperationUpdate, false}, {"new", DiffOperationCreate, false},
+				{"unknown", "", true}},
+		},
+	}
+
+	for _, c := range cases {
+		spec := &AppDeploymentFromFileSpec{Name: "file", Namespace: "default", Content: content, Apply: c.apply}
+		actual, err := diffAppFromFile(context.TODO(), discoveryClient, dynamicClient, spec)
+		if err != nil {
+			t.Fatalf("Test Case: %s. diffAppFromFile() returned error: %s", c.info, err)
+		}
+
+		if len(actual.Items) != len(c.expected) {
+			t.Fatalf("Test Case: %s. diffAppFromFile() returned %d items, expected %d", c.info, len(actual.Items),
+				len(c.expected))
+		}
+		for i, e := range c.expected {
+			item := actual.Items[i]
+			if item.Name != e.name || item.Operation != e.operation || (len(item.Error) > 0) != e.hasError {
+				t.Errorf("Test Case: %s. diffAppFromFile() item %d == %#v, expected %#v", c.info, i, item, e)
+			}
+		}
+	}
+
+	if _, err := dynamicClient.Tracker().Get(configMaps, "default", "new"); err == nil {
+		t.Error("diffAppFromFile() should not create objects")
+	}
+	for _, action := range dynamicClient.Actions() {
+		if action.GetVerb() == "update" {
+			t.Errorf("diffAppFromFile() should not modify objects, got %#v", action)
+		}
+	}
+}
+
+func TestDeployAppFromFileDryRun(t *testing.T) {
+	options := getCreateOptions(&AppDeploymentFromFileSpec{DryRun: true})
+	if len(options.DryRun) != 1 || options.DryRun[0] != metaV1.DryRunAll {
+		t.Errorf("getCreateOptions() == %#v, expected dry run of all stages", options)
+	}
+
+	options = getCreateOptions(&AppDeploymentFromFileSpec{})
+	if len(options.DryRun) != 0 {
+		t.Errorf("getCreateOptions() == %#v, expected no dry run", options)
+	}
+}
+
+func newUnstructuredConfigMap(name, answer string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"})
+	object.SetName(name)
+	object.SetNamespace("default")
+	unstructured.SetNestedField(object.Object, answer, "data", "answer")
+	return object
+}
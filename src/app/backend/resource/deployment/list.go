@@ -15,6 +15,7 @@
 package deployment
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -61,24 +62,24 @@ type Deployment struct {
 }
 
 // GetDeploymentList returns a list of all Deployments in the cluster.
-func GetDeploymentList(client client.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery,
+func GetDeploymentList(ctx context.Context, client client.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery,
 	metricClient metricapi.MetricClient) (*DeploymentList, error) {
 	log.Print("Getting list of all deployments in the cluster")
 
 	channels := &common.ResourceChannels{
-		DeploymentList: common.GetDeploymentListChannel(client, nsQuery, 1),
-		PodList:        common.GetPodListChannel(client, nsQuery, 1),
-		EventList:      common.GetEventListChannel(client, nsQuery, 1),
-		ReplicaSetList: common.GetReplicaSetListChannel(client, nsQuery, 1),
+		DeploymentList: common.GetDeploymentListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
+		PodList:        common.GetPodListChannel(ctx, client, nsQuery, 1),
+		EventList:      common.GetEventListChannel(ctx, client, nsQuery, 1),
+		ReplicaSetList: common.GetReplicaSetListChannel(ctx, client, nsQuery, 1),
 	}
 
-	return GetDeploymentListFromChannels(channels, dsQuery, metricClient)
+	return GetDeploymentListFromChannels(ctx, channels, dsQuery, metricClient)
 }
 
 // GetDeploymentListFromChannels returns a list of all Deployments in the cluster
 // reading required resource list once from the channels.
-func GetDeploymentListFromChannels(channels *common.ResourceChannels, dsQuery *dataselect.DataSelectQuery,
-	metricClient metricapi.MetricClient) (*DeploymentList, error) {
+func GetDeploymentListFromChannels(ctx context.Context, channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*DeploymentList, error) {
 
 	deployments := <-channels.DeploymentList.List
 	err := <-channels.DeploymentList.Error
@@ -108,14 +109,16 @@ func GetDeploymentListFromChannels(channels *common.ResourceChannels, dsQuery *d
 		return nil, criticalError
 	}
 
-	deploymentList := toDeploymentList(deployments.Items, pods.Items, events.Items, rs.Items, nonCriticalErrors,
+	deploymentList := toDeploymentList(ctx, deployments.Items, pods.Items, events.Items, rs.Items, nonCriticalErrors,
 		dsQuery, metricClient)
 	deploymentList.Status = getStatus(deployments, rs.Items, pods.Items, events.Items)
+	deploymentList.ListMeta = dsQuery.CursorListMeta(deploymentList.ListMeta, deployments)
 	return deploymentList, nil
 }
 
-func toDeploymentList(deployments []apps.Deployment, pods []v1.Pod, events []v1.Event, rs []apps.ReplicaSet,
-	nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) *DeploymentList {
+func toDeploymentList(ctx context.Context, deployments []apps.Deployment, pods []v1.Pod, events []v1.Event,
+	rs []apps.ReplicaSet, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery,
+	metricClient metricapi.MetricClient) *DeploymentList {
 
 	deploymentList := &DeploymentList{
 		Deployments: make([]Deployment, 0),
@@ -127,7 +130,7 @@ func toDeploymentList(deployments []apps.Deployment, pods []v1.Pod, events []v1.
 		Pods: pods,
 	}
 	deploymentCells, metricPromises, filteredTotal := dataselect.GenericDataSelectWithFilterAndMetrics(
-		toCells(deployments), dsQuery, cachedResources, metricClient)
+		ctx, toCells(deployments), dsQuery, cachedResources, metricClient)
 	deployments = fromCells(deploymentCells)
 	deploymentList.ListMeta = api.ListMeta{TotalItems: filteredTotal}
 
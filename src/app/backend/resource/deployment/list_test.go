@@ -15,6 +15,7 @@
 package deployment
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -178,12 +179,12 @@ func TestGetDeploymentListFromChannels(t *testing.T) {
 		channels.ReplicaSetList.List <- &apps.ReplicaSetList{}
 		channels.ReplicaSetList.Error <- nil
 
-		actual, err := GetDeploymentListFromChannels(channels, dataselect.NoDataSelect, nil)
+		actual, err := GetDeploymentListFromChannels(context.TODO(), channels, dataselect.NoDataSelect, nil)
 		if !reflect.DeepEqual(actual, c.expected) {
-			t.Errorf("GetDeploymentListFromChannels() ==\n          %#v\nExpected: %#v", actual, c.expected)
+			t.Errorf("GetDeploymentListFromChannels(context.TODO(), ) ==\n          %#v\nExpected: %#v", actual, c.expected)
 		}
 		if !reflect.DeepEqual(err, c.expectedError) {
-			t.Errorf("GetDeploymentListFromChannels() ==\n          %#v\nExpected: %#v", err, c.expectedError)
+			t.Errorf("GetDeploymentListFromChannels(context.TODO(), ) ==\n          %#v\nExpected: %#v", err, c.expectedError)
 		}
 	}
 }
@@ -28,12 +28,12 @@ import (
 )
 
 // GetDeploymentNewReplicaSet returns old replica sets targeting Deployment with given name
-func GetDeploymentNewReplicaSet(client client.Interface, dsQuery *dataselect.DataSelectQuery,
+func GetDeploymentNewReplicaSet(ctx context.Context, client client.Interface, dsQuery *dataselect.DataSelectQuery,
 	namespace string, deploymentName string) (*replicaset.ReplicaSet, error) {
 
 	newReplicaSet := &replicaset.ReplicaSet{}
 
-	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), deploymentName, metaV1.GetOptions{})
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metaV1.GetOptions{})
 	if err != nil {
 		return newReplicaSet, err
 	}
@@ -45,11 +45,11 @@ func GetDeploymentNewReplicaSet(client client.Interface, dsQuery *dataselect.Dat
 	options := metaV1.ListOptions{LabelSelector: selector.String()}
 
 	channels := &common.ResourceChannels{
-		ReplicaSetList: common.GetReplicaSetListChannelWithOptions(client,
+		ReplicaSetList: common.GetReplicaSetListChannelWithOptions(ctx, client,
 			common.NewSameNamespaceQuery(namespace), options, 1),
-		PodList: common.GetPodListChannelWithOptions(client,
+		PodList: common.GetPodListChannelWithOptions(ctx, client,
 			common.NewSameNamespaceQuery(namespace), options, 1),
-		EventList: common.GetEventListChannelWithOptions(client,
+		EventList: common.GetEventListChannelWithOptions(ctx, client,
 			common.NewSameNamespaceQuery(namespace), options, 1),
 	}
 
@@ -79,7 +79,7 @@ func GetDeploymentNewReplicaSet(client client.Interface, dsQuery *dataselect.Dat
 		return newReplicaSet, err
 	}
 
-	newReplicaSetList := replicaset.ToReplicaSetList([]apps.ReplicaSet{*newRS}, rawPods.Items, rawEvents.Items,
+	newReplicaSetList := replicaset.ToReplicaSetList(ctx, []apps.ReplicaSet{*newRS}, rawPods.Items, rawEvents.Items,
 		nonCriticalErrors, dsQuery, nil)
 	return &newReplicaSetList.ReplicaSets[0], nil
 }
@@ -28,7 +28,7 @@ import (
 )
 
 //GetDeploymentOldReplicaSets returns old replica sets targeting Deployment with given name
-func GetDeploymentOldReplicaSets(client client.Interface, dsQuery *dataselect.DataSelectQuery,
+func GetDeploymentOldReplicaSets(ctx context.Context, client client.Interface, dsQuery *dataselect.DataSelectQuery,
 	namespace string, deploymentName string) (*replicaset.ReplicaSetList, error) {
 
 	oldReplicaSetList := &replicaset.ReplicaSetList{
@@ -36,7 +36,7 @@ func GetDeploymentOldReplicaSets(client client.Interface, dsQuery *dataselect.Da
 		ListMeta:    api.ListMeta{TotalItems: 0},
 	}
 
-	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), deploymentName, metaV1.GetOptions{})
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metaV1.GetOptions{})
 	if err != nil {
 		return oldReplicaSetList, err
 	}
@@ -48,11 +48,11 @@ func GetDeploymentOldReplicaSets(client client.Interface, dsQuery *dataselect.Da
 	options := metaV1.ListOptions{LabelSelector: selector.String()}
 
 	channels := &common.ResourceChannels{
-		ReplicaSetList: common.GetReplicaSetListChannelWithOptions(client,
+		ReplicaSetList: common.GetReplicaSetListChannelWithOptions(ctx, client,
 			common.NewSameNamespaceQuery(namespace), options, 1),
-		PodList: common.GetPodListChannelWithOptions(client,
+		PodList: common.GetPodListChannelWithOptions(ctx, client,
 			common.NewSameNamespaceQuery(namespace), options, 1),
-		EventList: common.GetEventListChannelWithOptions(client,
+		EventList: common.GetEventListChannelWithOptions(ctx, client,
 			common.NewSameNamespaceQuery(namespace), options, 1),
 	}
 
@@ -87,7 +87,7 @@ func GetDeploymentOldReplicaSets(client client.Interface, dsQuery *dataselect.Da
 		oldReplicaSets[i] = *replicaSet
 	}
 
-	oldReplicaSetList = replicaset.ToReplicaSetList(oldReplicaSets, rawPods.Items, rawEvents.Items,
+	oldReplicaSetList = replicaset.ToReplicaSetList(ctx, oldReplicaSets, rawPods.Items, rawEvents.Items,
 		nonCriticalErrors, dsQuery, nil)
 	return oldReplicaSetList, nil
 }
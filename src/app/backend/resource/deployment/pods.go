@@ -28,17 +28,17 @@ import (
 )
 
 // GetDeploymentPods returns list of pods targeting deployment.
-func GetDeploymentPods(client client.Interface, metricClient metricapi.MetricClient,
+func GetDeploymentPods(ctx context.Context, client client.Interface, metricClient metricapi.MetricClient,
 	dsQuery *dataselect.DataSelectQuery, namespace, deploymentName string) (*pod.PodList, error) {
 
-	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), deploymentName, metaV1.GetOptions{})
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metaV1.GetOptions{})
 	if err != nil {
 		return pod.EmptyPodList, err
 	}
 
 	channels := &common.ResourceChannels{
-		PodList:        common.GetPodListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
-		ReplicaSetList: common.GetReplicaSetListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		PodList:        common.GetPodListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
+		ReplicaSetList: common.GetReplicaSetListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
 	rawPods := <-channels.PodList.List
@@ -54,12 +54,12 @@ func GetDeploymentPods(client client.Interface, metricClient metricapi.MetricCli
 	}
 
 	pods := common.FilterDeploymentPodsByOwnerReference(*deployment, rawRs.Items, rawPods.Items)
-	events, err := event.GetPodsEvents(client, namespace, pods)
+	events, err := event.GetPodsEvents(ctx, client, namespace, pods)
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return pod.EmptyPodList, criticalError
 	}
 
-	podList := pod.ToPodList(pods, events, nonCriticalErrors, dsQuery, metricClient)
+	podList := pod.ToPodList(ctx, pods, events, nonCriticalErrors, dsQuery, metricClient)
 	return &podList, nil
 }
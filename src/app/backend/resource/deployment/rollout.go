@@ -40,8 +40,8 @@ type RolloutSpec struct {
 }
 
 // RollbackDeployment rollback to a specific ReplicaSet revision
-func RollbackDeployment(client client.Interface, rolloutSpec *RolloutSpec, namespace, name string) (*RolloutSpec, error) {
-	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+func RollbackDeployment(ctx context.Context, client client.Interface, rolloutSpec *RolloutSpec, namespace, name string) (*RolloutSpec, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +49,7 @@ func RollbackDeployment(client client.Interface, rolloutSpec *RolloutSpec, names
 	if currRevision == FirstRevision {
 		return nil, errors.New("No revision for rolling back ")
 	}
-	matchRS, err := GetReplicaSetFromDeployment(client, namespace, name)
+	matchRS, err := GetReplicaSetFromDeployment(ctx, client, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func RollbackDeployment(client client.Interface, rolloutSpec *RolloutSpec, names
 		if rs.Annotations[RevisionAnnotationKey] == rolloutSpec.Revision {
 			updateDeployment := deployment.DeepCopy()
 			updateDeployment.Spec.Template.Spec = rs.Spec.Template.Spec
-			res, err := client.AppsV1().Deployments(namespace).Update(context.TODO(), updateDeployment, metaV1.UpdateOptions{})
+			res, err := client.AppsV1().Deployments(namespace).Update(ctx, updateDeployment, metaV1.UpdateOptions{})
 			if err != nil {
 				return nil, err
 			}
@@ -70,14 +70,14 @@ func RollbackDeployment(client client.Interface, rolloutSpec *RolloutSpec, names
 }
 
 // PauseDeployment is used to pause a deployment
-func PauseDeployment(client client.Interface, namespace, name string) (*v1.Deployment, error) {
-	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+func PauseDeployment(ctx context.Context, client client.Interface, namespace, name string) (*v1.Deployment, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 	if !deployment.Spec.Paused {
 		deployment.Spec.Paused = true
-		_, err = client.AppsV1().Deployments(namespace).Update(context.TODO(), deployment, metaV1.UpdateOptions{})
+		_, err = client.AppsV1().Deployments(namespace).Update(ctx, deployment, metaV1.UpdateOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -87,14 +87,14 @@ func PauseDeployment(client client.Interface, namespace, name string) (*v1.Deplo
 }
 
 // ResumeDeployment is used to resume a deployment
-func ResumeDeployment(client client.Interface, namespace, name string) (*v1.Deployment, error) {
-	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+func ResumeDeployment(ctx context.Context, client client.Interface, namespace, name string) (*v1.Deployment, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 	if deployment.Spec.Paused {
 		deployment.Spec.Paused = false
-		_, err = client.AppsV1().Deployments(namespace).Update(context.TODO(), deployment, metaV1.UpdateOptions{})
+		_, err = client.AppsV1().Deployments(namespace).Update(ctx, deployment, metaV1.UpdateOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -104,8 +104,8 @@ func ResumeDeployment(client client.Interface, namespace, name string) (*v1.Depl
 }
 
 // RestartDeployment restarts a deployment in the manner of `kubectl rollout restart`.
-func RestartDeployment(client client.Interface, namespace, name string) (*RolloutSpec, error) {
-	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+func RestartDeployment(ctx context.Context, client client.Interface, namespace, name string) (*RolloutSpec, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +114,7 @@ func RestartDeployment(client client.Interface, namespace, name string) (*Rollou
 		deployment.Spec.Template.ObjectMeta.Annotations = map[string]string{}
 	}
 	deployment.Spec.Template.ObjectMeta.Annotations[RestartedAtAnnotationKey] = time.Now().Format(time.RFC3339)
-	res, err := client.AppsV1().Deployments(namespace).Update(context.TODO(), deployment, metaV1.UpdateOptions{})
+	res, err := client.AppsV1().Deployments(namespace).Update(ctx, deployment, metaV1.UpdateOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -124,8 +124,8 @@ func RestartDeployment(client client.Interface, namespace, name string) (*Rollou
 }
 
 // GetReplicaSetFromDeployment return all replicaSet which is belong to the deployment
-func GetReplicaSetFromDeployment(client client.Interface, namespace, name string) ([]v1.ReplicaSet, error) {
-	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+func GetReplicaSetFromDeployment(ctx context.Context, client client.Interface, namespace, name string) ([]v1.ReplicaSet, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +135,7 @@ func GetReplicaSetFromDeployment(client client.Interface, namespace, name string
 		return nil, err
 	}
 	options := metaV1.ListOptions{LabelSelector: selector.String()}
-	allRS, err := client.AppsV1().ReplicaSets(namespace).List(context.TODO(), options)
+	allRS, err := client.AppsV1().ReplicaSets(namespace).List(ctx, options)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,83 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	apps "k8s.io/api/apps/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/listwatch"
+)
+
+// NewDeploymentListWatch returns list watch that streams changes of the Deployment list selected by given data select
+// query.
+func NewDeploymentListWatch(client kubernetes.Interface, metricClient metricapi.MetricClient, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+	return &listwatch.ListWatch{
+		NamespaceQuery: nsQuery,
+		ListOptions:    dsQuery.ListOptions(),
+		ListFunc: func(ctx context.Context, options metaV1.ListOptions) (runtime.Object, error) {
+			return client.AppsV1().Deployments(nsQuery.ToRequestParam()).List(ctx, options)
+		},
+		WatchFunc: func(ctx context.Context, options metaV1.ListOptions) (watch.Interface, error) {
+			return client.AppsV1().Deployments(nsQuery.ToRequestParam()).Watch(ctx, options)
+		},
+		Related: map[string]listwatch.Related{
+			listwatch.RelatedPods:        listwatch.NewRelatedPods(client, nsQuery, apps.DefaultDeploymentUniqueLabelKey),
+			listwatch.RelatedEvents:      listwatch.NewRelatedEvents(client, nsQuery),
+			listwatch.RelatedReplicaSets: listwatch.NewRelatedReplicaSets(client, nsQuery),
+		},
+		SelectFunc: func(ctx context.Context, objects []runtime.Object, related map[string][]runtime.Object) (
+			interface{}, api.ListMeta, []listwatch.Item, error) {
+			list := &apps.DeploymentList{}
+			for _, object := range objects {
+				list.Items = append(list.Items, *object.(*apps.Deployment))
+			}
+
+			channels := &common.ResourceChannels{
+				DeploymentList: common.DeploymentListChannel{
+					List:  make(chan *apps.DeploymentList, 1),
+					Error: make(chan error, 1),
+				},
+				PodList:        listwatch.PodListChannel(related[listwatch.RelatedPods]),
+				EventList:      listwatch.EventListChannel(related[listwatch.RelatedEvents]),
+				ReplicaSetList: listwatch.ReplicaSetListChannel(related[listwatch.RelatedReplicaSets]),
+			}
+			channels.DeploymentList.List <- list
+			channels.DeploymentList.Error <- nil
+
+			result, err := GetDeploymentListFromChannels(ctx, channels, dsQuery, metricClient)
+			if err != nil {
+				return nil, api.ListMeta{}, nil, err
+			}
+
+			items := make([]listwatch.Item, len(result.Deployments))
+			for i, item := range result.Deployments {
+				items[i] = listwatch.Item{UID: item.ObjectMeta.UID, Object: item}
+			}
+
+			return result, result.ListMeta, items, nil
+		},
+	}
+}
@@ -15,6 +15,7 @@
 package endpoint
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -44,13 +45,13 @@ type Endpoint struct {
 }
 
 // GetServiceEndpoints gets list of endpoints targeted by given label selector in given namespace.
-func GetServiceEndpoints(client k8sClient.Interface, namespace, name string) (*EndpointList, error) {
+func GetServiceEndpoints(ctx context.Context, client k8sClient.Interface, namespace, name string) (*EndpointList, error) {
 	endpointList := &EndpointList{
 		Endpoints: make([]Endpoint, 0),
 		ListMeta:  api.ListMeta{TotalItems: 0},
 	}
 
-	serviceEndpoints, err := GetEndpoints(client, namespace, name)
+	serviceEndpoints, err := GetEndpoints(ctx, client, namespace, name)
 	if err != nil {
 		return endpointList, err
 	}
@@ -61,14 +62,14 @@ func GetServiceEndpoints(client k8sClient.Interface, namespace, name string) (*E
 }
 
 // GetEndpoints gets endpoints associated to resource with given name.
-func GetEndpoints(client k8sClient.Interface, namespace, name string) ([]v1.Endpoints, error) {
+func GetEndpoints(ctx context.Context, client k8sClient.Interface, namespace, name string) ([]v1.Endpoints, error) {
 	fieldSelector, err := fields.ParseSelector("metadata.name" + "=" + name)
 	if err != nil {
 		return nil, err
 	}
 
 	channels := &common.ResourceChannels{
-		EndpointList: common.GetEndpointListChannelWithOptions(client,
+		EndpointList: common.GetEndpointListChannelWithOptions(ctx, client,
 			common.NewSameNamespaceQuery(namespace),
 			metaV1.ListOptions{
 				LabelSelector: labels.Everything().String(),
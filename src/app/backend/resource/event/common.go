@@ -40,7 +40,7 @@ var EmptyEventList = &common.EventList{
 }
 
 // GetEvents gets events associated to resource with given name.
-func GetEvents(client kubernetes.Interface, namespace, resourceName string) ([]v1.Event, error) {
+func GetEvents(ctx context.Context, client kubernetes.Interface, namespace, resourceName string) ([]v1.Event, error) {
 	fieldSelector, err := fields.ParseSelector("involvedObject.name" + "=" + resourceName)
 
 	if err != nil {
@@ -48,7 +48,7 @@ func GetEvents(client kubernetes.Interface, namespace, resourceName string) ([]v
 	}
 
 	channels := &common.ResourceChannels{
-		EventList: common.GetEventListChannelWithOptions(
+		EventList: common.GetEventListChannelWithOptions(ctx,
 			client,
 			common.NewSameNamespaceQuery(namespace),
 			metaV1.ListOptions{
@@ -67,7 +67,7 @@ func GetEvents(client kubernetes.Interface, namespace, resourceName string) ([]v
 }
 
 // GetPodsEvents gets events targeting given list of pods.
-func GetPodsEvents(client kubernetes.Interface, namespace string, pods []v1.Pod) (
+func GetPodsEvents(ctx context.Context, client kubernetes.Interface, namespace string, pods []v1.Pod) (
 	[]v1.Event, error) {
 
 	nsQuery := common.NewSameNamespaceQuery(namespace)
@@ -76,7 +76,7 @@ func GetPodsEvents(client kubernetes.Interface, namespace string, pods []v1.Pod)
 	}
 
 	channels := &common.ResourceChannels{
-		EventList: common.GetEventListChannel(client, nsQuery, 1),
+		EventList: common.GetEventListChannel(ctx, client, nsQuery, 1),
 	}
 
 	eventList := <-channels.EventList.List
@@ -90,13 +90,13 @@ func GetPodsEvents(client kubernetes.Interface, namespace string, pods []v1.Pod)
 }
 
 // GetPodEvents gets pods events associated to pod name and namespace
-func GetPodEvents(client kubernetes.Interface, namespace, podName string) ([]v1.Event, error) {
+func GetPodEvents(ctx context.Context, client kubernetes.Interface, namespace, podName string) ([]v1.Event, error) {
 
 	channels := &common.ResourceChannels{
-		PodList: common.GetPodListChannel(client,
+		PodList: common.GetPodListChannel(ctx, client,
 			common.NewSameNamespaceQuery(namespace),
 			1),
-		EventList: common.GetEventListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		EventList: common.GetEventListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
 	podList := <-channels.PodList.List
@@ -121,7 +121,7 @@ func GetPodEvents(client kubernetes.Interface, namespace, podName string) ([]v1.
 }
 
 // GetNodeEvents gets events associated to node with given name.
-func GetNodeEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, nodeName string) (*common.EventList, error) {
+func GetNodeEvents(ctx context.Context, client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, nodeName string) (*common.EventList, error) {
 	eventList := common.EventList{
 		Events: make([]common.Event, 0),
 	}
@@ -131,7 +131,7 @@ func GetNodeEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQu
 	scheme.AddKnownTypes(groupVersion, &v1.Node{})
 
 	mc := client.CoreV1().Nodes()
-	node, err := mc.Get(context.TODO(), nodeName, metaV1.GetOptions{})
+	node, err := mc.Get(ctx, nodeName, metaV1.GetOptions{})
 	if err != nil {
 		return &eventList, err
 	}
@@ -154,8 +154,8 @@ func GetNodeEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQu
 }
 
 // GetNamespaceEvents gets events associated to a namespace with given name.
-func GetNamespaceEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace string) (common.EventList, error) {
-	events, _ := client.CoreV1().Events(namespace).List(context.TODO(), api.ListEverything)
+func GetNamespaceEvents(ctx context.Context, client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace string) (common.EventList, error) {
+	events, _ := client.CoreV1().Events(namespace).List(ctx, api.ListEverything)
 	return CreateEventList(FillEventsType(events.Items), dsQuery), nil
 }
 
@@ -209,9 +209,9 @@ func ToEvent(event v1.Event) common.Event {
 }
 
 // GetResourceEvents gets events associated to specified resource.
-func GetResourceEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
+func GetResourceEvents(ctx context.Context, client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
 	*common.EventList, error) {
-	resourceEvents, err := GetEvents(client, namespace, name)
+	resourceEvents, err := GetEvents(ctx, client, namespace, name)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return EmptyEventList, err
@@ -15,6 +15,7 @@
 package event
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -54,7 +55,7 @@ func TestGetEvents(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.eventList)
 
-		actual, _ := GetEvents(fakeClient, c.namespace, c.name)
+		actual, _ := GetEvents(context.TODO(), fakeClient, c.namespace, c.name)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -158,7 +159,7 @@ func TestGetResourceEvents(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.eventList, c.replicaSet, c.podList)
 
-		actual, _ := GetResourceEvents(fakeClient, dataselect.NoDataSelect, c.namespace, c.name)
+		actual, _ := GetResourceEvents(context.TODO(), fakeClient, dataselect.NoDataSelect, c.namespace, c.name)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -15,6 +15,7 @@
 package event
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
@@ -23,12 +24,12 @@ import (
 	k8sClient "k8s.io/client-go/kubernetes"
 )
 
-func GetEventList(client k8sClient.Interface, nsQuery *common.NamespaceQuery,
+func GetEventList(ctx context.Context, client k8sClient.Interface, nsQuery *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery) (*common.EventList, error) {
 	log.Printf("Getting list of events in namespace: %s", nsQuery.ToRequestParam())
 
 	channels := &common.ResourceChannels{
-		EventList: common.GetEventListChannel(client, nsQuery, 2),
+		EventList: common.GetEventListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 2),
 	}
 
 	return GetEventListFromChannels(channels, dsQuery)
@@ -49,6 +50,7 @@ func GetEventListFromChannels(channels *common.ResourceChannels, dsQuery *datase
 	}
 
 	result := CreateEventList(FillEventsType(eventList.Items), dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, eventList)
 	result.Errors = nonCriticalErrors
 
 	return &result, nil
@@ -0,0 +1,212 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// Format is the serialization format of an exported bundle.
+type Format string
+
+// List of supported export formats.
+const (
+	// FormatYAML is a multi-document YAML file.
+	FormatYAML Format = "yaml"
+
+	// FormatJSON is a JSON list of objects.
+	FormatJSON Format = "json"
+)
+
+// Validate returns bad request error if the format is not supported.
+func (self Format) Validate() error {
+	switch self {
+	case FormatYAML, FormatJSON:
+		return nil
+	}
+
+	return errors.NewBadRequest(fmt.Sprintf("unsupported export format: %s", self))
+}
+
+// rootCACertConfigMap is created in every namespace by the cluster and holds the CA of the cluster.
+const rootCACertConfigMap = "kube-root-ca.crt"
+
+// NamespaceKinds are the kinds exported when no kinds are selected. Kinds whose objects are created by
+// controllers, like pods, replica sets or endpoints, are left out.
+var NamespaceKinds = []string{
+	api.ResourceKindServiceAccount,
+	api.ResourceKindRole,
+	api.ResourceKindRoleBinding,
+	api.ResourceKindResourceQuota,
+	api.ResourceKindLimitRange,
+	api.ResourceKindConfigMap,
+	api.ResourceKindSecret,
+	api.ResourceKindPersistentVolumeClaim,
+	api.ResourceKindService,
+	api.ResourceKindDeployment,
+	api.ResourceKindStatefulSet,
+	api.ResourceKindDaemonSet,
+	api.ResourceKindJob,
+	api.ResourceKindCronJob,
+	api.ResourceKindReplicationController,
+	api.ResourceKindHorizontalPodAutoscaler,
+	api.ResourceKindIngress,
+	api.ResourceKindNetworkPolicy,
+}
+
+// Bundle is a set of exported objects.
+type Bundle struct {
+	// Exported objects in the order of the exported kinds.
+	Items []unstructured.Unstructured
+
+	// List of non-critical errors, that occurred during resource retrieval, and of protected resources, that
+	// were left out.
+	Errors []error
+}
+
+// Export lists objects of the given kinds and strips fields populated by the server, so that the objects can
+// be applied to another cluster. Objects of namespaced kinds are listed in the given namespace, or in all
+// namespaces if it is empty. When no kinds are given, NamespaceKinds of the namespace are exported. Objects
+// managed by a controller are left out, because the controller recreates them. Dashboard protected resources,
+// such as the secret with encryption keys, are left out too and reported in errors of the bundle.
+func Export(ctx context.Context, verber clientapi.ResourceVerber, kinds []string, namespace string) (*Bundle, error) {
+	if len(kinds) == 0 {
+		if len(namespace) == 0 {
+			return nil, errors.NewBadRequest("kinds or namespace to export must be set")
+		}
+		kinds = NamespaceKinds
+	}
+
+	bundle := &Bundle{Items: make([]unstructured.Unstructured, 0), Errors: make([]error, 0)}
+	for _, kind := range kinds {
+		list, err := verber.List(ctx, kind, len(namespace) > 0, namespace)
+		var criticalError error
+		bundle.Errors, criticalError = errors.AppendError(err, bundle.Errors)
+		if criticalError != nil {
+			return nil, criticalError
+		}
+		if err != nil {
+			continue
+		}
+
+		items, err := toUnstructuredItems(list)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			if isManaged(item) {
+				continue
+			}
+			if authApi.ShouldRejectResource(item.GetName(), item.GetNamespace()) {
+				bundle.Errors = append(bundle.Errors, fmt.Errorf("%s %s/%s is a Dashboard protected resource "+
+					"and was not exported", kind, item.GetNamespace(), item.GetName()))
+				continue
+			}
+			bundle.Items = append(bundle.Items, stripServerFields(item))
+		}
+	}
+
+	return bundle, nil
+}
+
+// Marshal serializes the bundle in the given format.
+func (self *Bundle) Marshal(format Format) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		list := &unstructured.UnstructuredList{
+			Object: map[string]interface{}{"apiVersion": "v1", "kind": "List"},
+			Items:  self.Items,
+		}
+		return list.MarshalJSON()
+	case FormatYAML:
+		documents := make([]string, 0, len(self.Items))
+		for _, item := range self.Items {
+			out, err := yaml.Marshal(item.Object)
+			if err != nil {
+				return nil, err
+			}
+			documents = append(documents, string(out))
+		}
+		return []byte(strings.Join(documents, "---\n")), nil
+	}
+
+	return nil, format.Validate()
+}
+
+func toUnstructuredItems(list runtime.Object) ([]unstructured.Unstructured, error) {
+	raw, ok := list.(*runtime.Unknown)
+	if !ok {
+		return nil, errors.NewUnexpectedObject(list)
+	}
+
+	result := &unstructured.UnstructuredList{}
+	if err := result.UnmarshalJSON(raw.Raw); err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+// isManaged returns true for objects that are created and kept up to date by the cluster itself.
+func isManaged(object unstructured.Unstructured) bool {
+	for _, reference := range object.GetOwnerReferences() {
+		if reference.Controller != nil && *reference.Controller {
+			return true
+		}
+	}
+
+	switch object.GetKind() {
+	case "ConfigMap":
+		return object.GetName() == rootCACertConfigMap
+	case "Secret":
+		secretType, _, _ := unstructured.NestedString(object.Object, "type")
+		return secretType == string(v1.SecretTypeServiceAccountToken)
+	}
+	return false
+}
+
+// stripServerFields removes fields that are populated by the server and would be rejected or would conflict
+// when the object is created in another cluster.
+func stripServerFields(object unstructured.Unstructured) unstructured.Unstructured {
+	object = *object.DeepCopy()
+	unstructured.RemoveNestedField(object.Object, "status")
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "creationTimestamp", "selfLink",
+		"generation"} {
+		unstructured.RemoveNestedField(object.Object, "metadata", field)
+	}
+
+	// Cluster IPs are allocated from the service range of the cluster. Headless services keep "None".
+	if object.GetKind() == "Service" {
+		clusterIP, _, _ := unstructured.NestedString(object.Object, "spec", "clusterIP")
+		if clusterIP != v1.ClusterIPNone {
+			unstructured.RemoveNestedField(object.Object, "spec", "clusterIP")
+			unstructured.RemoveNestedField(object.Object, "spec", "clusterIPs")
+		}
+	}
+
+	return object
+}
@@ -0,0 +1,246 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+)
+
+type fakeVerber struct {
+	lists  map[string]string
+	errors map[string]error
+	listed []string
+}
+
+func (self *fakeVerber) Put(ctx context.Context, kind string, namespaceSet bool, namespace string, name string,
+	object *runtime.Unknown, options clientapi.PutOptions) (runtime.Object, error) {
+	return nil, nil
+}
+
+func (self *fakeVerber) Get(ctx context.Context, kind string, namespaceSet bool, namespace string, name string) (runtime.Object, error) {
+	return nil, nil
+}
+
+func (self *fakeVerber) List(ctx context.Context, kind string, namespaceSet bool, namespace string) (runtime.Object, error) {
+	self.listed = append(self.listed, kind+"/"+namespace)
+	if err, ok := self.errors[kind]; ok {
+		return nil, err
+	}
+	list, ok := self.lists[kind]
+	if !ok {
+		list = `{"apiVersion":"v1","kind":"List","items":[]}`
+	}
+	return &runtime.Unknown{Raw: []byte(list)}, nil
+}
+
+func (self *fakeVerber) Delete(ctx context.Context, kind string, namespaceSet bool, namespace string, name string,
+	options clientapi.DeleteOptions) error {
+	return nil
+}
+
+func (self *fakeVerber) Patch(ctx context.Context, kind string, namespaceSet bool, namespace string, name string,
+	patchType types.PatchType, data []byte, options clientapi.PatchOptions) (runtime.Object, error) {
+	return nil, nil
+}
+
+const configMapList = `{"apiVersion":"v1","kind":"ConfigMapList","items":[
+	{"metadata":{"name":"settings","namespace":"ns","uid":"1","resourceVersion":"5",
+		"creationTimestamp":"2021-01-01T00:00:00Z","managedFields":[{"manager":"kubectl"}]},"data":{"a":"b"}},
+	{"metadata":{"name":"kube-root-ca.crt","namespace":"ns"},"data":{"ca.crt":"x"}},
+	{"metadata":{"name":"owned","namespace":"ns","ownerReferences":[{"apiVersion":"v1","kind":"Pod","name":"p",
+		"uid":"2","controller":true}]}}]}`
+
+const serviceList = `{"apiVersion":"v1","kind":"ServiceList","items":[
+	{"metadata":{"name":"web","namespace":"ns"},"spec":{"clusterIP":"10.0.0.1","clusterIPs":["10.0.0.1"]},
+		"status":{"loadBalancer":{}}},
+	{"metadata":{"name":"headless","namespace":"ns"},"spec":{"clusterIP":"None","clusterIPs":["None"]}}]}`
+
+const secretList = `{"apiVersion":"v1","kind":"SecretList","items":[
+	{"metadata":{"name":"token","namespace":"ns"},"type":"kubernetes.io/service-account-token"},
+	{"metadata":{"name":"password","namespace":"ns"},"type":"Opaque","data":{"p":"eA=="}},
+	{"metadata":{"name":"kubernetes-dashboard-key-holder","namespace":"ns"},"type":"Opaque",
+		"data":{"priv":"eA=="}},
+	{"metadata":{"name":"kubernetes-dashboard-csrf","namespace":"ns"},"type":"Opaque","data":{"csrf":"eA=="}}]}`
+
+func TestExport(t *testing.T) {
+	forbidden := k8serrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", nil)
+	cases := []struct {
+		info      string
+		kinds     []string
+		namespace string
+		errors    map[string]error
+		expected  []map[string]interface{}
+		listed    []string
+		warnings  int
+		wantErr   bool
+	}{
+		{
+			info:      "should strip server fields and skip managed and protected objects",
+			kinds:     []string{api.ResourceKindConfigMap, api.ResourceKindService, api.ResourceKindSecret},
+			namespace: "ns",
+			expected: []map[string]interface{}{
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "settings",
+					"namespace": "ns"}, "data": map[string]interface{}{"a": "b"}},
+				{"apiVersion": "v1", "kind": "Service", "metadata": map[string]interface{}{"name": "web",
+					"namespace": "ns"}, "spec": map[string]interface{}{}},
+				{"apiVersion": "v1", "kind": "Service", "metadata": map[string]interface{}{"name": "headless",
+					"namespace": "ns"}, "spec": map[string]interface{}{"clusterIP": "None",
+					"clusterIPs": []interface{}{"None"}}},
+				{"apiVersion": "v1", "kind": "Secret", "metadata": map[string]interface{}{"name": "password",
+					"namespace": "ns"}, "type": "Opaque", "data": map[string]interface{}{"p": "eA=="}},
+			},
+			listed:   []string{"configmap/ns", "service/ns", "secret/ns"},
+			warnings: 2,
+		},
+		{
+			info:      "should export default kinds of namespace",
+			namespace: "ns",
+			listed:    make([]string, len(NamespaceKinds)),
+		},
+		{
+			info:     "should collect non-critical errors",
+			kinds:    []string{api.ResourceKindSecret, api.ResourceKindConfigMap},
+			errors:   map[string]error{api.ResourceKindSecret: forbidden},
+			listed:   []string{"secret/", "configmap/"},
+			warnings: 1,
+			expected: []map[string]interface{}{
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "settings",
+					"namespace": "ns"}, "data": map[string]interface{}{"a": "b"}},
+			},
+		},
+		{
+			info:    "should fail on critical errors",
+			kinds:   []string{api.ResourceKindConfigMap},
+			errors:  map[string]error{api.ResourceKindConfigMap: k8serrors.NewServiceUnavailable("unavailable")},
+			wantErr: true,
+		},
+		{
+			info:    "should require kinds or namespace",
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		verber := &fakeVerber{
+			lists: map[string]string{
+				api.ResourceKindConfigMap: configMapList,
+				api.ResourceKindService:   serviceList,
+				api.ResourceKindSecret:    secretList,
+			},
+			errors: c.errors,
+		}
+		if c.kinds == nil && c.namespace != "" {
+			verber.lists = nil
+			for i, kind := range NamespaceKinds {
+				c.listed[i] = kind + "/" + c.namespace
+			}
+		}
+
+		bundle, err := Export(context.TODO(), verber, c.kinds, c.namespace)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("Test Case: %s. Expected error, got nil", c.info)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test Case: %s. Unexpected error: %v", c.info, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(verber.listed, c.listed) {
+			t.Errorf("Test Case: %s. Expected listed kinds %v, got %v", c.info, c.listed, verber.listed)
+		}
+		if len(bundle.Errors) != c.warnings {
+			t.Errorf("Test Case: %s. Expected %d errors, got %v", c.info, c.warnings, bundle.Errors)
+		}
+		actual := make([]map[string]interface{}, 0)
+		for _, item := range bundle.Items {
+			actual = append(actual, item.Object)
+		}
+		if c.expected == nil {
+			c.expected = make([]map[string]interface{}, 0)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected items %#v, got %#v", c.info, c.expected, actual)
+		}
+	}
+}
+
+func TestBundleMarshal(t *testing.T) {
+	bundle, err := Export(context.TODO(), &fakeVerber{lists: map[string]string{api.ResourceKindConfigMap: configMapList}},
+		[]string{api.ResourceKindConfigMap, api.ResourceKindConfigMap}, "ns")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	out, err := bundle.Marshal(FormatYAML)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	document := "apiVersion: v1\ndata:\n  a: b\nkind: ConfigMap\nmetadata:\n  name: settings\n  namespace: ns\n"
+	if expected := document + "---\n" + document; string(out) != expected {
+		t.Errorf("Expected YAML %q, got %q", expected, out)
+	}
+
+	out, err = bundle.Marshal(FormatJSON)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	list := struct {
+		Kind  string        `json:"kind"`
+		Items []interface{} `json:"items"`
+	}{}
+	if err := json.Unmarshal(out, &list); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if list.Kind != "List" || len(list.Items) != 2 {
+		t.Errorf("Expected list of 2 items, got %s", out)
+	}
+
+	if _, err := bundle.Marshal("xml"); err == nil || !strings.Contains(err.Error(), "xml") {
+		t.Errorf("Expected unsupported format error, got %v", err)
+	}
+}
+
+func TestFormatValidate(t *testing.T) {
+	cases := []struct {
+		format     Format
+		badRequest bool
+	}{
+		{FormatYAML, false},
+		{FormatJSON, false},
+		{"xml", true},
+		{"", true},
+	}
+
+	for _, c := range cases {
+		if err := c.format.Validate(); k8serrors.IsBadRequest(err) != c.badRequest {
+			t.Errorf("Test Case: %q. Expected bad request error: %t, got %v", c.format, c.badRequest, err)
+		}
+	}
+}
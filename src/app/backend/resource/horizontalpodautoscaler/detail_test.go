@@ -15,6 +15,7 @@
 package horizontalpodautoscaler
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -69,7 +70,7 @@ func TestGetHorizontalPodAutoscalerDetail(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.hpa)
 
-		actual, _ := GetHorizontalPodAutoscalerDetail(fakeClient, c.namespace, c.name)
+		actual, _ := GetHorizontalPodAutoscalerDetail(context.TODO(), fakeClient, c.namespace, c.name)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -15,6 +15,7 @@
 package horizontalpodautoscaler
 
 import (
+	"context"
 	"strings"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -46,8 +47,8 @@ type HorizontalPodAutoscaler struct {
 	TargetCPUUtilizationPercentage  *int32         `json:"targetCPUUtilizationPercentage"`
 }
 
-func GetHorizontalPodAutoscalerList(client k8sClient.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*HorizontalPodAutoscalerList, error) {
-	channel := common.GetHorizontalPodAutoscalerListChannel(client, nsQuery, 1)
+func GetHorizontalPodAutoscalerList(ctx context.Context, client k8sClient.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*HorizontalPodAutoscalerList, error) {
+	channel := common.GetHorizontalPodAutoscalerListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1)
 	hpaList := <-channel.List
 	err := <-channel.Error
 
@@ -56,12 +57,14 @@ func GetHorizontalPodAutoscalerList(client k8sClient.Interface, nsQuery *common.
 		return nil, criticalError
 	}
 
-	return toHorizontalPodAutoscalerList(hpaList.Items, nonCriticalErrors, dsQuery), nil
+	result := toHorizontalPodAutoscalerList(hpaList.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, hpaList)
+	return result, nil
 }
 
-func GetHorizontalPodAutoscalerListForResource(client k8sClient.Interface, namespace, kind, name string) (*HorizontalPodAutoscalerList, error) {
+func GetHorizontalPodAutoscalerListForResource(ctx context.Context, client k8sClient.Interface, namespace, kind, name string) (*HorizontalPodAutoscalerList, error) {
 	nsQuery := common.NewSameNamespaceQuery(namespace)
-	channel := common.GetHorizontalPodAutoscalerListChannel(client, nsQuery, 1)
+	channel := common.GetHorizontalPodAutoscalerListChannel(ctx, client, nsQuery, 1)
 	hpaList := <-channel.List
 	err := <-channel.Error
 
@@ -15,6 +15,7 @@
 package horizontalpodautoscaler
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -142,7 +143,7 @@ func TestGetHorizontalPodAutoscalerList(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.hpaList)
 
-		actual, _ := GetHorizontalPodAutoscalerList(fakeClient, &common.NamespaceQuery{}, dataselect.DefaultDataSelect)
+		actual, _ := GetHorizontalPodAutoscalerList(context.TODO(), fakeClient, &common.NamespaceQuery{}, dataselect.DefaultDataSelect)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -222,7 +223,7 @@ func TestGetHorizontalPodAutoscalerListForResource(t *testing.T) {
 
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.hpaList)
-		actual, _ := GetHorizontalPodAutoscalerListForResource(fakeClient, "", c.kind, c.name)
+		actual, _ := GetHorizontalPodAutoscalerListForResource(context.TODO(), fakeClient, "", c.kind, c.name)
 		actions := fakeClient.Actions()
 
 		if len(actions) != len(c.expectedActions) {
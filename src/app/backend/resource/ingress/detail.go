@@ -40,10 +40,10 @@ type IngressDetail struct {
 }
 
 // GetIngressDetail returns detailed information about an ingress
-func GetIngressDetail(client client.Interface, namespace, name string) (*IngressDetail, error) {
+func GetIngressDetail(ctx context.Context, client client.Interface, namespace, name string) (*IngressDetail, error) {
 	log.Printf("Getting details of %s ingress in %s namespace", name, namespace)
 
-	rawIngress, err := client.NetworkingV1().Ingresses(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	rawIngress, err := client.NetworkingV1().Ingresses(namespace).Get(ctx, name, metaV1.GetOptions{})
 
 	if err != nil {
 		return nil, err
@@ -47,16 +47,33 @@ type IngressList struct {
 }
 
 // GetIngressList returns all ingresses in the given namespace.
-func GetIngressList(client client.Interface, namespace *common.NamespaceQuery,
+func GetIngressList(ctx context.Context, client client.Interface, namespace *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery) (*IngressList, error) {
-	ingressList, err := client.NetworkingV1().Ingresses(namespace.ToRequestParam()).List(context.TODO(), api.ListEverything)
+	ingressList, err := client.NetworkingV1().Ingresses(namespace.ToRequestParam()).List(ctx, dsQuery.ListOptions())
 
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	return ToIngressList(ingressList.Items, nonCriticalErrors, dsQuery), nil
+	result := ToIngressList(ingressList.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, ingressList)
+	return result, nil
+}
+
+// GetIngressListFromChannels returns a list of all ingresses in the cluster reading required
+// resource list once from the channels.
+func GetIngressListFromChannels(channels *common.ResourceChannels, dsQuery *dataselect.DataSelectQuery) (*IngressList, error) {
+	ingressList := <-channels.IngressList.List
+	err := <-channels.IngressList.Error
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	result := ToIngressList(ingressList.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, ingressList)
+	return result, nil
 }
 
 func getEndpoints(ingress *v1.Ingress) []common.Endpoint {
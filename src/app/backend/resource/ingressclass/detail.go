@@ -31,10 +31,10 @@ type IngressClassDetail struct {
 }
 
 // GetIngressClass returns Storage Class resource.
-func GetIngressClass(client kubernetes.Interface, name string) (*IngressClassDetail, error) {
+func GetIngressClass(ctx context.Context, client kubernetes.Interface, name string) (*IngressClassDetail, error) {
 	log.Printf("Getting details of %s ingress class", name)
 
-	ic, err := client.NetworkingV1().IngressClasses().Get(context.TODO(), name, metaV1.GetOptions{})
+	ic, err := client.NetworkingV1().IngressClasses().Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@
 package ingressclass
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -42,12 +43,12 @@ type IngressClass struct {
 }
 
 // GetIngressClassList returns a list of all Ingress class objects in the cluster.
-func GetIngressClassList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (
+func GetIngressClassList(ctx context.Context, client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (
 	*IngressClassList, error) {
 	log.Print("Getting list of ingress classes in the cluster")
 
 	channels := &common.ResourceChannels{
-		IngressClassList: common.GetIngressClassListChannel(client, 1),
+		IngressClassList: common.GetIngressClassListChannelWithOptions(ctx, client, dsQuery.ListOptions(), 1),
 	}
 
 	return GetIngressClassListFromChannels(channels, dsQuery)
@@ -63,7 +64,9 @@ func GetIngressClassListFromChannels(channels *common.ResourceChannels,
 		return nil, criticalError
 	}
 
-	return toIngressClassList(ingressClasses.Items, nonCriticalErrors, dsQuery), nil
+	result := toIngressClassList(ingressClasses.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, ingressClasses)
+	return result, nil
 }
 
 func toIngressClassList(ingressClasses []networkingv1.IngressClass, nonCriticalErrors []error,
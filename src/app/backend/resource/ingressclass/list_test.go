@@ -15,6 +15,7 @@
 package ingressclass
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -61,7 +62,7 @@ func TestGetIngressClassList(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.ingressClassList)
 
-		actual, _ := GetIngressClassList(fakeClient, dataselect.NoDataSelect)
+		actual, _ := GetIngressClassList(context.TODO(), fakeClient, dataselect.NoDataSelect)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -37,13 +37,13 @@ type JobDetail struct {
 }
 
 // GetJobDetail gets job details.
-func GetJobDetail(client k8sClient.Interface, namespace, name string) (*JobDetail, error) {
-	jobData, err := client.BatchV1().Jobs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+func GetJobDetail(ctx context.Context, client k8sClient.Interface, namespace, name string) (*JobDetail, error) {
+	jobData, err := client.BatchV1().Jobs(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	podInfo, err := getJobPodInfo(client, jobData)
+	podInfo, err := getJobPodInfo(ctx, client, jobData)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
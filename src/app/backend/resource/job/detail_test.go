@@ -15,6 +15,7 @@
 package job
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -79,7 +80,7 @@ func TestGetJobDetail(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.job)
 		dataselect.DefaultDataSelectWithMetrics.MetricQuery = dataselect.NoMetrics
-		actual, _ := GetJobDetail(fakeClient, c.namespace, c.name)
+		actual, _ := GetJobDetail(context.TODO(), fakeClient, c.namespace, c.name)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
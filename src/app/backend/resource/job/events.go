@@ -15,6 +15,7 @@
 package job
 
 import (
+	"context"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
@@ -22,10 +23,10 @@ import (
 )
 
 // GetJobEvents gets events associated to job.
-func GetJobEvents(client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
+func GetJobEvents(ctx context.Context, client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
 	*common.EventList, error) {
 
-	jobEvents, err := event.GetEvents(client, namespace, name)
+	jobEvents, err := event.GetEvents(ctx, client, namespace, name)
 	if err != nil {
 		return event.EmptyEventList, err
 	}
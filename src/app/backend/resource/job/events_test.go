@@ -15,6 +15,7 @@
 package job
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -64,7 +65,7 @@ func TestGetJobEvents(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.eventList, c.job, c.podList)
 
-		actual, _ := GetJobEvents(fakeClient, dataselect.NoDataSelect, c.namespace, c.name)
+		actual, _ := GetJobEvents(context.TODO(), fakeClient, dataselect.NoDataSelect, c.namespace, c.name)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -15,6 +15,7 @@
 package job
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -86,22 +87,22 @@ type Job struct {
 }
 
 // GetJobList returns a list of all Jobs in the cluster.
-func GetJobList(client client.Interface, nsQuery *common.NamespaceQuery,
+func GetJobList(ctx context.Context, client client.Interface, nsQuery *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*JobList, error) {
 	log.Print("Getting list of all jobs in the cluster")
 
 	channels := &common.ResourceChannels{
-		JobList:   common.GetJobListChannel(client, nsQuery, 1),
-		PodList:   common.GetPodListChannel(client, nsQuery, 1),
-		EventList: common.GetEventListChannel(client, nsQuery, 1),
+		JobList:   common.GetJobListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
+		PodList:   common.GetPodListChannel(ctx, client, nsQuery, 1),
+		EventList: common.GetEventListChannel(ctx, client, nsQuery, 1),
 	}
 
-	return GetJobListFromChannels(channels, dsQuery, metricClient)
+	return GetJobListFromChannels(ctx, channels, dsQuery, metricClient)
 }
 
 // GetJobListFromChannels returns a list of all Jobs in the cluster reading required resource list once from the channels.
-func GetJobListFromChannels(channels *common.ResourceChannels, dsQuery *dataselect.DataSelectQuery,
-	metricClient metricapi.MetricClient) (*JobList, error) {
+func GetJobListFromChannels(ctx context.Context, channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*JobList, error) {
 
 	jobs := <-channels.JobList.List
 	err := <-channels.JobList.Error
@@ -124,12 +125,13 @@ func GetJobListFromChannels(channels *common.ResourceChannels, dsQuery *datasele
 		return nil, criticalError
 	}
 
-	jobList := ToJobList(jobs.Items, pods.Items, events.Items, nonCriticalErrors, dsQuery, metricClient)
+	jobList := ToJobList(ctx, jobs.Items, pods.Items, events.Items, nonCriticalErrors, dsQuery, metricClient)
 	jobList.Status = getStatus(jobs, pods.Items)
+	jobList.ListMeta = dsQuery.CursorListMeta(jobList.ListMeta, jobs)
 	return jobList, nil
 }
 
-func ToJobList(jobs []batch.Job, pods []v1.Pod, events []v1.Event, nonCriticalErrors []error,
+func ToJobList(ctx context.Context, jobs []batch.Job, pods []v1.Pod, events []v1.Event, nonCriticalErrors []error,
 	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) *JobList {
 
 	jobList := &JobList{
@@ -141,7 +143,7 @@ func ToJobList(jobs []batch.Job, pods []v1.Pod, events []v1.Event, nonCriticalEr
 	cachedResources := &metricapi.CachedResources{
 		Pods: pods,
 	}
-	jobCells, metricPromises, filteredTotal := dataselect.GenericDataSelectWithFilterAndMetrics(ToCells(jobs),
+	jobCells, metricPromises, filteredTotal := dataselect.GenericDataSelectWithFilterAndMetrics(ctx, ToCells(jobs),
 		dsQuery, cachedResources, metricClient)
 	jobs = FromCells(jobCells)
 	jobList.ListMeta = api.ListMeta{TotalItems: filteredTotal}
@@ -15,6 +15,7 @@
 package job
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -259,12 +260,12 @@ func TestGetJobListFromChannels(t *testing.T) {
 		channels.EventList.List <- &v1.EventList{}
 		channels.EventList.Error <- nil
 
-		actual, err := GetJobListFromChannels(channels, dataselect.NoDataSelect, nil)
+		actual, err := GetJobListFromChannels(context.TODO(), channels, dataselect.NoDataSelect, nil)
 		if !reflect.DeepEqual(actual, c.expected) {
-			t.Errorf("GetJobListFromChannels() ==\n          %#v\nExpected: %#v", actual, c.expected)
+			t.Errorf("GetJobListFromChannels(context.TODO(), ) ==\n          %#v\nExpected: %#v", actual, c.expected)
 		}
 		if !reflect.DeepEqual(err, c.expectedError) {
-			t.Errorf("GetJobListFromChannels() ==\n          %#v\nExpected: %#v", err, c.expectedError)
+			t.Errorf("GetJobListFromChannels(context.TODO(), ) ==\n          %#v\nExpected: %#v", err, c.expectedError)
 		}
 	}
 }
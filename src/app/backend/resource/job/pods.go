@@ -33,35 +33,35 @@ import (
 )
 
 // GetJobPods return list of pods targeting job.
-func GetJobPods(client k8sClient.Interface, metricClient metricapi.MetricClient,
+func GetJobPods(ctx context.Context, client k8sClient.Interface, metricClient metricapi.MetricClient,
 	dsQuery *dataselect.DataSelectQuery, namespace string, jobName string) (*pod.PodList, error) {
 	log.Printf("Getting replication controller %s pods in namespace %s", jobName, namespace)
 
-	pods, err := getRawJobPods(client, jobName, namespace)
+	pods, err := getRawJobPods(ctx, client, jobName, namespace)
 	if err != nil {
 		return pod.EmptyPodList, err
 	}
 
-	events, err := event.GetPodsEvents(client, namespace, pods)
+	events, err := event.GetPodsEvents(ctx, client, namespace, pods)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return pod.EmptyPodList, criticalError
 	}
 
-	podList := pod.ToPodList(pods, events, nonCriticalErrors, dsQuery, metricClient)
+	podList := pod.ToPodList(ctx, pods, events, nonCriticalErrors, dsQuery, metricClient)
 	return &podList, nil
 }
 
 // Returns array of api pods targeting job with given name.
-func getRawJobPods(client k8sClient.Interface, petSetName, namespace string) ([]v1.Pod, error) {
-	job, err := client.BatchV1().Jobs(namespace).Get(context.TODO(), petSetName, metaV1.GetOptions{})
+func getRawJobPods(ctx context.Context, client k8sClient.Interface, petSetName, namespace string) ([]v1.Pod, error) {
+	job, err := client.BatchV1().Jobs(namespace).Get(ctx, petSetName, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	labelSelector := labels.SelectorFromSet(job.Spec.Selector.MatchLabels)
 	channels := &common.ResourceChannels{
-		PodList: common.GetPodListChannelWithOptions(client, common.NewSameNamespaceQuery(namespace),
+		PodList: common.GetPodListChannelWithOptions(ctx, client, common.NewSameNamespaceQuery(namespace),
 			metaV1.ListOptions{
 				LabelSelector: labelSelector.String(),
 				FieldSelector: fields.Everything().String(),
@@ -77,10 +77,10 @@ func getRawJobPods(client k8sClient.Interface, petSetName, namespace string) ([]
 }
 
 // Returns simple info about pods(running, desired, failing, etc.) related to given job.
-func getJobPodInfo(client k8sClient.Interface, job *batch.Job) (*common.PodInfo, error) {
+func getJobPodInfo(ctx context.Context, client k8sClient.Interface, job *batch.Job) (*common.PodInfo, error) {
 	labelSelector := labels.SelectorFromSet(job.Spec.Selector.MatchLabels)
 	channels := &common.ResourceChannels{
-		PodList: common.GetPodListChannelWithOptions(client, common.NewSameNamespaceQuery(
+		PodList: common.GetPodListChannelWithOptions(ctx, client, common.NewSameNamespaceQuery(
 			job.Namespace),
 			metaV1.ListOptions{
 				LabelSelector: labelSelector.String(),
@@ -0,0 +1,409 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package listwatch
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"sort"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+)
+
+// EventType is a type of the event pushed to the list stream.
+type EventType string
+
+const (
+	// Sync event carries the whole selected list. It is sent right after the stream is opened and every
+	// time the underlying watch had to be re-established.
+	Sync EventType = "SYNC"
+	// Added event is sent when an item enters the selected list.
+	Added EventType = EventType(watch.Added)
+	// Modified event is sent when an item that is already on the selected list changes.
+	Modified EventType = EventType(watch.Modified)
+	// Deleted event is sent when an item leaves the selected list.
+	Deleted EventType = EventType(watch.Deleted)
+)
+
+// DefaultBatchPeriod is a time during which watch events are collected before the selected list is computed
+// again. It prevents recomputing the list on every single change on busy clusters.
+const DefaultBatchPeriod = time.Second
+
+const (
+	// minRetryPeriod is a time the stream waits before listing objects again after a watch was closed.
+	minRetryPeriod = time.Second
+	// maxRetryPeriod caps the retry period that doubles every time a watch is closed shortly after it was opened.
+	maxRetryPeriod = 30 * time.Second
+)
+
+// Event is a single change of the selected list pushed to the client.
+type Event struct {
+	Type EventType `json:"type"`
+
+	// List is set only for Sync events. It has exactly the same shape as the response of regular list route.
+	List interface{} `json:"list,omitempty"`
+
+	// Object is set for Added, Modified and Deleted events. It has the same shape as a single list item.
+	Object interface{} `json:"object,omitempty"`
+
+	// ListMeta of the selected list after the change was applied.
+	ListMeta api.ListMeta `json:"listMeta"`
+}
+
+// Item is a single item of the selected list identified by UID of the object it was created from.
+type Item struct {
+	UID    types.UID
+	Object interface{}
+}
+
+// ListFunc lists objects of a single kind.
+type ListFunc func(ctx context.Context, options metaV1.ListOptions) (runtime.Object, error)
+
+// WatchFunc opens a watch on objects of a single kind.
+type WatchFunc func(ctx context.Context, options metaV1.ListOptions) (watch.Interface, error)
+
+// SelectFunc builds the list representation out of raw objects applying data select query. Related objects are
+// passed under the same keys as they were configured with. It has to return the list itself, its meta and the
+// items selected on the current page in the order they appear on the list. The context is canceled together with
+// the watch.
+type SelectFunc func(ctx context.Context, objects []runtime.Object, related map[string][]runtime.Object) (interface{},
+	api.ListMeta, []Item, error)
+
+// Related describes objects of another kind that are needed to build the selected list, e.g. pods and events
+// of deployments. They are listed and watched the same as the main objects, so the list can be computed again
+// without calling the apiserver.
+type Related struct {
+	// ListOptions are passed to both list and watch calls. They should select only the objects that the list is
+	// built from, as related objects are kept in memory for every stream.
+	ListOptions metaV1.ListOptions
+
+	ListFunc  ListFunc
+	WatchFunc WatchFunc
+}
+
+// ListWatch keeps a local copy of objects of a single kind in sync using a watch and pushes changes of the list
+// selected from them to the client.
+type ListWatch struct {
+	// NamespaceQuery is used to filter out objects that do not belong to requested namespaces. It is needed when
+	// more than one namespace is selected as objects from all namespaces are watched then.
+	NamespaceQuery *common.NamespaceQuery
+
+	// ListOptions are passed to both list and watch calls, so label and field selectors are applied by the
+	// apiserver. Limit and continue options are not supported.
+	ListOptions metaV1.ListOptions
+
+	ListFunc   ListFunc
+	WatchFunc  WatchFunc
+	SelectFunc SelectFunc
+
+	// Related objects are listed and watched with their own list options and filtered by NamespaceQuery.
+	Related map[string]Related
+
+	// BatchPeriod overrides DefaultBatchPeriod when set.
+	BatchPeriod time.Duration
+}
+
+// source keeps a local copy of objects listed and watched using the same functions.
+type source struct {
+	listFunc        ListFunc
+	watchFunc       WatchFunc
+	options         metaV1.ListOptions
+	store           map[types.UID]runtime.Object
+	resourceVersion string
+}
+
+// sourceEvent is a watch event received from given source. Closed is set when the watch of the source expired.
+type sourceEvent struct {
+	watch.Event
+	source *source
+	closed bool
+}
+
+// Stream sends selected list to the given function and keeps sending its changes until context is done or an
+// error occurs. Watch is re-established automatically when it expires. Watches that are closed shortly after
+// they were opened are re-established with exponential backoff.
+func (self *ListWatch) Stream(ctx context.Context, send func(Event) error) error {
+	retryPeriod := minRetryPeriod
+	for {
+		started := time.Now()
+		if err := self.run(ctx, send); err != nil {
+			return err
+		}
+
+		if time.Since(started) > maxRetryPeriod {
+			retryPeriod = minRetryPeriod
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(retryPeriod):
+		}
+
+		if retryPeriod *= 2; retryPeriod > maxRetryPeriod {
+			retryPeriod = maxRetryPeriod
+		}
+	}
+}
+
+func (self *ListWatch) run(ctx context.Context, send func(Event) error) error {
+	// Canceling the context stops all watches opened by this run.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	main := &source{listFunc: self.ListFunc, watchFunc: self.WatchFunc, options: self.ListOptions}
+	sources := []*source{main}
+	related := make(map[string]*source, len(self.Related))
+	for name, r := range self.Related {
+		related[name] = &source{listFunc: r.ListFunc, watchFunc: r.WatchFunc, options: r.ListOptions}
+		sources = append(sources, related[name])
+	}
+
+	for _, s := range sources {
+		if err := self.list(ctx, s); err != nil {
+			return err
+		}
+	}
+
+	selected, err := self.sync(ctx, main, related, send)
+	if err != nil {
+		return err
+	}
+
+	events := make(chan sourceEvent)
+	for _, s := range sources {
+		if err := self.watch(ctx, s, events); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(self.batchPeriod())
+	defer ticker.Stop()
+
+	changed := false
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			if event.closed {
+				// Watch has expired. Returning without an error makes the stream list the objects again.
+				return nil
+			}
+
+			if event.Type == watch.Error {
+				log.Printf("Watch closed with an error, listing objects again: %v", k8serrors.FromObject(event.Object))
+				return nil
+			}
+
+			changed = self.update(event.source.store, event.Type, event.Object) || changed
+		case <-ticker.C:
+			if !changed {
+				continue
+			}
+
+			changed = false
+			if selected, err = self.diff(ctx, main, related, selected, send); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (self *ListWatch) batchPeriod() time.Duration {
+	if self.BatchPeriod > 0 {
+		return self.BatchPeriod
+	}
+
+	return DefaultBatchPeriod
+}
+
+// list fills the store of given source with listed objects.
+func (self *ListWatch) list(ctx context.Context, s *source) error {
+	list, err := s.listFunc(ctx, s.options)
+	if err != nil {
+		return err
+	}
+
+	objects, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	listAccessor, err := meta.ListAccessor(list)
+	if err != nil {
+		return err
+	}
+
+	s.store = make(map[types.UID]runtime.Object)
+	s.resourceVersion = listAccessor.GetResourceVersion()
+	for _, object := range objects {
+		self.update(s.store, watch.Added, object)
+	}
+
+	return nil
+}
+
+// watch opens a watch on given source and forwards its events until the watch expires or context is done.
+func (self *ListWatch) watch(ctx context.Context, s *source, events chan<- sourceEvent) error {
+	options := s.options
+	options.ResourceVersion = s.resourceVersion
+	watcher, err := s.watchFunc(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer watcher.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.ResultChan():
+				select {
+				case events <- sourceEvent{Event: event, source: s, closed: !ok}:
+				case <-ctx.Done():
+					return
+				}
+
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// update applies a single watch event to the store and returns true if the store was changed.
+func (self *ListWatch) update(store map[types.UID]runtime.Object, eventType watch.EventType,
+	object runtime.Object) bool {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		log.Printf("Skipping object that does not have object meta: %v", err)
+		return false
+	}
+
+	if self.NamespaceQuery != nil && !self.NamespaceQuery.Matches(accessor.GetNamespace()) {
+		return false
+	}
+
+	switch eventType {
+	case watch.Added, watch.Modified:
+		store[accessor.GetUID()] = object
+		return true
+	case watch.Deleted:
+		delete(store, accessor.GetUID())
+		return true
+	default:
+		return false
+	}
+}
+
+func (self *ListWatch) sync(ctx context.Context, main *source, related map[string]*source,
+	send func(Event) error) ([]Item, error) {
+	list, listMeta, items, err := self.SelectFunc(ctx, sortedObjects(main.store), relatedObjects(related))
+	if err != nil {
+		return nil, err
+	}
+
+	return items, send(Event{Type: Sync, List: list, ListMeta: listMeta})
+}
+
+func (self *ListWatch) diff(ctx context.Context, main *source, related map[string]*source, previous []Item,
+	send func(Event) error) ([]Item, error) {
+	_, listMeta, current, err := self.SelectFunc(ctx, sortedObjects(main.store), relatedObjects(related))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range diff(previous, current) {
+		event.ListMeta = listMeta
+		if err := send(event); err != nil {
+			return nil, err
+		}
+	}
+
+	return current, nil
+}
+
+// diff returns events that transform previous list items into current list items.
+func diff(previous, current []Item) []Event {
+	events := make([]Event, 0)
+	previousByUID := make(map[types.UID]Item, len(previous))
+	for _, item := range previous {
+		previousByUID[item.UID] = item
+	}
+
+	currentByUID := make(map[types.UID]Item, len(current))
+	for _, item := range current {
+		currentByUID[item.UID] = item
+		old, exists := previousByUID[item.UID]
+		if !exists {
+			events = append(events, Event{Type: Added, Object: item.Object})
+		} else if !reflect.DeepEqual(old.Object, item.Object) {
+			events = append(events, Event{Type: Modified, Object: item.Object})
+		}
+	}
+
+	for _, item := range previous {
+		if _, exists := currentByUID[item.UID]; !exists {
+			events = append(events, Event{Type: Deleted, Object: item.Object})
+		}
+	}
+
+	return events
+}
+
+// sortedObjects returns objects from the store in the same order as apiserver lists them, so the lists without
+// any sort applied are stable between changes.
+func sortedObjects(store map[types.UID]runtime.Object) []runtime.Object {
+	objects := make([]runtime.Object, 0, len(store))
+	for _, object := range store {
+		objects = append(objects, object)
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		a, _ := meta.Accessor(objects[i])
+		b, _ := meta.Accessor(objects[j])
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+
+	return objects
+}
+
+// relatedObjects returns objects from the stores of related sources under the same keys.
+func relatedObjects(related map[string]*source) map[string][]runtime.Object {
+	result := make(map[string][]runtime.Object, len(related))
+	for name, s := range related {
+		result[name] = sortedObjects(s.store)
+	}
+
+	return result
+}
@@ -0,0 +1,188 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package listwatch
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+)
+
+func TestDiff(t *testing.T) {
+	cases := []struct {
+		info     string
+		previous []Item
+		current  []Item
+		expected []Event
+	}{
+		{
+			"no changes",
+			[]Item{{UID: "a", Object: "a-1"}},
+			[]Item{{UID: "a", Object: "a-1"}},
+			[]Event{},
+		},
+		{
+			"item added",
+			[]Item{{UID: "a", Object: "a-1"}},
+			[]Item{{UID: "a", Object: "a-1"}, {UID: "b", Object: "b-1"}},
+			[]Event{{Type: Added, Object: "b-1"}},
+		},
+		{
+			"item modified",
+			[]Item{{UID: "a", Object: "a-1"}},
+			[]Item{{UID: "a", Object: "a-2"}},
+			[]Event{{Type: Modified, Object: "a-2"}},
+		},
+		{
+			"item moved out of the page",
+			[]Item{{UID: "a", Object: "a-1"}, {UID: "b", Object: "b-1"}},
+			[]Item{{UID: "c", Object: "c-1"}, {UID: "a", Object: "a-1"}},
+			[]Event{{Type: Added, Object: "c-1"}, {Type: Deleted, Object: "b-1"}},
+		},
+	}
+
+	for _, c := range cases {
+		actual := diff(c.previous, c.current)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("diff(%#v, %#v) == \ngot %#v, \nexpected %#v (%s)", c.previous, c.current, actual,
+				c.expected, c.info)
+		}
+	}
+}
+
+func TestListWatchRelated(t *testing.T) {
+	lists := 0
+	relatedOptions := make([]metaV1.ListOptions, 0)
+	podWatcher := watch.NewFake()
+	eventWatcher := watch.NewFake()
+	listWatch := &ListWatch{
+		ListFunc: func(ctx context.Context, options metaV1.ListOptions) (runtime.Object, error) {
+			lists++
+			return &v1.PodList{Items: []v1.Pod{{ObjectMeta: metaV1.ObjectMeta{Name: "pod-1", UID: "pod-1"}}}}, nil
+		},
+		WatchFunc: func(ctx context.Context, options metaV1.ListOptions) (watch.Interface, error) {
+			return podWatcher, nil
+		},
+		Related: map[string]Related{
+			"events": {
+				ListOptions: metaV1.ListOptions{FieldSelector: "type=Warning"},
+				ListFunc: func(ctx context.Context, options metaV1.ListOptions) (runtime.Object, error) {
+					lists++
+					relatedOptions = append(relatedOptions, options)
+					return &v1.EventList{}, nil
+				},
+				WatchFunc: func(ctx context.Context, options metaV1.ListOptions) (watch.Interface, error) {
+					relatedOptions = append(relatedOptions, options)
+					return eventWatcher, nil
+				},
+			},
+		},
+		SelectFunc: func(ctx context.Context, objects []runtime.Object, related map[string][]runtime.Object) (
+			interface{}, api.ListMeta, []Item, error) {
+			items := make([]Item, len(objects))
+			for i, object := range objects {
+				pod := object.(*v1.Pod)
+				items[i] = Item{UID: pod.UID, Object: len(related["events"])}
+			}
+
+			return nil, api.ListMeta{TotalItems: len(items)}, items, nil
+		},
+		BatchPeriod: 10 * time.Millisecond,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := make(chan Event)
+	done := make(chan error)
+	go func() {
+		done <- listWatch.Stream(ctx, func(event Event) error {
+			events <- event
+			return nil
+		})
+	}()
+
+	if sync := <-events; sync.Type != Sync {
+		t.Fatalf("Expected sync event, got %#v", sync)
+	}
+
+	eventWatcher.Add(&v1.Event{ObjectMeta: metaV1.ObjectMeta{Name: "event-1", UID: "event-1"}})
+	modified := <-events
+	if modified.Type != Modified || modified.Object != 1 {
+		t.Fatalf("Expected pod to be modified by related event, got %#v", modified)
+	}
+
+	if lists != 2 {
+		t.Errorf("Expected objects to be listed only once per kind, got %d lists", lists)
+	}
+
+	for _, options := range relatedOptions {
+		if options.FieldSelector != "type=Warning" {
+			t.Errorf("Expected related objects to be listed and watched with their options, got %#v", options)
+		}
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Expected stream to finish without error, got %s", err)
+	}
+}
+
+func TestNewRelated(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	nsQuery := common.NewSameNamespaceQuery("default")
+	cases := []struct {
+		info     string
+		related  Related
+		expected metaV1.ListOptions
+	}{
+		{
+			"pods with label key",
+			NewRelatedPods(client, nsQuery, "pod-template-hash"),
+			metaV1.ListOptions{LabelSelector: "pod-template-hash"},
+		},
+		{
+			"all pods",
+			NewRelatedPods(client, nsQuery, ""),
+			metaV1.ListOptions{},
+		},
+		{
+			"warning events of pods",
+			NewRelatedEvents(client, nsQuery),
+			metaV1.ListOptions{FieldSelector: "involvedObject.kind=Pod,type=Warning"},
+		},
+		{
+			"replica sets of deployments",
+			NewRelatedReplicaSets(client, nsQuery),
+			metaV1.ListOptions{LabelSelector: "pod-template-hash"},
+		},
+	}
+
+	for _, c := range cases {
+		if !reflect.DeepEqual(c.related.ListOptions, c.expected) {
+			t.Errorf("Test Case: %s. Expected list options %#v, got %#v", c.info, c.expected, c.related.ListOptions)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package listwatch
+
+import (
+	"context"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+)
+
+// Keys of related objects shared by list watches of workloads.
+const (
+	RelatedPods        = "pods"
+	RelatedEvents      = "events"
+	RelatedReplicaSets = "replicasets"
+)
+
+// relatedEventsSelector selects warning events of pods.
+var relatedEventsSelector = fields.AndSelectors(
+	fields.OneTermEqualSelector("involvedObject.kind", "Pod"),
+	fields.OneTermEqualSelector("type", v1.EventTypeWarning),
+)
+
+// NewRelatedPods returns related Pods from namespaces selected by given query. Pods of controllers that label
+// them with a key, that is set on all of their pods, can be limited to pods having the label. Empty label key
+// selects all pods.
+func NewRelatedPods(client kubernetes.Interface, nsQuery *common.NamespaceQuery, labelKey string) Related {
+	return Related{
+		ListOptions: metaV1.ListOptions{LabelSelector: labelKey},
+		ListFunc: func(ctx context.Context, options metaV1.ListOptions) (runtime.Object, error) {
+			return client.CoreV1().Pods(nsQuery.ToRequestParam()).List(ctx, options)
+		},
+		WatchFunc: func(ctx context.Context, options metaV1.ListOptions) (watch.Interface, error) {
+			return client.CoreV1().Pods(nsQuery.ToRequestParam()).Watch(ctx, options)
+		},
+	}
+}
+
+// NewRelatedEvents returns related Events from namespaces selected by given query. Lists use events only to show
+// warnings of their pods, so only warning events of pods are selected.
+func NewRelatedEvents(client kubernetes.Interface, nsQuery *common.NamespaceQuery) Related {
+	return Related{
+		ListOptions: metaV1.ListOptions{FieldSelector: relatedEventsSelector.String()},
+		ListFunc: func(ctx context.Context, options metaV1.ListOptions) (runtime.Object, error) {
+			return client.CoreV1().Events(nsQuery.ToRequestParam()).List(ctx, options)
+		},
+		WatchFunc: func(ctx context.Context, options metaV1.ListOptions) (watch.Interface, error) {
+			return client.CoreV1().Events(nsQuery.ToRequestParam()).Watch(ctx, options)
+		},
+	}
+}
+
+// NewRelatedReplicaSets returns related Replica Sets from namespaces selected by given query. Only Replica Sets
+// created by Deployments are selected.
+func NewRelatedReplicaSets(client kubernetes.Interface, nsQuery *common.NamespaceQuery) Related {
+	return Related{
+		ListOptions: metaV1.ListOptions{LabelSelector: apps.DefaultDeploymentUniqueLabelKey},
+		ListFunc: func(ctx context.Context, options metaV1.ListOptions) (runtime.Object, error) {
+			return client.AppsV1().ReplicaSets(nsQuery.ToRequestParam()).List(ctx, options)
+		},
+		WatchFunc: func(ctx context.Context, options metaV1.ListOptions) (watch.Interface, error) {
+			return client.AppsV1().ReplicaSets(nsQuery.ToRequestParam()).Watch(ctx, options)
+		},
+	}
+}
+
+// PodListChannel returns a channel that can be read once and holds Pods built from given objects.
+func PodListChannel(objects []runtime.Object) common.PodListChannel {
+	list := &v1.PodList{}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object.(*v1.Pod))
+	}
+
+	channel := common.PodListChannel{List: make(chan *v1.PodList, 1), Error: make(chan error, 1)}
+	channel.List <- list
+	channel.Error <- nil
+	return channel
+}
+
+// EventListChannel returns a channel that can be read once and holds Events built from given objects.
+func EventListChannel(objects []runtime.Object) common.EventListChannel {
+	list := &v1.EventList{}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object.(*v1.Event))
+	}
+
+	channel := common.EventListChannel{List: make(chan *v1.EventList, 1), Error: make(chan error, 1)}
+	channel.List <- list
+	channel.Error <- nil
+	return channel
+}
+
+// ReplicaSetListChannel returns a channel that can be read once and holds Replica Sets built from given objects.
+func ReplicaSetListChannel(objects []runtime.Object) common.ReplicaSetListChannel {
+	list := &apps.ReplicaSetList{}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object.(*apps.ReplicaSet))
+	}
+
+	channel := common.ReplicaSetListChannel{List: make(chan *apps.ReplicaSetList, 1), Error: make(chan error, 1)}
+	channel.List <- list
+	channel.Error <- nil
+	return channel
+}
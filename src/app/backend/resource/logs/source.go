@@ -25,16 +25,16 @@ import (
 )
 
 // GetLogSources returns all log sources for a given resource. A log source identifies a log file through the combination of pod & container
-func GetLogSources(k8sClient kubernetes.Interface, ns string, resourceName string, resourceType string) (controller.LogSources, error) {
+func GetLogSources(ctx context.Context, k8sClient kubernetes.Interface, ns string, resourceName string, resourceType string) (controller.LogSources, error) {
 	if resourceType == "pod" {
-		return getLogSourcesFromPod(k8sClient, ns, resourceName)
+		return getLogSourcesFromPod(ctx, k8sClient, ns, resourceName)
 	}
-	return getLogSourcesFromController(k8sClient, ns, resourceName, resourceType)
+	return getLogSourcesFromController(ctx, k8sClient, ns, resourceName, resourceType)
 }
 
 // GetLogSourcesFromPod returns all containers for a given pod
-func getLogSourcesFromPod(k8sClient kubernetes.Interface, ns, resourceName string) (controller.LogSources, error) {
-	pod, err := k8sClient.CoreV1().Pods(ns).Get(context.TODO(), resourceName, meta.GetOptions{})
+func getLogSourcesFromPod(ctx context.Context, k8sClient kubernetes.Interface, ns, resourceName string) (controller.LogSources, error) {
+	pod, err := k8sClient.CoreV1().Pods(ns).Get(ctx, resourceName, meta.GetOptions{})
 	if err != nil {
 		return controller.LogSources{}, err
 	}
@@ -46,13 +46,13 @@ func getLogSourcesFromPod(k8sClient kubernetes.Interface, ns, resourceName strin
 }
 
 // GetLogSourcesFromController returns all pods and containers for a controller object, such as ReplicaSet
-func getLogSourcesFromController(k8sClient kubernetes.Interface, ns, resourceName, resourceType string) (controller.LogSources, error) {
+func getLogSourcesFromController(ctx context.Context, k8sClient kubernetes.Interface, ns, resourceName, resourceType string) (controller.LogSources, error) {
 	ref := meta.OwnerReference{Kind: resourceType, Name: resourceName}
-	rc, err := controller.NewResourceController(ref, ns, k8sClient)
+	rc, err := controller.NewResourceController(ctx, ref, ns, k8sClient)
 	if err != nil {
 		return controller.LogSources{}, err
 	}
-	allPods, err := k8sClient.CoreV1().Pods(ns).List(context.TODO(), api.ListEverything)
+	allPods, err := k8sClient.CoreV1().Pods(ns).List(ctx, api.ListEverything)
 	if err != nil {
 		return controller.LogSources{}, err
 	}
@@ -31,7 +31,7 @@ type NamespaceSpec struct {
 }
 
 // CreateNamespace creates namespace based on given specification.
-func CreateNamespace(spec *NamespaceSpec, client kubernetes.Interface) error {
+func CreateNamespace(ctx context.Context, spec *NamespaceSpec, client kubernetes.Interface) error {
 	log.Printf("Creating namespace %s", spec.Name)
 
 	namespace := &api.Namespace{
@@ -40,7 +40,7 @@ func CreateNamespace(spec *NamespaceSpec, client kubernetes.Interface) error {
 		},
 	}
 
-	_, err := client.CoreV1().Namespaces().Create(context.TODO(), namespace, metaV1.CreateOptions{})
+	_, err := client.CoreV1().Namespaces().Create(ctx, namespace, metaV1.CreateOptions{})
 	return err
 }
 
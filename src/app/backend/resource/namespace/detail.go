@@ -44,21 +44,21 @@ type NamespaceDetail struct {
 }
 
 // GetNamespaceDetail gets namespace details.
-func GetNamespaceDetail(client k8sClient.Interface, name string) (*NamespaceDetail, error) {
+func GetNamespaceDetail(ctx context.Context, client k8sClient.Interface, name string) (*NamespaceDetail, error) {
 	log.Printf("Getting details of %s namespace\n", name)
 
-	namespace, err := client.CoreV1().Namespaces().Get(context.TODO(), name, metaV1.GetOptions{})
+	namespace, err := client.CoreV1().Namespaces().Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	resourceQuotaList, err := getResourceQuotas(client, *namespace)
+	resourceQuotaList, err := getResourceQuotas(ctx, client, *namespace)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	resourceLimits, err := getLimitRanges(client, *namespace)
+	resourceLimits, err := getLimitRanges(ctx, client, *namespace)
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return nil, criticalError
@@ -79,8 +79,8 @@ func toNamespaceDetail(namespace v1.Namespace, resourceQuotaList *rq.ResourceQuo
 	}
 }
 
-func getResourceQuotas(client k8sClient.Interface, namespace v1.Namespace) (*rq.ResourceQuotaDetailList, error) {
-	list, err := client.CoreV1().ResourceQuotas(namespace.Name).List(context.TODO(), api.ListEverything)
+func getResourceQuotas(ctx context.Context, client k8sClient.Interface, namespace v1.Namespace) (*rq.ResourceQuotaDetailList, error) {
+	list, err := client.CoreV1().ResourceQuotas(namespace.Name).List(ctx, api.ListEverything)
 
 	result := &rq.ResourceQuotaDetailList{
 		Items:    make([]rq.ResourceQuotaDetail, 0),
@@ -95,8 +95,8 @@ func getResourceQuotas(client k8sClient.Interface, namespace v1.Namespace) (*rq.
 	return result, err
 }
 
-func getLimitRanges(client k8sClient.Interface, namespace v1.Namespace) ([]limitrange.LimitRangeItem, error) {
-	list, err := client.CoreV1().LimitRanges(namespace.Name).List(context.TODO(), api.ListEverything)
+func getLimitRanges(ctx context.Context, client k8sClient.Interface, namespace v1.Namespace) ([]limitrange.LimitRangeItem, error) {
+	list, err := client.CoreV1().LimitRanges(namespace.Name).List(ctx, api.ListEverything)
 	if err != nil {
 		return nil, err
 	}
@@ -34,10 +34,10 @@ type NetworkPolicyDetail struct {
 }
 
 // GetNetworkPolicyDetail returns detailed information about a network policy.
-func GetNetworkPolicyDetail(client client.Interface, namespace, name string) (*NetworkPolicyDetail, error) {
+func GetNetworkPolicyDetail(ctx context.Context, client client.Interface, namespace, name string) (*NetworkPolicyDetail, error) {
 	log.Printf("Getting details of %s network policy in %s namespace", name, namespace)
 
-	raw, err := client.NetworkingV1().NetworkPolicies(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	raw, err := client.NetworkingV1().NetworkPolicies(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -41,17 +41,19 @@ type NetworkPolicyList struct {
 }
 
 // GetNetworkPolicyList lists network policies from given namespace using given data select query.
-func GetNetworkPolicyList(client client.Interface, namespace *common.NamespaceQuery,
+func GetNetworkPolicyList(ctx context.Context, client client.Interface, namespace *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery) (*NetworkPolicyList, error) {
-	saList, err := client.NetworkingV1().NetworkPolicies(namespace.ToRequestParam()).List(context.TODO(),
-		api.ListEverything)
+	saList, err := client.NetworkingV1().NetworkPolicies(namespace.ToRequestParam()).List(ctx,
+		dsQuery.ListOptions())
 
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	return toNetworkPolicyList(saList.Items, nonCriticalErrors, dsQuery), nil
+	result := toNetworkPolicyList(saList.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, saList)
+	return result, nil
 }
 
 func toNetworkPolicy(sa *v1.NetworkPolicy) NetworkPolicy {
@@ -15,6 +15,8 @@
 package node
 
 import (
+	"log"
+
 	"github.com/kubernetes/dashboard/src/app/backend/api"
 	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
@@ -60,6 +62,24 @@ func (self NodeCell) GetResourceSelector() *metricapi.ResourceSelector {
 	}
 }
 
+// nodeAllocationCell is a NodeCell that also exposes resources allocated on the node, so nodes can be sorted and
+// filtered by them. Allocation is a fraction of node allocatable resources requested by its pods.
+type nodeAllocationCell struct {
+	NodeCell
+	allocatedResources NodeAllocatedResources
+}
+
+func (self nodeAllocationCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.AllocatedCPUProperty:
+		return dataselect.StdComparableFloat(self.allocatedResources.CPURequestsFraction)
+	case dataselect.AllocatedMemoryProperty:
+		return dataselect.StdComparableFloat(self.allocatedResources.MemoryRequestsFraction)
+	default:
+		return self.NodeCell.GetProperty(name)
+	}
+}
+
 func toCells(std []v1.Node) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -71,11 +91,35 @@ func toCells(std []v1.Node) []dataselect.DataCell {
 func fromCells(cells []dataselect.DataCell) []v1.Node {
 	std := make([]v1.Node, len(cells))
 	for i := range std {
-		std[i] = v1.Node(cells[i].(NodeCell))
+		switch cell := cells[i].(type) {
+		case nodeAllocationCell:
+			std[i] = v1.Node(cell.NodeCell)
+		default:
+			std[i] = v1.Node(cell.(NodeCell))
+		}
 	}
 	return std
 }
 
+// toAllocationCells returns data cells of given nodes with resources allocated on them by given pods.
+func toAllocationCells(std []v1.Node, podsByNode map[string]*v1.PodList) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		pods, exists := podsByNode[std[i].Name]
+		if !exists {
+			pods = &v1.PodList{}
+		}
+
+		allocatedResources, err := getNodeAllocatedResources(std[i], pods)
+		if err != nil {
+			log.Printf("Couldn't get allocated resources of %s node: %s\n", std[i].Name, err)
+		}
+
+		cells[i] = nodeAllocationCell{NodeCell: NodeCell(std[i]), allocatedResources: allocatedResources}
+	}
+	return cells
+}
+
 func getNodeConditions(node v1.Node) []common.Condition {
 	var conditions []common.Condition
 	for _, condition := range node.Status.Conditions {
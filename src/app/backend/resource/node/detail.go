@@ -124,34 +124,34 @@ type NodeDetail struct {
 }
 
 // GetNodeDetail gets node details.
-func GetNodeDetail(client k8sClient.Interface, metricClient metricapi.MetricClient, name string,
+func GetNodeDetail(ctx context.Context, client k8sClient.Interface, metricClient metricapi.MetricClient, name string,
 	dsQuery *dataselect.DataSelectQuery) (*NodeDetail, error) {
 	log.Printf("Getting details of %s node", name)
 
-	node, err := client.CoreV1().Nodes().Get(context.TODO(), name, metaV1.GetOptions{})
+	node, err := client.CoreV1().Nodes().Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	// Download standard metrics. Currently metrics are hard coded, but it is possible to replace
 	// dataselect.StdMetricsDataSelect with data select provided in the request.
-	_, metricPromises := dataselect.GenericDataSelectWithMetrics(toCells([]v1.Node{*node}),
+	_, metricPromises := dataselect.GenericDataSelectWithMetrics(ctx, toCells([]v1.Node{*node}),
 		dsQuery,
 		metricapi.NoResourceCache, metricClient)
 
-	pods, err := getNodePods(client, *node)
+	pods, err := getNodePods(ctx, client, *node)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	podList, err := GetNodePods(client, metricClient, dsQuery, name)
+	podList, err := GetNodePods(ctx, client, metricClient, dsQuery, name)
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	eventList, err := event.GetNodeEvents(client, dsQuery, node.Name)
+	eventList, err := event.GetNodeEvents(ctx, client, dsQuery, node.Name)
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return nil, criticalError
@@ -290,36 +290,36 @@ func maxResourceList(list, new v1.ResourceList) {
 }
 
 // GetNodePods return pods list in given named node
-func GetNodePods(client k8sClient.Interface, metricClient metricapi.MetricClient,
+func GetNodePods(ctx context.Context, client k8sClient.Interface, metricClient metricapi.MetricClient,
 	dsQuery *dataselect.DataSelectQuery, name string) (*pod.PodList, error) {
 	podList := pod.PodList{
 		Pods:              []pod.Pod{},
 		CumulativeMetrics: []metricapi.Metric{},
 	}
 
-	node, err := client.CoreV1().Nodes().Get(context.TODO(), name, metaV1.GetOptions{})
+	node, err := client.CoreV1().Nodes().Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return &podList, err
 	}
 
-	pods, err := getNodePods(client, *node)
+	pods, err := getNodePods(ctx, client, *node)
 	podNonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return &podList, criticalError
 	}
 
-	events, err := event.GetPodsEvents(client, v1.NamespaceAll, pods.Items)
+	events, err := event.GetPodsEvents(ctx, client, v1.NamespaceAll, pods.Items)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return &podList, criticalError
 	}
 
 	nonCriticalErrors = append(nonCriticalErrors, podNonCriticalErrors...)
-	podList = pod.ToPodList(pods.Items, events, nonCriticalErrors, dsQuery, metricClient)
+	podList = pod.ToPodList(ctx, pods.Items, events, nonCriticalErrors, dsQuery, metricClient)
 	return &podList, nil
 }
 
-func getNodePods(client k8sClient.Interface, node v1.Node) (*v1.PodList, error) {
+func getNodePods(ctx context.Context, client k8sClient.Interface, node v1.Node) (*v1.PodList, error) {
 	fieldSelector, err := fields.ParseSelector("spec.nodeName=" + node.Name +
 		",status.phase!=" + string(v1.PodSucceeded) +
 		",status.phase!=" + string(v1.PodFailed))
@@ -328,11 +328,40 @@ func getNodePods(client k8sClient.Interface, node v1.Node) (*v1.PodList, error)
 		return nil, err
 	}
 
-	return client.CoreV1().Pods(v1.NamespaceAll).List(context.TODO(), metaV1.ListOptions{
+	return client.CoreV1().Pods(v1.NamespaceAll).List(ctx, metaV1.ListOptions{
 		FieldSelector: fieldSelector.String(),
 	})
 }
 
+// getPodsByNode returns pods that are not terminated grouped by names of nodes they run on. Nodes without pods
+// are not present in the map.
+func getPodsByNode(ctx context.Context, client k8sClient.Interface) (map[string]*v1.PodList, error) {
+	fieldSelector, err := fields.ParseSelector("spec.nodeName!=," +
+		"status.phase!=" + string(v1.PodSucceeded) +
+		",status.phase!=" + string(v1.PodFailed))
+
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := client.CoreV1().Pods(v1.NamespaceAll).List(ctx, metaV1.ListOptions{
+		FieldSelector: fieldSelector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	podsByNode := make(map[string]*v1.PodList)
+	for _, pod := range pods.Items {
+		if _, exists := podsByNode[pod.Spec.NodeName]; !exists {
+			podsByNode[pod.Spec.NodeName] = &v1.PodList{}
+		}
+		podsByNode[pod.Spec.NodeName].Items = append(podsByNode[pod.Spec.NodeName].Items, pod)
+	}
+
+	return podsByNode, nil
+}
+
 func toNodeDetail(node v1.Node, pods *pod.PodList, eventList *common.EventList,
 	allocatedResources NodeAllocatedResources, metrics []metricapi.Metric, nonCriticalErrors []error) NodeDetail {
 	return NodeDetail{
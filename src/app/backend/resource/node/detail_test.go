@@ -15,6 +15,7 @@
 package node
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -85,7 +86,7 @@ func TestGetNodeDetail(t *testing.T) {
 		fakeClient := fake.NewSimpleClientset(c.node)
 
 		dataselect.StdMetricsDataSelect.MetricQuery = dataselect.NoMetrics
-		actual, _ := GetNodeDetail(fakeClient, nil, c.name, dataselect.NoDataSelect)
+		actual, _ := GetNodeDetail(context.TODO(), fakeClient, nil, c.name, dataselect.NoDataSelect)
 
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetNodeDetail(client,metricClient,%#v, %#v) == \ngot: %#v, \nexpected %#v",
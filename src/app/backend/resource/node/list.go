@@ -47,18 +47,20 @@ type Node struct {
 }
 
 // GetNodeList returns a list of all Nodes in the cluster.
-func GetNodeList(client client.Interface, dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*NodeList, error) {
-	nodes, err := client.CoreV1().Nodes().List(context.TODO(), api.ListEverything)
+func GetNodeList(ctx context.Context, client client.Interface, dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*NodeList, error) {
+	nodes, err := client.CoreV1().Nodes().List(ctx, dsQuery.ListOptions())
 
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	return toNodeList(client, nodes.Items, nonCriticalErrors, dsQuery, metricClient), nil
+	result := toNodeList(ctx, client, nodes.Items, nonCriticalErrors, dsQuery, metricClient)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, nodes)
+	return result, nil
 }
 
-func toNodeList(client client.Interface, nodes []v1.Node, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery,
+func toNodeList(ctx context.Context, client client.Interface, nodes []v1.Node, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery,
 	metricClient metricapi.MetricClient) *NodeList {
 	nodeList := &NodeList{
 		Nodes:    make([]Node, 0),
@@ -66,13 +68,26 @@ func toNodeList(client client.Interface, nodes []v1.Node, nonCriticalErrors []er
 		Errors:   nonCriticalErrors,
 	}
 
-	nodeCells, metricPromises, filteredTotal := dataselect.GenericDataSelectWithFilterAndMetrics(toCells(nodes),
+	cells := toCells(nodes)
+	var podsByNode map[string]*v1.PodList
+	if dsQuery.UsesProperty(dataselect.AllocatedCPUProperty) ||
+		dsQuery.UsesProperty(dataselect.AllocatedMemoryProperty) {
+		// Allocated resources of all nodes are needed before sorting, so pods of all nodes are listed at once.
+		var err error
+		if podsByNode, err = getPodsByNode(ctx, client); err != nil {
+			log.Printf("Couldn't get pods of nodes: %s\n", err)
+		} else {
+			cells = toAllocationCells(nodes, podsByNode)
+		}
+	}
+
+	nodeCells, metricPromises, filteredTotal := dataselect.GenericDataSelectWithFilterAndMetrics(ctx, cells,
 		dsQuery, metricapi.NoResourceCache, metricClient)
 	nodes = fromCells(nodeCells)
 	nodeList.ListMeta = api.ListMeta{TotalItems: filteredTotal}
 
 	for _, node := range nodes {
-		pods, err := getNodePods(client, node)
+		pods, err := getPodsOfNode(ctx, client, node, podsByNode)
 		if err != nil {
 			log.Printf("Couldn't get pods of %s node: %s\n", node.Name, err)
 		}
@@ -89,6 +104,20 @@ func toNodeList(client client.Interface, nodes []v1.Node, nonCriticalErrors []er
 	return nodeList
 }
 
+// getPodsOfNode returns pods of given node from pods grouped by nodes if they were already listed and lists them
+// otherwise.
+func getPodsOfNode(ctx context.Context, client client.Interface, node v1.Node, podsByNode map[string]*v1.PodList) (*v1.PodList, error) {
+	if podsByNode == nil {
+		return getNodePods(ctx, client, node)
+	}
+
+	if pods, exists := podsByNode[node.Name]; exists {
+		return pods, nil
+	}
+
+	return &v1.PodList{}, nil
+}
+
 func toNode(node v1.Node, pods *v1.PodList) Node {
 	allocatedResources, err := getNodeAllocatedResources(node, pods)
 	if err != nil {
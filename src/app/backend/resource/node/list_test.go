@@ -15,6 +15,7 @@
 package node
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -22,6 +23,7 @@ import (
 	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 )
@@ -71,9 +73,62 @@ func TestGetNodeList(t *testing.T) {
 
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.node)
-		actual, _ := GetNodeList(fakeClient, dataselect.NoDataSelect, nil)
+		actual, _ := GetNodeList(context.TODO(), fakeClient, dataselect.NoDataSelect, nil)
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetNodeList() == \ngot: %#v, \nexpected %#v", actual, c.expected)
 		}
 	}
 }
+
+func TestGetNodeListSortedByAllocatedCPU(t *testing.T) {
+	newNode := func(name string) *v1.Node {
+		return &v1.Node{
+			ObjectMeta: metaV1.ObjectMeta{Name: name},
+			Status: v1.NodeStatus{Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("1"),
+				v1.ResourceMemory: resource.MustParse("1Gi"),
+			}},
+		}
+	}
+	newPod := func(name, nodeName, cpu string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: v1.PodSpec{
+				NodeName: nodeName,
+				Containers: []v1.Container{{
+					Name: "container",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+					},
+				}},
+			},
+		}
+	}
+
+	fakeClient := fake.NewSimpleClientset(newNode("node-a"), newNode("node-b"), newNode("node-c"),
+		newPod("pod-1", "node-a", "200m"), newPod("pod-2", "node-b", "500m"), newPod("pod-3", "node-b", "100m"))
+	dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination,
+		dataselect.NewSortQuery([]string{"d", dataselect.AllocatedCPUProperty}), dataselect.NoFilter,
+		dataselect.NoMetrics)
+
+	actual, err := GetNodeList(context.TODO(), fakeClient, dsQuery, nil)
+	if err != nil {
+		t.Fatalf("GetNodeList() returned unexpected error: %s", err.Error())
+	}
+
+	expected := []struct {
+		name        string
+		cpuRequests int64
+	}{{"node-b", 600}, {"node-a", 200}, {"node-c", 0}}
+	if len(actual.Nodes) != len(expected) {
+		t.Fatalf("GetNodeList() returned %d nodes, expected %d", len(actual.Nodes), len(expected))
+	}
+
+	for i, e := range expected {
+		node := actual.Nodes[i]
+		if node.ObjectMeta.Name != e.name || node.AllocatedResources.CPURequests != e.cpuRequests {
+			t.Errorf("GetNodeList() returned node %s with %d CPU requests at index %d, expected %s with %d",
+				node.ObjectMeta.Name, node.AllocatedResources.CPURequests, i, e.name, e.cpuRequests)
+		}
+	}
+}
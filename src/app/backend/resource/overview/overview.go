@@ -0,0 +1,116 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"context"
+	"log"
+
+	client "k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/configmap"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/ingress"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/persistentvolumeclaim"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/secret"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/service"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/workload"
+)
+
+// Overview contains the first page of every list shown on the namespace overview: workloads,
+// service discovery and configuration and storage resources.
+type Overview struct {
+	// Workloads together with their status summaries and cumulative metrics.
+	*workload.Workloads `json:",inline"`
+
+	// Service discovery and load balancing.
+	ServiceList *service.ServiceList `json:"serviceList"`
+	IngressList *ingress.IngressList `json:"ingressList"`
+
+	// Config and storage.
+	ConfigMapList             *configmap.ConfigMapList                         `json:"configMapList"`
+	SecretList                *secret.SecretList                               `json:"secretList"`
+	PersistentVolumeClaimList *persistentvolumeclaim.PersistentVolumeClaimList `json:"persistentVolumeClaimList"`
+}
+
+// GetOverview returns the first page of every list shown on the namespace overview.
+func GetOverview(ctx context.Context, client client.Interface, metricClient metricapi.MetricClient, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*Overview, error) {
+	log.Print("Getting overview of all resources")
+
+	channels := workload.GetWorkloadChannels(ctx, client, nsQuery)
+	channels.ServiceList = common.GetServiceListChannel(ctx, client, nsQuery, 1)
+	channels.IngressList = common.GetIngressListChannel(ctx, client, nsQuery, 1)
+	channels.ConfigMapList = common.GetConfigMapListChannel(ctx, client, nsQuery, 1)
+	channels.SecretList = common.GetSecretListChannel(ctx, client, nsQuery, 1)
+	channels.PersistentVolumeClaimList = common.GetPersistentVolumeClaimListChannel(ctx, client, nsQuery, 1)
+
+	return GetOverviewFromChannels(ctx, channels, metricClient, nsQuery, dsQuery)
+}
+
+// GetOverviewFromChannels returns the first page of every list shown on the namespace overview
+// reading required resource lists once from the channels.
+func GetOverviewFromChannels(ctx context.Context, channels *common.ResourceChannels, metricClient metricapi.MetricClient,
+	nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*Overview, error) {
+	workloads, err := workload.GetWorkloadsFromChannels(ctx, channels, metricClient, dsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remaining lists don't download metrics, so there is no need to build them concurrently.
+	// They were fetched together with the workloads.
+	dsQuery = workload.FirstPageQuery(dsQuery)
+	overview := &Overview{Workloads: workloads}
+
+	overview.ServiceList, err = service.GetServiceListFromChannels(channels, dsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	overview.IngressList, err = ingress.GetIngressListFromChannels(channels, dsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	overview.ConfigMapList, err = configmap.GetConfigMapListFromChannels(channels, dsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	overview.SecretList, err = secret.GetSecretListFromChannels(channels, dsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	overview.PersistentVolumeClaimList, err = persistentvolumeclaim.GetPersistentVolumeClaimListFromChannels(channels,
+		nsQuery, dsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	overview.Errors = errors.MergeErrors(
+		overview.Errors,
+		overview.ServiceList.Errors,
+		overview.IngressList.Errors,
+		overview.ConfigMapList.Errors,
+		overview.SecretList.Errors,
+		overview.PersistentVolumeClaimList.Errors,
+	)
+
+	return overview, nil
+}
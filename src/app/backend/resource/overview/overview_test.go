@@ -0,0 +1,73 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+func TestGetOverview(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&apps.Deployment{ObjectMeta: metaV1.ObjectMeta{Name: "checkout", Namespace: "shop"}},
+		&v1.Service{ObjectMeta: metaV1.ObjectMeta{Name: "checkout", Namespace: "shop"}},
+		&v1.ConfigMap{ObjectMeta: metaV1.ObjectMeta{Name: "checkout-config", Namespace: "shop"}},
+		&v1.PersistentVolumeClaim{ObjectMeta: metaV1.ObjectMeta{Name: "data", Namespace: "shop"}},
+	)
+	client.PrependReactor("list", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &v1.SecretList{}, k8serrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", nil)
+	})
+
+	actual, err := GetOverview(context.TODO(), client, nil, common.NewSameNamespaceQuery("shop"), dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetOverview() returned error: %s", err)
+	}
+
+	if actual.DeploymentList.ListMeta.TotalItems != 1 || actual.ServiceList.ListMeta.TotalItems != 1 ||
+		actual.ConfigMapList.ListMeta.TotalItems != 1 || actual.PersistentVolumeClaimList.ListMeta.TotalItems != 1 {
+		t.Errorf("GetOverview() should return all lists, got %#v", actual)
+	}
+	if len(actual.Errors) != 1 {
+		t.Errorf("GetOverview() should return forbidden secret list as a non-critical error, got %v", actual.Errors)
+	}
+
+	marshalled, err := json.Marshal(actual)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %s", err)
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(marshalled, &fields); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %s", err)
+	}
+	for _, field := range []string{"status", "cumulativeMetrics", "deploymentList", "podList", "serviceList",
+		"secretList", "errors"} {
+		if _, ok := fields[field]; !ok {
+			t.Errorf("GetOverview() result should contain %q at the top level", field)
+		}
+	}
+}
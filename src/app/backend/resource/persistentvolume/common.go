@@ -28,17 +28,17 @@ import (
 )
 
 // GetStorageClassPersistentVolumes gets persistentvolumes that are associated with this storageclass.
-func GetStorageClassPersistentVolumes(client client.Interface, storageClassName string,
+func GetStorageClassPersistentVolumes(ctx context.Context, client client.Interface, storageClassName string,
 	dsQuery *dataselect.DataSelectQuery) (*PersistentVolumeList, error) {
 
-	storageClass, err := client.StorageV1().StorageClasses().Get(context.TODO(), storageClassName, metaV1.GetOptions{})
+	storageClass, err := client.StorageV1().StorageClasses().Get(ctx, storageClassName, metaV1.GetOptions{})
 
 	if err != nil {
 		return nil, err
 	}
 
 	channels := &common.ResourceChannels{
-		PersistentVolumeList: common.GetPersistentVolumeListChannel(
+		PersistentVolumeList: common.GetPersistentVolumeListChannel(ctx,
 			client, 1),
 	}
 
@@ -15,6 +15,7 @@
 package persistentvolume
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -98,7 +99,7 @@ func TestGetStorageClassPersistentVolumes(t *testing.T) {
 
 		fakeClient := fake.NewSimpleClientset(c.persistentVolumeList, c.storageClass)
 
-		actual, _ := GetStorageClassPersistentVolumes(fakeClient, c.name, dataselect.NoDataSelect)
+		actual, _ := GetStorageClassPersistentVolumes(context.TODO(), fakeClient, c.name, dataselect.NoDataSelect)
 
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetStorageClassPersistentVolumes(client, %#v) == \ngot: %#v, \nexpected %#v",
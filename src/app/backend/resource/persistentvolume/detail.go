@@ -33,10 +33,10 @@ type PersistentVolumeDetail struct {
 }
 
 // GetPersistentVolumeDetail returns detailed information about a persistent volume
-func GetPersistentVolumeDetail(client client.Interface, name string) (*PersistentVolumeDetail, error) {
+func GetPersistentVolumeDetail(ctx context.Context, client client.Interface, name string) (*PersistentVolumeDetail, error) {
 	log.Printf("Getting details of %s persistent volume", name)
 
-	rawPersistentVolume, err := client.CoreV1().PersistentVolumes().Get(context.TODO(), name, metaV1.GetOptions{})
+	rawPersistentVolume, err := client.CoreV1().PersistentVolumes().Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
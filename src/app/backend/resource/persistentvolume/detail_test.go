@@ -15,6 +15,7 @@
 package persistentvolume
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -76,7 +77,7 @@ func TestGetPersistentVolumeDetail(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.persistentVolume)
 
-		actual, err := GetPersistentVolumeDetail(fakeClient, c.name)
+		actual, err := GetPersistentVolumeDetail(context.TODO(), fakeClient, c.name)
 
 		if err != nil {
 			t.Errorf("GetPersistentVolumeDetail(%#v) == \ngot err %#v", c.persistentVolume, err)
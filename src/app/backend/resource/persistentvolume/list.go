@@ -15,6 +15,7 @@
 package persistentvolume
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -49,10 +50,10 @@ type PersistentVolume struct {
 }
 
 // GetPersistentVolumeList returns a list of all Persistent Volumes in the cluster.
-func GetPersistentVolumeList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*PersistentVolumeList, error) {
+func GetPersistentVolumeList(ctx context.Context, client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*PersistentVolumeList, error) {
 	log.Print("Getting list persistent volumes")
 	channels := &common.ResourceChannels{
-		PersistentVolumeList: common.GetPersistentVolumeListChannel(client, 1),
+		PersistentVolumeList: common.GetPersistentVolumeListChannelWithOptions(ctx, client, dsQuery.ListOptions(), 1),
 	}
 
 	return GetPersistentVolumeListFromChannels(channels, dsQuery)
@@ -69,7 +70,9 @@ func GetPersistentVolumeListFromChannels(channels *common.ResourceChannels, dsQu
 		return nil, criticalError
 	}
 
-	return toPersistentVolumeList(persistentVolumes.Items, nonCriticalErrors, dsQuery), nil
+	result := toPersistentVolumeList(persistentVolumes.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, persistentVolumes)
+	return result, nil
 }
 
 func toPersistentVolumeList(persistentVolumes []v1.PersistentVolume, nonCriticalErrors []error,
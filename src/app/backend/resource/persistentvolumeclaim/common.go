@@ -32,10 +32,10 @@ import (
 type PersistentVolumeClaimCell api.PersistentVolumeClaim
 
 // GetPodPersistentVolumeClaims gets persistentvolumeclaims that are associated with this pod.
-func GetPodPersistentVolumeClaims(client client.Interface, namespace string, podName string,
+func GetPodPersistentVolumeClaims(ctx context.Context, client client.Interface, namespace string, podName string,
 	dsQuery *dataselect.DataSelectQuery) (*PersistentVolumeClaimList, error) {
 
-	pod, err := client.CoreV1().Pods(namespace).Get(context.TODO(), podName, metaV1.GetOptions{})
+	pod, err := client.CoreV1().Pods(namespace).Get(ctx, podName, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +52,7 @@ func GetPodPersistentVolumeClaims(client client.Interface, namespace string, pod
 
 	if len(claimNames) > 0 {
 		channels := &common.ResourceChannels{
-			PersistentVolumeClaimList: common.GetPersistentVolumeClaimListChannel(
+			PersistentVolumeClaimList: common.GetPersistentVolumeClaimListChannel(ctx,
 				client, common.NewSameNamespaceQuery(namespace), 1),
 		}
 
@@ -15,6 +15,7 @@
 package persistentvolumeclaim
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -74,7 +75,7 @@ func TestGetPodPersistentVolumeClaims(t *testing.T) {
 
 		fakeClient := fake.NewSimpleClientset(c.persistentVolumeClaimList, c.pod)
 
-		actual, _ := GetPodPersistentVolumeClaims(fakeClient, c.namespace, c.name, dataselect.NoDataSelect)
+		actual, _ := GetPodPersistentVolumeClaims(context.TODO(), fakeClient, c.namespace, c.name, dataselect.NoDataSelect)
 
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetPodPersistentVolumeClaims(client, %#v, %#v) == \ngot: %#v, \nexpected %#v",
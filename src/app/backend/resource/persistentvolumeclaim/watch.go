@@ -0,0 +1,74 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolumeclaim
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/listwatch"
+)
+
+// NewPersistentVolumeClaimListWatch returns list watch that streams changes of the Persistent Volume Claim list selected by given data select
+// query.
+func NewPersistentVolumeClaimListWatch(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+	return &listwatch.ListWatch{
+		NamespaceQuery: nsQuery,
+		ListOptions:    dsQuery.ListOptions(),
+		ListFunc: func(ctx context.Context, options metaV1.ListOptions) (runtime.Object, error) {
+			return client.CoreV1().PersistentVolumeClaims(nsQuery.ToRequestParam()).List(ctx, options)
+		},
+		WatchFunc: func(ctx context.Context, options metaV1.ListOptions) (watch.Interface, error) {
+			return client.CoreV1().PersistentVolumeClaims(nsQuery.ToRequestParam()).Watch(ctx, options)
+		},
+		SelectFunc: func(_ context.Context, objects []runtime.Object, _ map[string][]runtime.Object) (
+			interface{}, api.ListMeta, []listwatch.Item, error) {
+			list := &v1.PersistentVolumeClaimList{}
+			for _, object := range objects {
+				list.Items = append(list.Items, *object.(*v1.PersistentVolumeClaim))
+			}
+
+			channels := &common.ResourceChannels{
+				PersistentVolumeClaimList: common.PersistentVolumeClaimListChannel{
+					List:  make(chan *v1.PersistentVolumeClaimList, 1),
+					Error: make(chan error, 1),
+				},
+			}
+			channels.PersistentVolumeClaimList.List <- list
+			channels.PersistentVolumeClaimList.Error <- nil
+
+			result, err := GetPersistentVolumeClaimListFromChannels(channels, nsQuery, dsQuery)
+			if err != nil {
+				return nil, api.ListMeta{}, nil, err
+			}
+
+			items := make([]listwatch.Item, len(result.Items))
+			for i, item := range result.Items {
+				items[i] = listwatch.Item{UID: item.ObjectMeta.UID, Object: item}
+			}
+
+			return result, result.ListMeta, items, nil
+		},
+	}
+}
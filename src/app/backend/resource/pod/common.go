@@ -186,6 +186,14 @@ func (self PodCell) GetProperty(name dataselect.PropertyName) dataselect.Compara
 		return dataselect.StdComparableTime(self.ObjectMeta.CreationTimestamp.Time)
 	case dataselect.NamespaceProperty:
 		return dataselect.StdComparableString(self.ObjectMeta.Namespace)
+	case dataselect.RestartsProperty:
+		return dataselect.StdComparableInt(getRestartCount(v1.Pod(self)))
+	case dataselect.NodeNameProperty:
+		return dataselect.StdComparableString(self.Spec.NodeName)
+	case dataselect.QOSClassProperty:
+		return dataselect.StdComparableString(self.Status.QOSClass)
+	case dataselect.IPProperty:
+		return dataselect.StdComparableString(self.Status.PodIP)
 	default:
 		// if name is not supported then just return a constant dummy value, sort will have no effect.
 		return nil
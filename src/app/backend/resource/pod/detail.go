@@ -127,27 +127,27 @@ type VolumeMount struct {
 }
 
 // GetPodDetail returns the details of a named Pod from a particular namespace.
-func GetPodDetail(client kubernetes.Interface, metricClient metricapi.MetricClient, namespace, name string) (
+func GetPodDetail(ctx context.Context, client kubernetes.Interface, metricClient metricapi.MetricClient, namespace, name string) (
 	*PodDetail, error) {
 	log.Printf("Getting details of %s pod in %s namespace", name, namespace)
 
 	channels := &common.ResourceChannels{
-		ConfigMapList: common.GetConfigMapListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
-		SecretList:    common.GetSecretListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		ConfigMapList: common.GetConfigMapListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
+		SecretList:    common.GetSecretListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
-	pod, err := client.CoreV1().Pods(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	pod, err := client.CoreV1().Pods(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	podController, err := getPodController(client, common.NewSameNamespaceQuery(namespace), pod)
+	podController, err := getPodController(ctx, client, common.NewSameNamespaceQuery(namespace), pod)
 	nonCriticalErrors, criticalError := errorHandler.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	_, metricPromises := dataselect.GenericDataSelectWithMetrics(toCells([]v1.Pod{*pod}),
+	_, metricPromises := dataselect.GenericDataSelectWithMetrics(ctx, toCells([]v1.Pod{*pod}),
 		dataselect.StdMetricsDataSelect, metricapi.NoResourceCache, metricClient)
 	metrics, _ := metricPromises.GetMetrics()
 
@@ -165,13 +165,13 @@ func GetPodDetail(client kubernetes.Interface, metricClient metricapi.MetricClie
 		return nil, criticalError
 	}
 
-	eventList, err := GetEventsForPod(client, dataselect.DefaultDataSelect, pod.Namespace, pod.Name)
+	eventList, err := GetEventsForPod(ctx, client, dataselect.DefaultDataSelect, pod.Namespace, pod.Name)
 	nonCriticalErrors, criticalError = errorHandler.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	persistentVolumeClaimList, err := persistentvolumeclaim.GetPodPersistentVolumeClaims(client,
+	persistentVolumeClaimList, err := persistentvolumeclaim.GetPodPersistentVolumeClaims(ctx, client,
 		namespace, name, dataselect.DefaultDataSelect)
 	nonCriticalErrors, criticalError = errorHandler.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
@@ -183,10 +183,10 @@ func GetPodDetail(client kubernetes.Interface, metricClient metricapi.MetricClie
 	return &podDetail, nil
 }
 
-func getPodController(client kubernetes.Interface, nsQuery *common.NamespaceQuery, pod *v1.Pod) (*controller.ResourceOwner, error) {
+func getPodController(ctx context.Context, client kubernetes.Interface, nsQuery *common.NamespaceQuery, pod *v1.Pod) (*controller.ResourceOwner, error) {
 	channels := &common.ResourceChannels{
-		PodList:   common.GetPodListChannel(client, nsQuery, 1),
-		EventList: common.GetEventListChannel(client, nsQuery, 1),
+		PodList:   common.GetPodListChannel(ctx, client, nsQuery, 1),
+		EventList: common.GetEventListChannel(ctx, client, nsQuery, 1),
 	}
 
 	pods := <-channels.PodList.List
@@ -204,7 +204,7 @@ func getPodController(client kubernetes.Interface, nsQuery *common.NamespaceQuer
 	ownerRef := metaV1.GetControllerOf(pod)
 	if ownerRef != nil {
 		var rc controller.ResourceController
-		rc, err = controller.NewResourceController(*ownerRef, pod.Namespace, client)
+		rc, err = controller.NewResourceController(ctx, *ownerRef, pod.Namespace, client)
 		if err == nil {
 			ctrl = rc.Get(pods.Items, events.Items)
 		}
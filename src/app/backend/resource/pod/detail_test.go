@@ -15,6 +15,7 @@
 package pod
 
 import (
+	"context"
 	"encoding/base64"
 	"reflect"
 	"testing"
@@ -67,7 +68,7 @@ func TestGetPodDetail(t *testing.T) {
 		fakeClient := fake.NewSimpleClientset(c.pod)
 
 		dataselect.DefaultDataSelectWithMetrics.MetricQuery = dataselect.NoMetrics
-		actual, err := GetPodDetail(fakeClient, nil, "test-namespace", "test-pod")
+		actual, err := GetPodDetail(context.TODO(), fakeClient, nil, "test-namespace", "test-pod")
 
 		if err != nil {
 			t.Errorf("GetPodDetail(%#v) == \ngot err %#v", c.pod, err)
@@ -15,6 +15,7 @@
 package pod
 
 import (
+	"context"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
@@ -22,7 +23,7 @@ import (
 )
 
 // GetEventsForPod gets events that are associated with this pod.
-func GetEventsForPod(client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace,
+func GetEventsForPod(ctx context.Context, client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace,
 	podName string) (*common.EventList, error) {
-	return event.GetResourceEvents(client, dsQuery, namespace, podName)
+	return event.GetResourceEvents(ctx, client, dsQuery, namespace, podName)
 }
@@ -15,6 +15,7 @@
 package pod
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -68,7 +69,7 @@ func TestGetPodEvents(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.podList, c.eventList)
 
-		actual, _ := GetEventsForPod(fakeClient, dataselect.NoDataSelect, c.namespace, c.podName)
+		actual, _ := GetEventsForPod(context.TODO(), fakeClient, dataselect.NoDataSelect, c.namespace, c.podName)
 
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetEventsForPods == \ngot %#v, \nexpected %#v", actual,
@@ -15,6 +15,7 @@
 package pod
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -24,7 +25,6 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
 	v1 "k8s.io/api/core/v1"
-	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sClient "k8s.io/client-go/kubernetes"
 )
 
@@ -83,22 +83,22 @@ var EmptyPodList = &PodList{
 }
 
 // GetPodList returns a list of all Pods in the cluster.
-func GetPodList(client k8sClient.Interface, metricClient metricapi.MetricClient, nsQuery *common.NamespaceQuery,
+func GetPodList(ctx context.Context, client k8sClient.Interface, metricClient metricapi.MetricClient, nsQuery *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery) (*PodList, error) {
 	log.Print("Getting list of all pods in the cluster")
 
 	channels := &common.ResourceChannels{
-		PodList:   common.GetPodListChannelWithOptions(client, nsQuery, metaV1.ListOptions{}, 1),
-		EventList: common.GetEventListChannel(client, nsQuery, 1),
+		PodList:   common.GetPodListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
+		EventList: common.GetEventListChannel(ctx, client, nsQuery, 1),
 	}
 
-	return GetPodListFromChannels(channels, dsQuery, metricClient)
+	return GetPodListFromChannels(ctx, channels, dsQuery, metricClient)
 }
 
 // GetPodListFromChannels returns a list of all Pods in the cluster
 // reading required resource list once from the channels.
-func GetPodListFromChannels(channels *common.ResourceChannels, dsQuery *dataselect.DataSelectQuery,
-	metricClient metricapi.MetricClient) (*PodList, error) {
+func GetPodListFromChannels(ctx context.Context, channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*PodList, error) {
 
 	pods := <-channels.PodList.List
 	err := <-channels.PodList.Error
@@ -114,24 +114,25 @@ func GetPodListFromChannels(channels *common.ResourceChannels, dsQuery *datasele
 		return nil, criticalError
 	}
 
-	podList := ToPodList(pods.Items, eventList.Items, nonCriticalErrors, dsQuery, metricClient)
+	podList := ToPodList(ctx, pods.Items, eventList.Items, nonCriticalErrors, dsQuery, metricClient)
 	podList.Status = getStatus(pods, eventList.Items)
+	podList.ListMeta = dsQuery.CursorListMeta(podList.ListMeta, pods)
 	return &podList, nil
 }
 
-func ToPodList(pods []v1.Pod, events []v1.Event, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery,
-	metricClient metricapi.MetricClient) PodList {
+func ToPodList(ctx context.Context, pods []v1.Pod, events []v1.Event, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) PodList {
 	podList := PodList{
 		Pods:   make([]Pod, 0),
 		Errors: nonCriticalErrors,
 	}
 
 	podCells, cumulativeMetricsPromises, filteredTotal := dataselect.
-		GenericDataSelectWithFilterAndMetrics(toCells(pods), dsQuery, metricapi.NoResourceCache, metricClient)
+		GenericDataSelectWithFilterAndMetrics(ctx, toCells(pods), dsQuery, metricapi.NoResourceCache, metricClient)
 	pods = fromCells(podCells)
 	podList.ListMeta = api.ListMeta{TotalItems: filteredTotal}
 
-	metrics, err := getMetricsPerPod(pods, metricClient, dsQuery)
+	metrics, err := getMetricsPerPod(ctx, pods, metricClient, dsQuery)
 	if err != nil {
 		log.Printf("Skipping metrics because of error: %s\n", err)
 	}
@@ -15,6 +15,7 @@
 package pod_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -130,12 +131,12 @@ func TestGetPodListFromChannels(t *testing.T) {
 		channels.EventList.List <- &v1.EventList{}
 		channels.EventList.Error <- nil
 
-		actual, err := pod.GetPodListFromChannels(channels, dataselect.NoDataSelect, nil)
+		actual, err := pod.GetPodListFromChannels(context.TODO(), channels, dataselect.NoDataSelect, nil)
 		if !reflect.DeepEqual(actual, c.expected) {
-			t.Errorf("GetPodListFromChannels() ==\n          %#v\nExpected: %#v", actual, c.expected)
+			t.Errorf("GetPodListFromChannels(context.TODO(), ) ==\n          %#v\nExpected: %#v", actual, c.expected)
 		}
 		if !reflect.DeepEqual(err, c.expectedError) {
-			t.Errorf("GetPodListFromChannels() ==\n          %#v\nExpected: %#v", err, c.expectedError)
+			t.Errorf("GetPodListFromChannels(context.TODO(), ) ==\n          %#v\nExpected: %#v", err, c.expectedError)
 		}
 	}
 }
@@ -15,6 +15,7 @@
 package pod
 
 import (
+	"context"
 	"log"
 
 	v1 "k8s.io/api/core/v1"
@@ -45,13 +46,13 @@ type PodMetrics struct {
 	MemoryUsageHistory []metricapi.MetricPoint `json:"memoryUsageHistory"`
 }
 
-func getMetricsPerPod(pods []v1.Pod, metricClient metricapi.MetricClient, dsQuery *dataselect.DataSelectQuery) (
-	*MetricsByPod, error) {
+func getMetricsPerPod(ctx context.Context, pods []v1.Pod, metricClient metricapi.MetricClient,
+	dsQuery *dataselect.DataSelectQuery) (*MetricsByPod, error) {
 	log.Println("Getting pod metrics")
 
 	result := &MetricsByPod{MetricsMap: make(map[types.UID]PodMetrics)}
 
-	metricPromises := dataselect.PodListMetrics(toCells(pods), dsQuery, metricClient)
+	metricPromises := dataselect.PodListMetrics(ctx, toCells(pods), dsQuery, metricClient)
 	metrics, err := metricPromises.GetMetrics()
 	if err != nil {
 		return result, err
@@ -0,0 +1,79 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/listwatch"
+)
+
+// NewPodListWatch returns list watch that streams changes of the Pod list selected by given data select
+// query.
+func NewPodListWatch(client kubernetes.Interface, metricClient metricapi.MetricClient, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) *listwatch.ListWatch {
+	return &listwatch.ListWatch{
+		NamespaceQuery: nsQuery,
+		ListOptions:    dsQuery.ListOptions(),
+		ListFunc: func(ctx context.Context, options metaV1.ListOptions) (runtime.Object, error) {
+			return client.CoreV1().Pods(nsQuery.ToRequestParam()).List(ctx, options)
+		},
+		WatchFunc: func(ctx context.Context, options metaV1.ListOptions) (watch.Interface, error) {
+			return client.CoreV1().Pods(nsQuery.ToRequestParam()).Watch(ctx, options)
+		},
+		Related: map[string]listwatch.Related{
+			listwatch.RelatedEvents: listwatch.NewRelatedEvents(client, nsQuery),
+		},
+		SelectFunc: func(ctx context.Context, objects []runtime.Object, related map[string][]runtime.Object) (
+			interface{}, api.ListMeta, []listwatch.Item, error) {
+			list := &v1.PodList{}
+			for _, object := range objects {
+				list.Items = append(list.Items, *object.(*v1.Pod))
+			}
+
+			channels := &common.ResourceChannels{
+				PodList: common.PodListChannel{
+					List:  make(chan *v1.PodList, 1),
+					Error: make(chan error, 1),
+				},
+				EventList: listwatch.EventListChannel(related[listwatch.RelatedEvents]),
+			}
+			channels.PodList.List <- list
+			channels.PodList.Error <- nil
+
+			result, err := GetPodListFromChannels(ctx, channels, dsQuery, metricClient)
+			if err != nil {
+				return nil, api.ListMeta{}, nil, err
+			}
+
+			items := make([]listwatch.Item, len(result.Pods))
+			for i, item := range result.Pods {
+				items[i] = listwatch.Item{UID: item.ObjectMeta.UID, Object: item}
+			}
+
+			return result, result.ListMeta, items, nil
+		},
+	}
+}
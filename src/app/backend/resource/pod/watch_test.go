@@ -0,0 +1,87 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/listwatch"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/pod"
+)
+
+func TestNewPodListWatch(t *testing.T) {
+	client := fake.NewSimpleClientset(&v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-1", Namespace: "default", UID: "pod-1"},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listWatch := pod.NewPodListWatch(client, nil, common.NewSameNamespaceQuery("default"), dataselect.NoDataSelect)
+	listWatch.BatchPeriod = 10 * time.Millisecond
+
+	events := make(chan listwatch.Event)
+	done := make(chan error)
+	go func() {
+		done <- listWatch.Stream(ctx, func(event listwatch.Event) error {
+			events <- event
+			return nil
+		})
+	}()
+
+	sync := <-events
+	if sync.Type != listwatch.Sync || sync.ListMeta.TotalItems != 1 {
+		t.Fatalf("Expected sync event with 1 item, got %#v", sync)
+	}
+
+	_, err := client.CoreV1().Pods("default").Create(ctx, &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-2", Namespace: "default", UID: "pod-2"},
+	}, metaV1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	added := <-events
+	if added.Type != listwatch.Added || added.ListMeta.TotalItems != 2 {
+		t.Fatalf("Expected added event with 2 items on the list, got %#v", added)
+	}
+
+	if name := added.Object.(pod.Pod).ObjectMeta.Name; name != "pod-2" {
+		t.Errorf("Expected pod-2 to be added, got %s", name)
+	}
+
+	err = client.CoreV1().Pods("default").Delete(ctx, "pod-1", metaV1.DeleteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deleted := <-events
+	if deleted.Type != listwatch.Deleted || deleted.ListMeta.TotalItems != 1 {
+		t.Fatalf("Expected deleted event with 1 item on the list, got %#v", deleted)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Expected stream to finish without error, got %s", err)
+	}
+}
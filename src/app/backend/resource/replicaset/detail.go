@@ -45,22 +45,22 @@ type ReplicaSetDetail struct {
 }
 
 // GetReplicaSetDetail gets replica set details.
-func GetReplicaSetDetail(client k8sClient.Interface, metricClient metricapi.MetricClient,
+func GetReplicaSetDetail(ctx context.Context, client k8sClient.Interface, metricClient metricapi.MetricClient,
 	namespace, name string) (*ReplicaSetDetail, error) {
 	log.Printf("Getting details of %s service in %s namespace", name, namespace)
 
-	rs, err := client.AppsV1().ReplicaSets(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	rs, err := client.AppsV1().ReplicaSets(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	podInfo, err := getReplicaSetPodInfo(client, rs)
+	podInfo, err := getReplicaSetPodInfo(ctx, client, rs)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	hpas, err := hpa.GetHorizontalPodAutoscalerListForResource(client, namespace, "ReplicaSet", name)
+	hpas, err := hpa.GetHorizontalPodAutoscalerListForResource(ctx, client, namespace, "ReplicaSet", name)
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return nil, criticalError
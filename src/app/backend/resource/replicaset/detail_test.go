@@ -15,6 +15,7 @@
 package replicaset
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -76,7 +77,7 @@ func TestGetReplicaSetDetail(t *testing.T) {
 		fakeClient := fake.NewSimpleClientset(c.replicaSet)
 
 		dataselect.DefaultDataSelectWithMetrics.MetricQuery = dataselect.NoMetrics
-		actual, _ := GetReplicaSetDetail(fakeClient, nil, c.namespace, c.name)
+		actual, _ := GetReplicaSetDetail(context.TODO(), fakeClient, nil, c.namespace, c.name)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
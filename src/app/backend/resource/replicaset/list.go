@@ -15,6 +15,7 @@
 package replicaset
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -70,22 +71,22 @@ func ToReplicaSet(replicaSet *apps.ReplicaSet, podInfo *common.PodInfo) ReplicaS
 }
 
 // GetReplicaSetList returns a list of all Replica Sets in the cluster.
-func GetReplicaSetList(client client.Interface, nsQuery *common.NamespaceQuery,
+func GetReplicaSetList(ctx context.Context, client client.Interface, nsQuery *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*ReplicaSetList, error) {
 	log.Print("Getting list of all replica sets in the cluster")
 
 	channels := &common.ResourceChannels{
-		ReplicaSetList: common.GetReplicaSetListChannel(client, nsQuery, 1),
-		PodList:        common.GetPodListChannel(client, nsQuery, 1),
-		EventList:      common.GetEventListChannel(client, nsQuery, 1),
+		ReplicaSetList: common.GetReplicaSetListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
+		PodList:        common.GetPodListChannel(ctx, client, nsQuery, 1),
+		EventList:      common.GetEventListChannel(ctx, client, nsQuery, 1),
 	}
 
-	return GetReplicaSetListFromChannels(channels, dsQuery, metricClient)
+	return GetReplicaSetListFromChannels(ctx, channels, dsQuery, metricClient)
 }
 
 // GetReplicaSetListFromChannels returns a list of all Replica Sets in the cluster
 // reading required resource list once from the channels.
-func GetReplicaSetListFromChannels(channels *common.ResourceChannels,
+func GetReplicaSetListFromChannels(ctx context.Context, channels *common.ResourceChannels,
 	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*ReplicaSetList, error) {
 
 	replicaSets := <-channels.ReplicaSetList.List
@@ -109,15 +110,17 @@ func GetReplicaSetListFromChannels(channels *common.ResourceChannels,
 		return nil, criticalError
 	}
 
-	rsList := ToReplicaSetList(replicaSets.Items, pods.Items, events.Items, nonCriticalErrors, dsQuery, metricClient)
+	rsList := ToReplicaSetList(ctx, replicaSets.Items, pods.Items, events.Items, nonCriticalErrors, dsQuery, metricClient)
 	rsList.Status = getStatus(replicaSets, pods.Items, events.Items)
+	rsList.ListMeta = dsQuery.CursorListMeta(rsList.ListMeta, replicaSets)
 	return rsList, nil
 }
 
 // ToReplicaSetList creates paginated list of Replica Set model
 // objects based on Kubernetes Replica Set objects array and related resources arrays.
-func ToReplicaSetList(replicaSets []apps.ReplicaSet, pods []v1.Pod, events []v1.Event, nonCriticalErrors []error,
-	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) *ReplicaSetList {
+func ToReplicaSetList(ctx context.Context, replicaSets []apps.ReplicaSet, pods []v1.Pod, events []v1.Event,
+	nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery,
+	metricClient metricapi.MetricClient) *ReplicaSetList {
 
 	replicaSetList := &ReplicaSetList{
 		ReplicaSets: make([]ReplicaSet, 0),
@@ -130,7 +133,7 @@ func ToReplicaSetList(replicaSets []apps.ReplicaSet, pods []v1.Pod, events []v1.
 	}
 	rsCells, metricPromises, filteredTotal := dataselect.
 		GenericDataSelectWithFilterAndMetrics(
-			ToCells(replicaSets), dsQuery, cachedResources, metricClient)
+			ctx, ToCells(replicaSets), dsQuery, cachedResources, metricClient)
 	replicaSets = FromCells(rsCells)
 	replicaSetList.ListMeta = api.ListMeta{TotalItems: filteredTotal}
 
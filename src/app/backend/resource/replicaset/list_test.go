@@ -15,6 +15,7 @@
 package replicaset
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -197,7 +198,7 @@ func TestGetReplicaSetListFromChannels(t *testing.T) {
 		channels.EventList.List <- &v1.EventList{}
 		channels.EventList.Error <- nil
 
-		actual, err := GetReplicaSetListFromChannels(channels, dataselect.NoDataSelect, nil)
+		actual, err := GetReplicaSetListFromChannels(context.TODO(), channels, dataselect.NoDataSelect, nil)
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetReplicaSetListChannels() ==\n          %#v\nExpected: %#v", actual, c.expected)
 		}
@@ -246,10 +247,10 @@ func TestToReplicaSetList(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		actual := ToReplicaSetList(c.replicaSets, c.pods, c.events, nil, dataselect.NoDataSelect, nil)
+		actual := ToReplicaSetList(context.TODO(), c.replicaSets, c.pods, c.events, nil, dataselect.NoDataSelect, nil)
 
 		if !reflect.DeepEqual(actual, c.expected) {
-			t.Errorf("ToReplicaSetList(%#v, %#v, %#v, ...) == \ngot %#v, \nexpected %#v",
+			t.Errorf("ToReplicaSetList(context.TODO(), %#v, %#v, %#v, ...) == \ngot %#v, \nexpected %#v",
 				c.replicaSets, c.pods, c.events, actual, c.expected)
 		}
 	}
@@ -300,7 +301,7 @@ func TestGetReplicaSetList(t *testing.T) {
 
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.rsList)
-		actual, _ := GetReplicaSetList(fakeClient, &common.NamespaceQuery{}, dataselect.NoDataSelect, nil)
+		actual, _ := GetReplicaSetList(context.TODO(), fakeClient, &common.NamespaceQuery{}, dataselect.NoDataSelect, nil)
 		actions := fakeClient.Actions()
 
 		if len(actions) != len(c.expectedActions) {
@@ -32,34 +32,34 @@ import (
 )
 
 // GetReplicaSetPods return list of pods targeting replica set.
-func GetReplicaSetPods(client k8sClient.Interface, metricClient metricapi.MetricClient,
+func GetReplicaSetPods(ctx context.Context, client k8sClient.Interface, metricClient metricapi.MetricClient,
 	dsQuery *dataselect.DataSelectQuery, replicaSetName, namespace string) (*pod.PodList, error) {
 	log.Printf("Getting replication controller %s pods in namespace %s", replicaSetName, namespace)
 
-	pods, err := getRawReplicaSetPods(client, replicaSetName, namespace)
+	pods, err := getRawReplicaSetPods(ctx, client, replicaSetName, namespace)
 	if err != nil {
 		return pod.EmptyPodList, err
 	}
 
-	events, err := event.GetPodsEvents(client, namespace, pods)
+	events, err := event.GetPodsEvents(ctx, client, namespace, pods)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	podList := pod.ToPodList(pods, events, nonCriticalErrors, dsQuery, metricClient)
+	podList := pod.ToPodList(ctx, pods, events, nonCriticalErrors, dsQuery, metricClient)
 	return &podList, nil
 }
 
-func getRawReplicaSetPods(client k8sClient.Interface, replicaSetName, namespace string) ([]v1.Pod, error) {
-	rs, err := client.AppsV1().ReplicaSets(namespace).Get(context.TODO(), replicaSetName, metaV1.GetOptions{})
+func getRawReplicaSetPods(ctx context.Context, client k8sClient.Interface, replicaSetName, namespace string) ([]v1.Pod, error) {
+	rs, err := client.AppsV1().ReplicaSets(namespace).Get(ctx, replicaSetName, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	labelSelector := labels.SelectorFromSet(rs.Spec.Selector.MatchLabels)
 	channels := &common.ResourceChannels{
-		PodList: common.GetPodListChannelWithOptions(client, common.NewSameNamespaceQuery(namespace),
+		PodList: common.GetPodListChannelWithOptions(ctx, client, common.NewSameNamespaceQuery(namespace),
 			metaV1.ListOptions{
 				LabelSelector: labelSelector.String(),
 			}, 1),
@@ -73,10 +73,10 @@ func getRawReplicaSetPods(client k8sClient.Interface, replicaSetName, namespace
 	return common.FilterPodsByControllerRef(rs, podList.Items), nil
 }
 
-func getReplicaSetPodInfo(client k8sClient.Interface, replicaSet *apps.ReplicaSet) (*common.PodInfo, error) {
+func getReplicaSetPodInfo(ctx context.Context, client k8sClient.Interface, replicaSet *apps.ReplicaSet) (*common.PodInfo, error) {
 	labelSelector := labels.SelectorFromSet(replicaSet.Spec.Selector.MatchLabels)
 	channels := &common.ResourceChannels{
-		PodList: common.GetPodListChannelWithOptions(client, common.NewSameNamespaceQuery(replicaSet.Namespace),
+		PodList: common.GetPodListChannelWithOptions(ctx, client, common.NewSameNamespaceQuery(replicaSet.Namespace),
 			metaV1.ListOptions{
 				LabelSelector: labelSelector.String(),
 			}, 1),
@@ -26,16 +26,16 @@ import (
 )
 
 // GetReplicaSetServices returns list of services that are related to replica set targeted by given name.
-func GetReplicaSetServices(client client.Interface, dsQuery *dataselect.DataSelectQuery,
+func GetReplicaSetServices(ctx context.Context, client client.Interface, dsQuery *dataselect.DataSelectQuery,
 	namespace, name string) (*service.ServiceList, error) {
 
-	replicaSet, err := client.AppsV1().ReplicaSets(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	replicaSet, err := client.AppsV1().ReplicaSets(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	channels := &common.ResourceChannels{
-		ServiceList: common.GetServiceListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		ServiceList: common.GetServiceListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
 	services := <-channels.ServiceList.List
@@ -44,10 +44,10 @@ func toLabelSelector(selector map[string]string) (labels.Selector, error) {
 // Based on given selector returns list of services that are candidates for deletion.
 // Services are matched by replication controllers' label selector. They are deleted if given
 // label selector is targeting only 1 replication controller.
-func getServicesForDeletion(client client.Interface, labelSelector labels.Selector,
+func getServicesForDeletion(ctx context.Context, client client.Interface, labelSelector labels.Selector,
 	namespace string) ([]v1.Service, error) {
 
-	replicationControllers, err := client.CoreV1().ReplicationControllers(namespace).List(context.TODO(), metaV1.ListOptions{
+	replicationControllers, err := client.CoreV1().ReplicationControllers(namespace).List(ctx, metaV1.ListOptions{
 		LabelSelector: labelSelector.String(),
 		FieldSelector: fields.Everything().String(),
 	})
@@ -62,7 +62,7 @@ func getServicesForDeletion(client client.Interface, labelSelector labels.Select
 		return []v1.Service{}, nil
 	}
 
-	services, err := client.CoreV1().Services(namespace).List(context.TODO(), metaV1.ListOptions{
+	services, err := client.CoreV1().Services(namespace).List(ctx, metaV1.ListOptions{
 		LabelSelector: labelSelector.String(),
 		FieldSelector: fields.Everything().String(),
 	})
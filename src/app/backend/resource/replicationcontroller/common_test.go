@@ -15,6 +15,7 @@
 package replicationcontroller
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -128,7 +129,7 @@ func TestGetServicesForDeletion(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.replicationControllerList, c.expected)
 
-		getServicesForDeletion(fakeClient, c.labelSelector, "ns-1")
+		getServicesForDeletion(context.TODO(), fakeClient, c.labelSelector, "ns-1")
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -44,15 +44,15 @@ type ReplicationControllerSpec struct {
 
 // GetReplicationControllerDetail returns detailed information about the given replication controller
 // in the given namespace.
-func GetReplicationControllerDetail(client k8sClient.Interface, namespace, name string) (*ReplicationControllerDetail, error) {
+func GetReplicationControllerDetail(ctx context.Context, client k8sClient.Interface, namespace, name string) (*ReplicationControllerDetail, error) {
 	log.Printf("Getting details of %s replication controller in %s namespace", name, namespace)
 
-	replicationController, err := client.CoreV1().ReplicationControllers(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	replicationController, err := client.CoreV1().ReplicationControllers(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	podInfo, err := getReplicationControllerPodInfo(client, replicationController, namespace)
+	podInfo, err := getReplicationControllerPodInfo(ctx, client, replicationController, namespace)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
@@ -63,18 +63,18 @@ func GetReplicationControllerDetail(client k8sClient.Interface, namespace, name
 }
 
 // UpdateReplicasCount updates number of replicas in Replication Controller based on Replication Controller Spec
-func UpdateReplicasCount(client k8sClient.Interface, namespace, name string, spec *ReplicationControllerSpec) error {
+func UpdateReplicasCount(ctx context.Context, client k8sClient.Interface, namespace, name string, spec *ReplicationControllerSpec) error {
 	log.Printf("Updating replicas count to %d for %s replication controller from %s namespace",
 		spec.Replicas, name, namespace)
 
-	replicationController, err := client.CoreV1().ReplicationControllers(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	replicationController, err := client.CoreV1().ReplicationControllers(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return err
 	}
 
 	replicationController.Spec.Replicas = &spec.Replicas
 
-	_, err = client.CoreV1().ReplicationControllers(namespace).Update(context.TODO(), replicationController, metaV1.UpdateOptions{})
+	_, err = client.CoreV1().ReplicationControllers(namespace).Update(ctx, replicationController, metaV1.UpdateOptions{})
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@
 package replicationcontroller
 
 import (
+	"context"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
@@ -46,7 +47,7 @@ func TestUpdateReplicasCount(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.replicationController)
 
-		UpdateReplicasCount(fakeClient, c.namespace, c.replicationControllerName, c.replicationControllerSpec)
+		UpdateReplicasCount(context.TODO(), fakeClient, c.namespace, c.replicationControllerName, c.replicationControllerSpec)
 
 		actual := fakeClient.Actions()[1].(core.UpdateActionImpl).GetObject().(*v1.ReplicationController)
 		if *actual.Spec.Replicas != *c.expected {
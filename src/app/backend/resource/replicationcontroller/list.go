@@ -15,6 +15,7 @@
 package replicationcontroller
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -59,23 +60,23 @@ type ReplicationController struct {
 }
 
 // GetReplicationControllerList returns a list of all Replication Controllers in the cluster.
-func GetReplicationControllerList(client client.Interface, nsQuery *common.NamespaceQuery,
+func GetReplicationControllerList(ctx context.Context, client client.Interface, nsQuery *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*ReplicationControllerList, error) {
 	log.Print("Getting list of all replication controllers in the cluster")
 
 	channels := &common.ResourceChannels{
-		ReplicationControllerList: common.GetReplicationControllerListChannel(client, nsQuery, 1),
-		PodList:                   common.GetPodListChannel(client, nsQuery, 1),
-		EventList:                 common.GetEventListChannel(client, nsQuery, 1),
+		ReplicationControllerList: common.GetReplicationControllerListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
+		PodList:                   common.GetPodListChannel(ctx, client, nsQuery, 1),
+		EventList:                 common.GetEventListChannel(ctx, client, nsQuery, 1),
 	}
 
-	return GetReplicationControllerListFromChannels(channels, dsQuery, metricClient)
+	return GetReplicationControllerListFromChannels(ctx, channels, dsQuery, metricClient)
 }
 
 // GetReplicationControllerListFromChannels returns a list of all Replication Controllers in the cluster
 // reading required resource list once from the channels.
-func GetReplicationControllerListFromChannels(channels *common.ResourceChannels, dsQuery *dataselect.DataSelectQuery,
-	metricClient metricapi.MetricClient) (*ReplicationControllerList, error) {
+func GetReplicationControllerListFromChannels(ctx context.Context, channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*ReplicationControllerList, error) {
 
 	rcList := <-channels.ReplicationControllerList.List
 	err := <-channels.ReplicationControllerList.Error
@@ -98,14 +99,16 @@ func GetReplicationControllerListFromChannels(channels *common.ResourceChannels,
 		return nil, criticalError
 	}
 
-	rcs := toReplicationControllerList(rcList.Items, dsQuery, podList.Items, eventList.Items, nonCriticalErrors,
+	rcs := toReplicationControllerList(ctx, rcList.Items, dsQuery, podList.Items, eventList.Items, nonCriticalErrors,
 		metricClient)
 	rcs.Status = getStatus(rcList, podList.Items, eventList.Items)
+	rcs.ListMeta = dsQuery.CursorListMeta(rcs.ListMeta, rcList)
 	return rcs, nil
 }
 
-func toReplicationControllerList(replicationControllers []v1.ReplicationController, dsQuery *dataselect.DataSelectQuery,
-	pods []v1.Pod, events []v1.Event, nonCriticalErrors []error, metricClient metricapi.MetricClient) *ReplicationControllerList {
+func toReplicationControllerList(ctx context.Context, replicationControllers []v1.ReplicationController,
+	dsQuery *dataselect.DataSelectQuery, pods []v1.Pod, events []v1.Event, nonCriticalErrors []error,
+	metricClient metricapi.MetricClient) *ReplicationControllerList {
 
 	rcList := &ReplicationControllerList{
 		ReplicationControllers: make([]ReplicationController, 0),
@@ -116,7 +119,7 @@ func toReplicationControllerList(replicationControllers []v1.ReplicationControll
 		Pods: pods,
 	}
 	rcCells, metricPromises, filteredTotal := dataselect.GenericDataSelectWithFilterAndMetrics(
-		toCells(replicationControllers), dsQuery, cachedResources, metricClient)
+		ctx, toCells(replicationControllers), dsQuery, cachedResources, metricClient)
 	replicationControllers = fromCells(rcCells)
 	rcList.ListMeta = api.ListMeta{TotalItems: filteredTotal}
 
@@ -15,6 +15,7 @@
 package replicationcontroller
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -211,10 +212,10 @@ func TestToReplicationControllerList(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		actual := toReplicationControllerList(c.replicationControllers, dataselect.NoDataSelect,
+		actual := toReplicationControllerList(context.TODO(), c.replicationControllers, dataselect.NoDataSelect,
 			c.pods, events, nil, nil)
 		if !reflect.DeepEqual(actual, c.expected) {
-			t.Errorf("toReplicationControllerList(%#v, %#v) == \n%#v\nexpected \n%#v\n",
+			t.Errorf("toReplicationControllerList(context.TODO(), %#v, %#v) == \n%#v\nexpected \n%#v\n",
 				c.replicationControllers, c.services, actual, c.expected)
 		}
 	}
@@ -269,7 +270,7 @@ func TestGetReplicationControllerList(t *testing.T) {
 
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.rcList)
-		actual, _ := GetReplicationControllerList(fakeClient, &common.NamespaceQuery{}, dataselect.NoDataSelect, nil)
+		actual, _ := GetReplicationControllerList(context.TODO(), fakeClient, &common.NamespaceQuery{}, dataselect.NoDataSelect, nil)
 		actions := fakeClient.Actions()
 
 		if len(actions) != len(c.expectedActions) {
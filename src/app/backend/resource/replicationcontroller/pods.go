@@ -31,34 +31,34 @@ import (
 
 // GetReplicationControllerPods return list of pods targeting replication controller associated
 // to given name.
-func GetReplicationControllerPods(client k8sClient.Interface,
+func GetReplicationControllerPods(ctx context.Context, client k8sClient.Interface,
 	metricClient metricapi.MetricClient,
 	dsQuery *dataselect.DataSelectQuery, rcName, namespace string) (*pod.PodList, error) {
 	log.Printf("Getting replication controller %s pods in namespace %s", rcName, namespace)
 
-	pods, err := getRawReplicationControllerPods(client, rcName, namespace)
+	pods, err := getRawReplicationControllerPods(ctx, client, rcName, namespace)
 	if err != nil {
 		return pod.EmptyPodList, err
 	}
 
-	events, err := event.GetPodsEvents(client, namespace, pods)
+	events, err := event.GetPodsEvents(ctx, client, namespace, pods)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	podList := pod.ToPodList(pods, events, nonCriticalErrors, dsQuery, metricClient)
+	podList := pod.ToPodList(ctx, pods, events, nonCriticalErrors, dsQuery, metricClient)
 	return &podList, nil
 }
 
-func getRawReplicationControllerPods(client k8sClient.Interface, rcName, namespace string) ([]v1.Pod, error) {
-	rc, err := client.CoreV1().ReplicationControllers(namespace).Get(context.TODO(), rcName, metaV1.GetOptions{})
+func getRawReplicationControllerPods(ctx context.Context, client k8sClient.Interface, rcName, namespace string) ([]v1.Pod, error) {
+	rc, err := client.CoreV1().ReplicationControllers(namespace).Get(ctx, rcName, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	channels := &common.ResourceChannels{
-		PodList: common.GetPodListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		PodList: common.GetPodListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
 	podList := <-channels.PodList.List
@@ -71,10 +71,10 @@ func getRawReplicationControllerPods(client k8sClient.Interface, rcName, namespa
 
 // getReplicationControllerPodInfo returns simple info about pods(running, desired, failing, etc.)
 // related to given replication controller.
-func getReplicationControllerPodInfo(client k8sClient.Interface, rc *v1.ReplicationController,
+func getReplicationControllerPodInfo(ctx context.Context, client k8sClient.Interface, rc *v1.ReplicationController,
 	namespace string) (*common.PodInfo, error) {
 
-	pods, err := getRawReplicationControllerPods(client, rc.Name, namespace)
+	pods, err := getRawReplicationControllerPods(ctx, client, rc.Name, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -34,8 +34,8 @@ type RoleDetail struct {
 }
 
 // GetRoleDetail gets Role details.
-func GetRoleDetail(client k8sClient.Interface, namespace, name string) (*RoleDetail, error) {
-	rawObject, err := client.RbacV1().Roles(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+func GetRoleDetail(ctx context.Context, client k8sClient.Interface, namespace, name string) (*RoleDetail, error) {
+	rawObject, err := client.RbacV1().Roles(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
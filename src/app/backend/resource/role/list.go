@@ -15,6 +15,7 @@
 package role
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -42,10 +43,10 @@ type Role struct {
 }
 
 // GetRoleList returns a list of all Roles in the cluster.
-func GetRoleList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*RoleList, error) {
+func GetRoleList(ctx context.Context, client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*RoleList, error) {
 	log.Print("Getting list of all roles in the cluster")
 	channels := &common.ResourceChannels{
-		RoleList: common.GetRoleListChannel(client, nsQuery, 1),
+		RoleList: common.GetRoleListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
 	}
 
 	return GetRoleListFromChannels(channels, dsQuery)
@@ -61,6 +62,7 @@ func GetRoleListFromChannels(channels *common.ResourceChannels, dsQuery *datasel
 		return nil, criticalError
 	}
 	roleList := toRoleList(roles.Items, nonCriticalErrors, dsQuery)
+	roleList.ListMeta = dsQuery.CursorListMeta(roleList.ListMeta, roles)
 	return roleList, nil
 }
 
@@ -36,8 +36,8 @@ type RoleBindingDetail struct {
 }
 
 // GetRoleBindingDetail gets RoleBinding details.
-func GetRoleBindingDetail(client k8sClient.Interface, namespace, name string) (*RoleBindingDetail, error) {
-	rawObject, err := client.RbacV1().RoleBindings(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+func GetRoleBindingDetail(ctx context.Context, client k8sClient.Interface, namespace, name string) (*RoleBindingDetail, error) {
+	rawObject, err := client.RbacV1().RoleBindings(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@
 package rolebinding
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -42,10 +43,10 @@ type RoleBinding struct {
 }
 
 // GetRoleBindingList returns a list of all RoleBindings in the cluster.
-func GetRoleBindingList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*RoleBindingList, error) {
+func GetRoleBindingList(ctx context.Context, client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*RoleBindingList, error) {
 	log.Print("Getting list of all roleBindings in the cluster")
 	channels := &common.ResourceChannels{
-		RoleBindingList: common.GetRoleBindingListChannel(client, nsQuery, 1),
+		RoleBindingList: common.GetRoleBindingListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
 	}
 
 	return GetRoleBindingListFromChannels(channels, dsQuery)
@@ -61,6 +62,7 @@ func GetRoleBindingListFromChannels(channels *common.ResourceChannels, dsQuery *
 		return nil, criticalError
 	}
 	roleBindingList := toRoleBindingList(roleBindings.Items, nonCriticalErrors, dsQuery)
+	roleBindingList.ListMeta = dsQuery.CursorListMeta(roleBindingList.ListMeta, roleBindings)
 	return roleBindingList, nil
 }
 
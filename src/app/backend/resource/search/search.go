@@ -0,0 +1,468 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	client "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/customresourcedefinition"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+// MaxItemsPerKind is the maximum number of results returned for a single resource kind. The total
+// number of matches is still reported in the list meta of the group.
+const MaxItemsPerKind = 20
+
+// maxConcurrentCRDRequests limits the number of custom resource lists fetched at the same time.
+const maxConcurrentCRDRequests = 10
+
+// lastAppliedConfigAnnotation holds the whole object as JSON, so matching it would match nearly
+// every object that mentions the query anywhere in its spec.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Match scores. The higher the score, the better the match.
+const (
+	scoreNameExact       = 100
+	scoreNamePrefix      = 80
+	scoreNameContains    = 60
+	scoreLabelExact      = 40
+	scoreLabelContains   = 30
+	scoreAnnotationMatch = 10
+)
+
+// MatchField is the part of the object metadata that matched the query.
+type MatchField string
+
+// List of fields that can be matched by the search query.
+const (
+	MatchFieldName       MatchField = "name"
+	MatchFieldLabel      MatchField = "label"
+	MatchFieldAnnotation MatchField = "annotation"
+)
+
+// SearchResult contains results of a search grouped by resource kind. Groups are ordered by their
+// best match.
+type SearchResult struct {
+	// Query that was used to search.
+	Query string `json:"query"`
+
+	// Total number of matches in all groups.
+	ListMeta api.ListMeta `json:"listMeta"`
+
+	// Groups of results, one per resource kind.
+	Groups []ResultGroup `json:"groups"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ResultGroup contains matched objects of a single resource kind.
+type ResultGroup struct {
+	Kind api.ResourceKind `json:"kind"`
+
+	// Total number of matches of this kind. Only MaxItemsPerKind of them are returned.
+	ListMeta api.ListMeta `json:"listMeta"`
+
+	// Matched objects ordered by score.
+	Items []ResultItem `json:"items"`
+}
+
+// ResultItem is a single object matched by the search query.
+type ResultItem struct {
+	ObjectMeta api.ObjectMeta `json:"objectMeta"`
+	TypeMeta   api.TypeMeta   `json:"typeMeta"`
+
+	// Score of the match. The higher the score, the better the match.
+	Score int `json:"score"`
+
+	// Part of the metadata that matched the query best.
+	MatchedBy MatchField `json:"matchedBy"`
+
+	// Path of the backend API that returns details of the object.
+	Link string `json:"link"`
+}
+
+// source reads a single list of objects from the resource channels.
+type source struct {
+	kind api.ResourceKind
+	read func() (runtime.Object, error)
+}
+
+// Search returns objects of all supported kinds, including custom resource objects, whose name,
+// labels or annotations match the given query. Errors caused by missing permissions to list some
+// of the kinds are returned as non-critical errors.
+func Search(ctx context.Context, client client.Interface, apiextensionsClient apiextensionsclientset.Interface, config *rest.Config,
+	nsQuery *common.NamespaceQuery, query string) (*SearchResult, error) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if len(query) == 0 {
+		return nil, errors.NewBadRequest("search query must not be empty")
+	}
+
+	// All lists are requested before any of them is read, so that they are fetched concurrently.
+	sources := getSources(ctx, client, nsQuery)
+	groups := make(map[api.ResourceKind][]ResultItem)
+	nonCriticalErrors := make([]error, 0)
+
+	for _, source := range sources {
+		list, err := source.read()
+		var criticalError error
+		nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+		if criticalError != nil {
+			return nil, criticalError
+		}
+		if err != nil {
+			continue
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			accessor, err := meta.Accessor(item)
+			if err != nil {
+				return nil, err
+			}
+
+			objectMeta := api.ObjectMeta{
+				Name:              accessor.GetName(),
+				Namespace:         accessor.GetNamespace(),
+				Labels:            accessor.GetLabels(),
+				Annotations:       accessor.GetAnnotations(),
+				CreationTimestamp: accessor.GetCreationTimestamp(),
+				UID:               accessor.GetUID(),
+			}
+			if result, ok := match(query, objectMeta); ok {
+				result.TypeMeta = api.NewTypeMeta(source.kind)
+				result.Link = getLink(source.kind, objectMeta)
+				groups[source.kind] = append(groups[source.kind], result)
+			}
+		}
+	}
+
+	if apiextensionsClient != nil {
+		crdGroups, crdErrors, err := searchCustomResources(ctx, apiextensionsClient, config, nsQuery, query)
+		if err != nil {
+			return nil, err
+		}
+		nonCriticalErrors = errors.MergeErrors(nonCriticalErrors, crdErrors)
+		for kind, items := range crdGroups {
+			groups[kind] = append(groups[kind], items...)
+		}
+	}
+
+	return toSearchResult(query, groups, nonCriticalErrors), nil
+}
+
+// getSources starts listing of all built-in kinds covered by the search. Cluster-scoped kinds are
+// searched only when the query is not limited to selected namespaces.
+func getSources(ctx context.Context, client client.Interface, nsQuery *common.NamespaceQuery) []source {
+	channels := &common.ResourceChannels{
+		PodList:                     common.GetPodListChannel(ctx, client, nsQuery, 1),
+		DeploymentList:              common.GetDeploymentListChannel(ctx, client, nsQuery, 1),
+		ReplicaSetList:              common.GetReplicaSetListChannel(ctx, client, nsQuery, 1),
+		ReplicationControllerList:   common.GetReplicationControllerListChannel(ctx, client, nsQuery, 1),
+		DaemonSetList:               common.GetDaemonSetListChannel(ctx, client, nsQuery, 1),
+		StatefulSetList:             common.GetStatefulSetListChannel(ctx, client, nsQuery, 1),
+		JobList:                     common.GetJobListChannel(ctx, client, nsQuery, 1),
+		CronJobList:                 common.GetCronJobListChannel(ctx, client, nsQuery, 1),
+		ServiceList:                 common.GetServiceListChannel(ctx, client, nsQuery, 1),
+		IngressList:                 common.GetIngressListChannel(ctx, client, nsQuery, 1),
+		ConfigMapList:               common.GetConfigMapListChannel(ctx, client, nsQuery, 1),
+		SecretList:                  common.GetSecretListChannel(ctx, client, nsQuery, 1),
+		PersistentVolumeClaimList:   common.GetPersistentVolumeClaimListChannel(ctx, client, nsQuery, 1),
+		HorizontalPodAutoscalerList: common.GetHorizontalPodAutoscalerListChannel(ctx, client, nsQuery, 1),
+		RoleList:                    common.GetRoleListChannel(ctx, client, nsQuery, 1),
+		RoleBindingList:             common.GetRoleBindingListChannel(ctx, client, nsQuery, 1),
+	}
+
+	sources := []source{
+		{api.ResourceKindPod, func() (runtime.Object, error) {
+			return <-channels.PodList.List, <-channels.PodList.Error
+		}},
+		{api.ResourceKindDeployment, func() (runtime.Object, error) {
+			return <-channels.DeploymentList.List, <-channels.DeploymentList.Error
+		}},
+		{api.ResourceKindReplicaSet, func() (runtime.Object, error) {
+			return <-channels.ReplicaSetList.List, <-channels.ReplicaSetList.Error
+		}},
+		{api.ResourceKindReplicationController, func() (runtime.Object, error) {
+			return <-channels.ReplicationControllerList.List, <-channels.ReplicationControllerList.Error
+		}},
+		{api.ResourceKindDaemonSet, func() (runtime.Object, error) {
+			return <-channels.DaemonSetList.List, <-channels.DaemonSetList.Error
+		}},
+		{api.ResourceKindStatefulSet, func() (runtime.Object, error) {
+			return <-channels.StatefulSetList.List, <-channels.StatefulSetList.Error
+		}},
+		{api.ResourceKindJob, func() (runtime.Object, error) {
+			return <-channels.JobList.List, <-channels.JobList.Error
+		}},
+		{api.ResourceKindCronJob, func() (runtime.Object, error) {
+			return <-channels.CronJobList.List, <-channels.CronJobList.Error
+		}},
+		{api.ResourceKindService, func() (runtime.Object, error) {
+			return <-channels.ServiceList.List, <-channels.ServiceList.Error
+		}},
+		{api.ResourceKindIngress, func() (runtime.Object, error) {
+			return <-channels.IngressList.List, <-channels.IngressList.Error
+		}},
+		{api.ResourceKindConfigMap, func() (runtime.Object, error) {
+			return <-channels.ConfigMapList.List, <-channels.ConfigMapList.Error
+		}},
+		{api.ResourceKindSecret, func() (runtime.Object, error) {
+			return <-channels.SecretList.List, <-channels.SecretList.Error
+		}},
+		{api.ResourceKindPersistentVolumeClaim, func() (runtime.Object, error) {
+			return <-channels.PersistentVolumeClaimList.List, <-channels.PersistentVolumeClaimList.Error
+		}},
+		{api.ResourceKindHorizontalPodAutoscaler, func() (runtime.Object, error) {
+			return <-channels.HorizontalPodAutoscalerList.List, <-channels.HorizontalPodAutoscalerList.Error
+		}},
+		{api.ResourceKindRole, func() (runtime.Object, error) {
+			return <-channels.RoleList.List, <-channels.RoleList.Error
+		}},
+		{api.ResourceKindRoleBinding, func() (runtime.Object, error) {
+			return <-channels.RoleBindingList.List, <-channels.RoleBindingList.Error
+		}},
+	}
+
+	if nsQuery.ToRequestParam() != "" {
+		return sources
+	}
+
+	channels.NamespaceList = common.GetNamespaceListChannel(ctx, client, 1)
+	channels.NodeList = common.GetNodeListChannel(ctx, client, 1)
+	channels.PersistentVolumeList = common.GetPersistentVolumeListChannel(ctx, client, 1)
+	channels.StorageClassList = common.GetStorageClassListChannel(ctx, client, 1)
+	channels.IngressClassList = common.GetIngressClassListChannel(ctx, client, 1)
+	channels.ClusterRoleList = common.GetClusterRoleListChannel(ctx, client, 1)
+	channels.ClusterRoleBindingList = common.GetClusterRoleBindingListChannel(ctx, client, 1)
+
+	return append(sources,
+		source{api.ResourceKindNamespace, func() (runtime.Object, error) {
+			return <-channels.NamespaceList.List, <-channels.NamespaceList.Error
+		}},
+		source{api.ResourceKindNode, func() (runtime.Object, error) {
+			return <-channels.NodeList.List, <-channels.NodeList.Error
+		}},
+		source{api.ResourceKindPersistentVolume, func() (runtime.Object, error) {
+			return <-channels.PersistentVolumeList.List, <-channels.PersistentVolumeList.Error
+		}},
+		source{api.ResourceKindStorageClass, func() (runtime.Object, error) {
+			return <-channels.StorageClassList.List, <-channels.StorageClassList.Error
+		}},
+		source{api.ResourceKindIngressClass, func() (runtime.Object, error) {
+			return <-channels.IngressClassList.List, <-channels.IngressClassList.Error
+		}},
+		source{api.ResourceKindClusterRole, func() (runtime.Object, error) {
+			return <-channels.ClusterRoleList.List, <-channels.ClusterRoleList.Error
+		}},
+		source{api.ResourceKindClusterRoleBinding, func() (runtime.Object, error) {
+			return <-channels.ClusterRoleBindingList.List, <-channels.ClusterRoleBindingList.Error
+		}},
+	)
+}
+
+// searchCustomResources matches custom resource definitions and objects of all served custom
+// resource definitions. Objects are grouped by the full name of their definition.
+func searchCustomResources(ctx context.Context, client apiextensionsclientset.Interface, config *rest.Config,
+	nsQuery *common.NamespaceQuery, query string) (map[api.ResourceKind][]ResultItem, []error, error) {
+	groups := make(map[api.ResourceKind][]ResultItem)
+
+	crdList, err := customresourcedefinition.GetCustomResourceDefinitionList(ctx, client, dataselect.NoDataSelect)
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, nil, criticalError
+	}
+	if crdList == nil {
+		return groups, nonCriticalErrors, nil
+	}
+	nonCriticalErrors = errors.MergeErrors(nonCriticalErrors, crdList.Errors)
+
+	type objectsResult struct {
+		kind  api.ResourceKind
+		items []ResultItem
+		err   error
+	}
+
+	results := make(chan objectsResult, len(crdList.Items))
+	semaphore := make(chan struct{}, maxConcurrentCRDRequests)
+	for _, crd := range crdList.Items {
+		if result, ok := match(query, crd.ObjectMeta); ok {
+			result.TypeMeta = crd.TypeMeta
+			result.Link = getLink(api.ResourceKindCustomResourceDefinition, crd.ObjectMeta)
+			groups[api.ResourceKindCustomResourceDefinition] = append(groups[api.ResourceKindCustomResourceDefinition], result)
+		}
+
+		go func(crdName string) {
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			list, err := customresourcedefinition.GetCustomResourceObjectList(ctx, client, config, nsQuery, dataselect.NoDataSelect, crdName)
+			if err != nil {
+				results <- objectsResult{err: err}
+				return
+			}
+
+			var items []ResultItem
+			for _, object := range list.Items {
+				if result, ok := match(query, object.ObjectMeta); ok {
+					result.TypeMeta = object.TypeMeta
+					result.Link = getCustomResourceObjectLink(crdName, object.ObjectMeta)
+					items = append(items, result)
+				}
+			}
+			results <- objectsResult{kind: api.ResourceKind(crdName), items: items}
+		}(crd.ObjectMeta.Name)
+	}
+
+	for range crdList.Items {
+		result := <-results
+		nonCriticalErrors, criticalError = errors.AppendError(result.err, nonCriticalErrors)
+		if criticalError != nil {
+			return nil, nil, criticalError
+		}
+		if len(result.items) > 0 {
+			groups[result.kind] = append(groups[result.kind], result.items...)
+		}
+	}
+
+	return groups, nonCriticalErrors, nil
+}
+
+// match checks whether the name, labels or annotations of the object contain the lowercase query
+// and returns the best scored match.
+func match(query string, objectMeta api.ObjectMeta) (ResultItem, bool) {
+	result := ResultItem{ObjectMeta: objectMeta}
+
+	name := strings.ToLower(objectMeta.Name)
+	switch {
+	case name == query:
+		result.Score, result.MatchedBy = scoreNameExact, MatchFieldName
+	case strings.HasPrefix(name, query):
+		result.Score, result.MatchedBy = scoreNamePrefix, MatchFieldName
+	case strings.Contains(name, query):
+		result.Score, result.MatchedBy = scoreNameContains, MatchFieldName
+	}
+	if result.Score > 0 {
+		return result, true
+	}
+
+	for key, value := range objectMeta.Labels {
+		key, value = strings.ToLower(key), strings.ToLower(value)
+		score := 0
+		switch {
+		case value == query || key+"="+value == query:
+			score = scoreLabelExact
+		case strings.Contains(key, query) || strings.Contains(value, query) || strings.Contains(key+"="+value, query):
+			score = scoreLabelContains
+		}
+		if score > result.Score {
+			result.Score, result.MatchedBy = score, MatchFieldLabel
+		}
+	}
+	if result.Score > 0 {
+		return result, true
+	}
+
+	for key, value := range objectMeta.Annotations {
+		if key == lastAppliedConfigAnnotation {
+			continue
+		}
+		if strings.Contains(strings.ToLower(key), query) || strings.Contains(strings.ToLower(value), query) {
+			result.Score, result.MatchedBy = scoreAnnotationMatch, MatchFieldAnnotation
+			return result, true
+		}
+	}
+
+	return result, false
+}
+
+// getLink returns path of the backend API that returns details of the given object.
+func getLink(kind api.ResourceKind, objectMeta api.ObjectMeta) string {
+	if kind == api.ResourceKindCustomResourceDefinition {
+		return "/api/v1/crd/" + objectMeta.Name
+	}
+	if mapping, ok := api.KindToAPIMapping[string(kind)]; ok && mapping.Namespaced {
+		return "/api/v1/" + string(kind) + "/" + objectMeta.Namespace + "/" + objectMeta.Name
+	}
+	return "/api/v1/" + string(kind) + "/" + objectMeta.Name
+}
+
+// getCustomResourceObjectLink returns path of the backend API that returns details of the given
+// custom resource object. Cluster-scoped objects use "_all" as their namespace.
+func getCustomResourceObjectLink(crdName string, objectMeta api.ObjectMeta) string {
+	namespace := objectMeta.Namespace
+	if len(namespace) == 0 {
+		namespace = "_all"
+	}
+	return "/api/v1/crd/" + namespace + "/" + crdName + "/" + objectMeta.Name
+}
+
+// toSearchResult sorts the matched objects and groups and limits the number of items per group.
+func toSearchResult(query string, groups map[api.ResourceKind][]ResultItem, nonCriticalErrors []error) *SearchResult {
+	result := &SearchResult{
+		Query:  query,
+		Groups: make([]ResultGroup, 0, len(groups)),
+		Errors: nonCriticalErrors,
+	}
+
+	for kind, items := range groups {
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].Score != items[j].Score {
+				return items[i].Score > items[j].Score
+			}
+			if items[i].ObjectMeta.Namespace != items[j].ObjectMeta.Namespace {
+				return items[i].ObjectMeta.Namespace < items[j].ObjectMeta.Namespace
+			}
+			return items[i].ObjectMeta.Name < items[j].ObjectMeta.Name
+		})
+
+		group := ResultGroup{
+			Kind:     kind,
+			ListMeta: api.ListMeta{TotalItems: len(items)},
+			Items:    items,
+		}
+		if len(items) > MaxItemsPerKind {
+			group.Items = items[:MaxItemsPerKind]
+		}
+
+		result.ListMeta.TotalItems += len(items)
+		result.Groups = append(result.Groups, group)
+	}
+
+	sort.SliceStable(result.Groups, func(i, j int) bool {
+		left, right := result.Groups[i].Items[0].Score, result.Groups[j].Items[0].Score
+		if left != right {
+			return left > right
+		}
+		return result.Groups[i].Kind < result.Groups[j].Kind
+	})
+
+	return result
+}
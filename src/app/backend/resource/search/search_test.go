@@ -0,0 +1,163 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+)
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		query         string
+		objectMeta    api.ObjectMeta
+		expectedScore int
+		expectedField MatchField
+		expectedMatch bool
+	}{
+		{"checkout", api.ObjectMeta{Name: "checkout"}, scoreNameExact, MatchFieldName, true},
+		{"checkout", api.ObjectMeta{Name: "Checkout-v2"}, scoreNamePrefix, MatchFieldName, true},
+		{"checkout", api.ObjectMeta{Name: "shop-checkout"}, scoreNameContains, MatchFieldName, true},
+		{"checkout", api.ObjectMeta{Name: "web", Labels: map[string]string{"app": "checkout"}},
+			scoreLabelExact, MatchFieldLabel, true},
+		{"app=checkout", api.ObjectMeta{Name: "web", Labels: map[string]string{"app": "checkout"}},
+			scoreLabelExact, MatchFieldLabel, true},
+		{"checkout", api.ObjectMeta{Name: "web", Labels: map[string]string{"checkout-team": "a"}},
+			scoreLabelContains, MatchFieldLabel, true},
+		{"checkout", api.ObjectMeta{Name: "web", Annotations: map[string]string{"owner": "Checkout team"}},
+			scoreAnnotationMatch, MatchFieldAnnotation, true},
+		{"checkout", api.ObjectMeta{Name: "web",
+			Annotations: map[string]string{lastAppliedConfigAnnotation: `{"name":"checkout"}`}}, 0, "", false},
+		{"checkout", api.ObjectMeta{Name: "web"}, 0, "", false},
+	}
+
+	for _, c := range cases {
+		actual, ok := match(c.query, c.objectMeta)
+		if ok != c.expectedMatch || actual.Score != c.expectedScore || actual.MatchedBy != c.expectedField {
+			t.Errorf("match(%s, %#v) == (%d, %s, %t), expected (%d, %s, %t)", c.query, c.objectMeta,
+				actual.Score, actual.MatchedBy, ok, c.expectedScore, c.expectedField, c.expectedMatch)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Name: "checkout-1", Namespace: "shop"}},
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Name: "web", Namespace: "shop",
+			Labels: map[string]string{"app": "checkout"}}},
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Name: "cart", Namespace: "shop"}},
+		&apps.Deployment{ObjectMeta: metaV1.ObjectMeta{Name: "checkout", Namespace: "shop"}},
+		&v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "node-checkout"}},
+		&v1.Secret{ObjectMeta: metaV1.ObjectMeta{Name: "checkout-token", Namespace: "shop"}},
+	)
+	client.PrependReactor("list", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &v1.SecretList{}, k8serrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", nil)
+	})
+
+	actual, err := Search(context.TODO(), client, nil, nil, common.NewNamespaceQuery(nil), " Checkout ")
+	if err != nil {
+		t.Fatalf("Search() returned error: %s", err)
+	}
+
+	expected := []ResultGroup{
+		{
+			Kind:     api.ResourceKindDeployment,
+			ListMeta: api.ListMeta{TotalItems: 1},
+			Items: []ResultItem{{
+				ObjectMeta: api.ObjectMeta{Name: "checkout", Namespace: "shop"},
+				TypeMeta:   api.NewTypeMeta(api.ResourceKindDeployment),
+				Score:      scoreNameExact,
+				MatchedBy:  MatchFieldName,
+				Link:       "/api/v1/deployment/shop/checkout",
+			}},
+		},
+		{
+			Kind:     api.ResourceKindPod,
+			ListMeta: api.ListMeta{TotalItems: 2},
+			Items: []ResultItem{
+				{
+					ObjectMeta: api.ObjectMeta{Name: "checkout-1", Namespace: "shop"},
+					TypeMeta:   api.NewTypeMeta(api.ResourceKindPod),
+					Score:      scoreNamePrefix,
+					MatchedBy:  MatchFieldName,
+					Link:       "/api/v1/pod/shop/checkout-1",
+				},
+				{
+					ObjectMeta: api.ObjectMeta{Name: "web", Namespace: "shop", Labels: map[string]string{"app": "checkout"}},
+					TypeMeta:   api.NewTypeMeta(api.ResourceKindPod),
+					Score:      scoreLabelExact,
+					MatchedBy:  MatchFieldLabel,
+					Link:       "/api/v1/pod/shop/web",
+				},
+			},
+		},
+		{
+			Kind:     api.ResourceKindNode,
+			ListMeta: api.ListMeta{TotalItems: 1},
+			Items: []ResultItem{{
+				ObjectMeta: api.ObjectMeta{Name: "node-checkout"},
+				TypeMeta:   api.NewTypeMeta(api.ResourceKindNode),
+				Score:      scoreNameContains,
+				MatchedBy:  MatchFieldName,
+				Link:       "/api/v1/node/node-checkout",
+			}},
+		},
+	}
+
+	if !reflect.DeepEqual(actual.Groups, expected) {
+		t.Errorf("Search() groups == \n%#v\nexpected \n%#v", actual.Groups, expected)
+	}
+	if actual.ListMeta.TotalItems != 4 {
+		t.Errorf("Search() total items == %d, expected 4", actual.ListMeta.TotalItems)
+	}
+	if len(actual.Errors) != 1 {
+		t.Errorf("Search() should return forbidden secret list as a non-critical error, got %v", actual.Errors)
+	}
+}
+
+func TestSearchNamespacedSkipsClusterScopedKinds(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "checkout"}},
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Name: "checkout", Namespace: "other"}},
+	)
+
+	actual, err := Search(context.TODO(), client, nil, nil, common.NewSameNamespaceQuery("shop"), "checkout")
+	if err != nil {
+		t.Fatalf("Search() returned error: %s", err)
+	}
+	if len(actual.Groups) != 0 {
+		t.Errorf("Search() in namespace should not return other namespaces or cluster-scoped kinds, got %#v",
+			actual.Groups)
+	}
+}
+
+func TestSearchEmptyQuery(t *testing.T) {
+	if _, err := Search(context.TODO(), fake.NewSimpleClientset(), nil, nil, common.NewNamespaceQuery(nil), "  "); err == nil {
+		t.Error("Search() with empty query should return an error")
+	}
+}
@@ -37,10 +37,10 @@ type SecretDetail struct {
 }
 
 // GetSecretDetail returns detailed information about a secret
-func GetSecretDetail(client kubernetes.Interface, namespace, name string) (*SecretDetail, error) {
+func GetSecretDetail(ctx context.Context, client kubernetes.Interface, namespace, name string) (*SecretDetail, error) {
 	log.Printf("Getting details of %s secret in %s namespace\n", name, namespace)
 
-	rawSecret, err := client.CoreV1().Secrets(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	rawSecret, err := client.CoreV1().Secrets(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -83,21 +83,38 @@ type SecretList struct {
 }
 
 // GetSecretList returns all secrets in the given namespace.
-func GetSecretList(client kubernetes.Interface, namespace *common.NamespaceQuery,
+func GetSecretList(ctx context.Context, client kubernetes.Interface, namespace *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery) (*SecretList, error) {
 	log.Printf("Getting list of secrets in %s namespace\n", namespace)
-	secretList, err := client.CoreV1().Secrets(namespace.ToRequestParam()).List(context.TODO(), api.ListEverything)
+	secretList, err := client.CoreV1().Secrets(namespace.ToRequestParam()).List(ctx, dsQuery.ListOptions())
 
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	return ToSecretList(secretList.Items, nonCriticalErrors, dsQuery), nil
+	result := ToSecretList(secretList.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, secretList)
+	return result, nil
+}
+
+// GetSecretListFromChannels returns a list of all secrets in the cluster reading required
+// resource list once from the channels.
+func GetSecretListFromChannels(channels *common.ResourceChannels, dsQuery *dataselect.DataSelectQuery) (*SecretList, error) {
+	secretList := <-channels.SecretList.List
+	err := <-channels.SecretList.Error
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	result := ToSecretList(secretList.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, secretList)
+	return result, nil
 }
 
 // CreateSecret creates a single secret using the cluster API client
-func CreateSecret(client kubernetes.Interface, spec SecretSpec) (*Secret, error) {
+func CreateSecret(ctx context.Context, client kubernetes.Interface, spec SecretSpec) (*Secret, error) {
 	namespace := spec.GetNamespace()
 	secret := &v1.Secret{
 		ObjectMeta: metaV1.ObjectMeta{
@@ -107,7 +124,7 @@ func CreateSecret(client kubernetes.Interface, spec SecretSpec) (*Secret, error)
 		Type: spec.GetType(),
 		Data: spec.GetData(),
 	}
-	_, err := client.CoreV1().Secrets(namespace).Create(context.TODO(), secret, metaV1.CreateOptions{})
+	_, err := client.CoreV1().Secrets(namespace).Create(ctx, secret, metaV1.CreateOptions{})
 	result := toSecret(secret)
 	return &result, err
 }
@@ -41,14 +41,14 @@ type ServiceDetail struct {
 }
 
 // GetServiceDetail gets service details.
-func GetServiceDetail(client k8sClient.Interface, namespace, name string) (*ServiceDetail, error) {
+func GetServiceDetail(ctx context.Context, client k8sClient.Interface, namespace, name string) (*ServiceDetail, error) {
 	log.Printf("Getting details of %s service in %s namespace", name, namespace)
-	serviceData, err := client.CoreV1().Services(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	serviceData, err := client.CoreV1().Services(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	endpointList, err := endpoint.GetServiceEndpoints(client, namespace, name)
+	endpointList, err := endpoint.GetServiceEndpoints(ctx, client, namespace, name)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
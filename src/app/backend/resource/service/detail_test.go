@@ -15,6 +15,7 @@
 package service
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -90,7 +91,7 @@ func TestGetServiceDetail(t *testing.T) {
 
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.service)
-		actual, _ := GetServiceDetail(fakeClient, c.namespace, c.name)
+		actual, _ := GetServiceDetail(context.TODO(), fakeClient, c.namespace, c.name)
 		actions := fakeClient.Actions()
 
 		if len(actions) != len(c.expectedActions) {
@@ -15,6 +15,7 @@
 package service
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -25,14 +26,14 @@ import (
 )
 
 // GetServiceEvents returns model events for a service with the given name in the given namespace.
-func GetServiceEvents(client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
+func GetServiceEvents(ctx context.Context, client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
 	*common.EventList, error) {
 	eventList := common.EventList{
 		Events:   make([]common.Event, 0),
 		ListMeta: api.ListMeta{TotalItems: 0},
 	}
 
-	serviceEvents, err := event.GetEvents(client, namespace, name)
+	serviceEvents, err := event.GetEvents(ctx, client, namespace, name)
 	if err != nil {
 		return &eventList, err
 	}
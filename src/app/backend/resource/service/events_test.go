@@ -15,6 +15,7 @@
 package service
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -61,7 +62,7 @@ func TestServiceEvents(t *testing.T) {
 
 		fakeClient := fake.NewSimpleClientset(c.eventList, c.service)
 
-		actual, _ := GetServiceEvents(fakeClient, dataselect.NoDataSelect, c.namespace, c.name)
+		actual, _ := GetServiceEvents(context.TODO(), fakeClient, dataselect.NoDataSelect, c.namespace, c.name)
 
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetServiceEvents(client,%#v, %#v) == \ngot: %#v, \nexpected %#v",
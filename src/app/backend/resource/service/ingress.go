@@ -15,6 +15,7 @@
 package service
 
 import (
+	"context"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
@@ -22,11 +23,11 @@ import (
 	client "k8s.io/client-go/kubernetes"
 )
 
-func GetServiceIngressList(client client.Interface, dsQuery *dataselect.DataSelectQuery,
+func GetServiceIngressList(ctx context.Context, client client.Interface, dsQuery *dataselect.DataSelectQuery,
 	namespace, serviceName string) (*ingress.IngressList, error) {
 
 	channels := &common.ResourceChannels{
-		IngressList: common.GetIngressListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		IngressList: common.GetIngressListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
 	ingressList := <-channels.IngressList.List
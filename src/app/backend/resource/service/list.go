@@ -15,6 +15,7 @@
 package service
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -61,12 +62,12 @@ type ServiceList struct {
 }
 
 // GetServiceList returns a list of all services in the cluster.
-func GetServiceList(client client.Interface, nsQuery *common.NamespaceQuery,
+func GetServiceList(ctx context.Context, client client.Interface, nsQuery *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery) (*ServiceList, error) {
 	log.Print("Getting list of all services in the cluster")
 
 	channels := &common.ResourceChannels{
-		ServiceList: common.GetServiceListChannel(client, nsQuery, 1),
+		ServiceList: common.GetServiceListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
 	}
 
 	return GetServiceListFromChannels(channels, dsQuery)
@@ -82,7 +83,9 @@ func GetServiceListFromChannels(channels *common.ResourceChannels,
 		return nil, criticalError
 	}
 
-	return CreateServiceList(services.Items, nonCriticalErrors, dsQuery), nil
+	result := CreateServiceList(services.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, services)
+	return result, nil
 }
 
 func toService(service *v1.Service) Service {
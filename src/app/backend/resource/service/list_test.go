@@ -15,6 +15,7 @@
 package service
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -65,7 +66,7 @@ func TestGetServiceList(t *testing.T) {
 
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.serviceList)
-		actual, _ := GetServiceList(fakeClient, common.NewNamespaceQuery(nil), dataselect.NoDataSelect)
+		actual, _ := GetServiceList(context.TODO(), fakeClient, common.NewNamespaceQuery(nil), dataselect.NoDataSelect)
 		actions := fakeClient.Actions()
 
 		if len(actions) != len(c.expectedActions) {
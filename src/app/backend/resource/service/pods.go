@@ -30,14 +30,14 @@ import (
 )
 
 // GetServicePods gets list of pods targeted by given label selector in given namespace.
-func GetServicePods(client k8sClient.Interface, metricClient metricapi.MetricClient, namespace,
+func GetServicePods(ctx context.Context, client k8sClient.Interface, metricClient metricapi.MetricClient, namespace,
 	name string, dsQuery *dataselect.DataSelectQuery) (*pod.PodList, error) {
 	podList := pod.PodList{
 		Pods:              []pod.Pod{},
 		CumulativeMetrics: []metricapi.Metric{},
 	}
 
-	service, err := client.CoreV1().Services(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	service, err := client.CoreV1().Services(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return &podList, err
 	}
@@ -48,7 +48,7 @@ func GetServicePods(client k8sClient.Interface, metricClient metricapi.MetricCli
 
 	labelSelector := labels.SelectorFromSet(service.Spec.Selector)
 	channels := &common.ResourceChannels{
-		PodList: common.GetPodListChannelWithOptions(client, common.NewSameNamespaceQuery(namespace),
+		PodList: common.GetPodListChannelWithOptions(ctx, client, common.NewSameNamespaceQuery(namespace),
 			metaV1.ListOptions{
 				LabelSelector: labelSelector.String(),
 				FieldSelector: fields.Everything().String(),
@@ -60,12 +60,12 @@ func GetServicePods(client k8sClient.Interface, metricClient metricapi.MetricCli
 		return &podList, err
 	}
 
-	events, err := event.GetPodsEvents(client, namespace, apiPodList.Items)
+	events, err := event.GetPodsEvents(ctx, client, namespace, apiPodList.Items)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return &podList, criticalError
 	}
 
-	podList = pod.ToPodList(apiPodList.Items, events, nonCriticalErrors, dsQuery, metricClient)
+	podList = pod.ToPodList(ctx, apiPodList.Items, events, nonCriticalErrors, dsQuery, metricClient)
 	return &podList, nil
 }
@@ -15,6 +15,7 @@
 package service
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -69,7 +70,7 @@ func TestGetServicePods(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.service, c.podList)
 
-		actual, _ := GetServicePods(fakeClient, nil, c.namespace, c.name, dataselect.NoDataSelect)
+		actual, _ := GetServicePods(context.TODO(), fakeClient, nil, c.namespace, c.name, dataselect.NoDataSelect)
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetServicePods == \ngot %#v, \nexpected %#v", actual, c.expected)
 		}
@@ -30,10 +30,10 @@ type ServiceAccountDetail struct {
 }
 
 // GetServiceAccountDetail returns detailed information about a service account.
-func GetServiceAccountDetail(client client.Interface, namespace, name string) (*ServiceAccountDetail, error) {
+func GetServiceAccountDetail(ctx context.Context, client client.Interface, namespace, name string) (*ServiceAccountDetail, error) {
 	log.Printf("Getting details of %s service account in %s namespace", name, namespace)
 
-	raw, err := client.CoreV1().ServiceAccounts(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	raw, err := client.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
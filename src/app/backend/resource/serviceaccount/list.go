@@ -40,17 +40,19 @@ type ServiceAccountList struct {
 }
 
 // GetServiceAccountList lists service accounts from given namespace using given data select query.
-func GetServiceAccountList(client client.Interface, namespace *common.NamespaceQuery,
+func GetServiceAccountList(ctx context.Context, client client.Interface, namespace *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery) (*ServiceAccountList, error) {
-	saList, err := client.CoreV1().ServiceAccounts(namespace.ToRequestParam()).List(context.TODO(),
-		api.ListEverything)
+	saList, err := client.CoreV1().ServiceAccounts(namespace.ToRequestParam()).List(ctx,
+		dsQuery.ListOptions())
 
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	return toServiceAccountList(saList.Items, nonCriticalErrors, dsQuery), nil
+	result := toServiceAccountList(saList.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, saList)
+	return result, nil
 }
 
 func toServiceAccount(sa *v1.ServiceAccount) ServiceAccount {
@@ -26,13 +26,13 @@ import (
 )
 
 // GetServiceAccountImagePullSecrets list image pull secrets of given service account.
-func GetServiceAccountImagePullSecrets(client k8sClient.Interface, namespace,
+func GetServiceAccountImagePullSecrets(ctx context.Context, client k8sClient.Interface, namespace,
 	name string, dsQuery *dataselect.DataSelectQuery) (*secret.SecretList, error) {
 	imagePullSecretList := secret.SecretList{
 		Secrets: []secret.Secret{},
 	}
 
-	serviceAccount, err := client.CoreV1().ServiceAccounts(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	serviceAccount, err := client.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return &imagePullSecretList, err
 	}
@@ -42,7 +42,7 @@ func GetServiceAccountImagePullSecrets(client k8sClient.Interface, namespace,
 	}
 
 	channels := &common.ResourceChannels{
-		SecretList: common.GetSecretListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		SecretList: common.GetSecretListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
 	apiSecretList := <-channels.SecretList.List
@@ -70,13 +70,13 @@ func GetServiceAccountImagePullSecrets(client k8sClient.Interface, namespace,
 // the name field is used and most of the time other fields are empty. Because of that we are using only the name field
 // to find referenced objects assuming that the namespace is the same. ObjectReference is being slowly replaced with
 // more specific types.
-func GetServiceAccountSecrets(client k8sClient.Interface, namespace,
+func GetServiceAccountSecrets(ctx context.Context, client k8sClient.Interface, namespace,
 	name string, dsQuery *dataselect.DataSelectQuery) (*secret.SecretList, error) {
 	secretList := secret.SecretList{
 		Secrets: []secret.Secret{},
 	}
 
-	serviceAccount, err := client.CoreV1().ServiceAccounts(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	serviceAccount, err := client.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return &secretList, err
 	}
@@ -86,7 +86,7 @@ func GetServiceAccountSecrets(client k8sClient.Interface, namespace,
 	}
 
 	channels := &common.ResourceChannels{
-		SecretList: common.GetSecretListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		SecretList: common.GetSecretListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
 	apiSecretList := <-channels.SecretList.List
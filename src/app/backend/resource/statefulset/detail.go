@@ -36,16 +36,16 @@ type StatefulSetDetail struct {
 }
 
 // GetStatefulSetDetail gets Stateful Set details.
-func GetStatefulSetDetail(client kubernetes.Interface, metricClient metricapi.MetricClient, namespace,
+func GetStatefulSetDetail(ctx context.Context, client kubernetes.Interface, metricClient metricapi.MetricClient, namespace,
 	name string) (*StatefulSetDetail, error) {
 	log.Printf("Getting details of %s statefulset in %s namespace", name, namespace)
 
-	ss, err := client.AppsV1().StatefulSets(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	ss, err := client.AppsV1().StatefulSets(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	podInfo, err := getStatefulSetPodInfo(client, ss)
+	podInfo, err := getStatefulSetPodInfo(ctx, client, ss)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
@@ -15,6 +15,7 @@
 package statefulset
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -50,23 +51,23 @@ type StatefulSet struct {
 }
 
 // GetStatefulSetList returns a list of all Stateful Sets in the cluster.
-func GetStatefulSetList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+func GetStatefulSetList(ctx context.Context, client kubernetes.Interface, nsQuery *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*StatefulSetList, error) {
 	log.Print("Getting list of all pet sets in the cluster")
 
 	channels := &common.ResourceChannels{
-		StatefulSetList: common.GetStatefulSetListChannel(client, nsQuery, 1),
-		PodList:         common.GetPodListChannel(client, nsQuery, 1),
-		EventList:       common.GetEventListChannel(client, nsQuery, 1),
+		StatefulSetList: common.GetStatefulSetListChannelWithOptions(ctx, client, nsQuery, dsQuery.ListOptions(), 1),
+		PodList:         common.GetPodListChannel(ctx, client, nsQuery, 1),
+		EventList:       common.GetEventListChannel(ctx, client, nsQuery, 1),
 	}
 
-	return GetStatefulSetListFromChannels(channels, dsQuery, metricClient)
+	return GetStatefulSetListFromChannels(ctx, channels, dsQuery, metricClient)
 }
 
 // GetStatefulSetListFromChannels returns a list of all Stateful Sets in the cluster reading
 // required resource list once from the channels.
-func GetStatefulSetListFromChannels(channels *common.ResourceChannels, dsQuery *dataselect.DataSelectQuery,
-	metricClient metricapi.MetricClient) (*StatefulSetList, error) {
+func GetStatefulSetListFromChannels(ctx context.Context, channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*StatefulSetList, error) {
 
 	statefulSets := <-channels.StatefulSetList.List
 	err := <-channels.StatefulSetList.Error
@@ -89,13 +90,15 @@ func GetStatefulSetListFromChannels(channels *common.ResourceChannels, dsQuery *
 		return nil, criticalError
 	}
 
-	ssList := toStatefulSetList(statefulSets.Items, pods.Items, events.Items, nonCriticalErrors, dsQuery, metricClient)
+	ssList := toStatefulSetList(ctx, statefulSets.Items, pods.Items, events.Items, nonCriticalErrors, dsQuery, metricClient)
 	ssList.Status = getStatus(statefulSets, pods.Items, events.Items)
+	ssList.ListMeta = dsQuery.CursorListMeta(ssList.ListMeta, statefulSets)
 	return ssList, nil
 }
 
-func toStatefulSetList(statefulSets []apps.StatefulSet, pods []v1.Pod, events []v1.Event, nonCriticalErrors []error,
-	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) *StatefulSetList {
+func toStatefulSetList(ctx context.Context, statefulSets []apps.StatefulSet, pods []v1.Pod, events []v1.Event,
+	nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery,
+	metricClient metricapi.MetricClient) *StatefulSetList {
 
 	statefulSetList := &StatefulSetList{
 		StatefulSets: make([]StatefulSet, 0),
@@ -107,7 +110,7 @@ func toStatefulSetList(statefulSets []apps.StatefulSet, pods []v1.Pod, events []
 		Pods: pods,
 	}
 	ssCells, metricPromises, filteredTotal := dataselect.GenericDataSelectWithFilterAndMetrics(
-		toCells(statefulSets), dsQuery, cachedResources, metricClient)
+		ctx, toCells(statefulSets), dsQuery, cachedResources, metricClient)
 	statefulSets = fromCells(ssCells)
 	statefulSetList.ListMeta = api.ListMeta{TotalItems: filteredTotal}
 
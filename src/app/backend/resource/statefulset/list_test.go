@@ -15,6 +15,7 @@
 package statefulset
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -204,7 +205,7 @@ func TestGetStatefulSetListFromChannels(t *testing.T) {
 		channels.EventList.List <- &v1.EventList{}
 		channels.EventList.Error <- nil
 
-		actual, err := GetStatefulSetListFromChannels(channels, dataselect.NoDataSelect, nil)
+		actual, err := GetStatefulSetListFromChannels(context.TODO(), channels, dataselect.NoDataSelect, nil)
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetStatefulSetListChannels() ==\n          %#v\nExpected: %#v", actual, c.expected)
 		}
@@ -31,35 +31,35 @@ import (
 )
 
 // GetStatefulSetPods return list of pods targeting pet set.
-func GetStatefulSetPods(client kubernetes.Interface, metricClient metricapi.MetricClient,
+func GetStatefulSetPods(ctx context.Context, client kubernetes.Interface, metricClient metricapi.MetricClient,
 	dsQuery *dataselect.DataSelectQuery, name, namespace string) (*pod.PodList, error) {
 
 	log.Printf("Getting replication controller %s pods in namespace %s", name, namespace)
 
-	pods, err := getRawStatefulSetPods(client, name, namespace)
+	pods, err := getRawStatefulSetPods(ctx, client, name, namespace)
 	if err != nil {
 		return pod.EmptyPodList, err
 	}
 
-	events, err := event.GetPodsEvents(client, namespace, pods)
+	events, err := event.GetPodsEvents(ctx, client, namespace, pods)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	podList := pod.ToPodList(pods, events, nonCriticalErrors, dsQuery, metricClient)
+	podList := pod.ToPodList(ctx, pods, events, nonCriticalErrors, dsQuery, metricClient)
 	return &podList, nil
 }
 
 // getRawStatefulSetPods return array of api pods targeting pet set with given name.
-func getRawStatefulSetPods(client kubernetes.Interface, name, namespace string) ([]v1.Pod, error) {
-	statefulSet, err := client.AppsV1().StatefulSets(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+func getRawStatefulSetPods(ctx context.Context, client kubernetes.Interface, name, namespace string) ([]v1.Pod, error) {
+	statefulSet, err := client.AppsV1().StatefulSets(namespace).Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	channels := &common.ResourceChannels{
-		PodList: common.GetPodListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		PodList: common.GetPodListChannel(ctx, client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
 	podList := <-channels.PodList.List
@@ -71,8 +71,8 @@ func getRawStatefulSetPods(client kubernetes.Interface, name, namespace string)
 }
 
 // Returns simple info about pods(running, desired, failing, etc.) related to given pet set.
-func getStatefulSetPodInfo(client kubernetes.Interface, statefulSet *apps.StatefulSet) (*common.PodInfo, error) {
-	pods, err := getRawStatefulSetPods(client, statefulSet.Name, statefulSet.Namespace)
+func getStatefulSetPodInfo(ctx context.Context, client kubernetes.Interface, statefulSet *apps.StatefulSet) (*common.PodInfo, error) {
+	pods, err := getRawStatefulSetPods(ctx, client, statefulSet.Name, statefulSet.Namespace)
 	if err != nil {
 		return nil, err
 	}
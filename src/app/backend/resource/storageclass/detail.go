@@ -30,10 +30,10 @@ type StorageClassDetail struct {
 }
 
 // GetStorageClass returns Storage Class resource.
-func GetStorageClass(client kubernetes.Interface, name string) (*StorageClassDetail, error) {
+func GetStorageClass(ctx context.Context, client kubernetes.Interface, name string) (*StorageClassDetail, error) {
 	log.Printf("Getting details of %s storage class", name)
 
-	sc, err := client.StorageV1().StorageClasses().Get(context.TODO(), name, metaV1.GetOptions{})
+	sc, err := client.StorageV1().StorageClasses().Get(ctx, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
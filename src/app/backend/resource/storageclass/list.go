@@ -15,6 +15,7 @@
 package storageclass
 
 import (
+	"context"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -43,12 +44,12 @@ type StorageClass struct {
 }
 
 // GetStorageClassList returns a list of all storage class objects in the cluster.
-func GetStorageClassList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (
+func GetStorageClassList(ctx context.Context, client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (
 	*StorageClassList, error) {
 	log.Print("Getting list of storage classes in the cluster")
 
 	channels := &common.ResourceChannels{
-		StorageClassList: common.GetStorageClassListChannel(client, 1),
+		StorageClassList: common.GetStorageClassListChannelWithOptions(ctx, client, dsQuery.ListOptions(), 1),
 	}
 
 	return GetStorageClassListFromChannels(channels, dsQuery)
@@ -64,7 +65,9 @@ func GetStorageClassListFromChannels(channels *common.ResourceChannels,
 		return nil, criticalError
 	}
 
-	return toStorageClassList(storageClasses.Items, nonCriticalErrors, dsQuery), nil
+	result := toStorageClassList(storageClasses.Items, nonCriticalErrors, dsQuery)
+	result.ListMeta = dsQuery.CursorListMeta(result.ListMeta, storageClasses)
+	return result, nil
 }
 
 func toStorageClassList(storageClasses []storage.StorageClass, nonCriticalErrors []error,
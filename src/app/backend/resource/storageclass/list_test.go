@@ -15,6 +15,7 @@
 package storageclass
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -61,7 +62,7 @@ func TestGetStorageClassList(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.storageClassList)
 
-		actual, _ := GetStorageClassList(fakeClient, dataselect.NoDataSelect)
+		actual, _ := GetStorageClassList(context.TODO(), fakeClient, dataselect.NoDataSelect)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
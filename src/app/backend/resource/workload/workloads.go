@@ -0,0 +1,198 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workload
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	client "k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/cronjob"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/daemonset"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/deployment"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/job"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/pod"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/replicaset"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/replicationcontroller"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/statefulset"
+)
+
+// DefaultItemsPerPage is the size of the first page of every list, used when the request does
+// not set one.
+const DefaultItemsPerPage = 10
+
+// Workloads contains the first page of every workload list together with status summaries and
+// cumulative metrics, so that all workloads can be shown with a single request.
+type Workloads struct {
+	// Status summaries of the workload lists by resource kind.
+	Status map[api.ResourceKind]common.ResourceStatus `json:"status"`
+
+	// Cumulative metrics of all workloads. Workloads consume resources only through their pods,
+	// so these are the metrics of the pod list. Summing metrics of all lists would count every
+	// pod several times.
+	CumulativeMetrics []metricapi.Metric `json:"cumulativeMetrics"`
+
+	DeploymentList            *deployment.DeploymentList                       `json:"deploymentList"`
+	ReplicaSetList            *replicaset.ReplicaSetList                       `json:"replicaSetList"`
+	ReplicationControllerList *replicationcontroller.ReplicationControllerList `json:"replicationControllerList"`
+	DaemonSetList             *daemonset.DaemonSetList                         `json:"daemonSetList"`
+	StatefulSetList           *statefulset.StatefulSetList                     `json:"statefulSetList"`
+	JobList                   *job.JobList                                     `json:"jobList"`
+	CronJobList               *cronjob.CronJobList                             `json:"cronJobList"`
+	PodList                   *pod.PodList                                     `json:"podList"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetWorkloads returns the first page of every workload list in the given namespaces.
+func GetWorkloads(ctx context.Context, client client.Interface, metricClient metricapi.MetricClient, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*Workloads, error) {
+	log.Print("Getting lists of all workloads")
+	return GetWorkloadsFromChannels(ctx, GetWorkloadChannels(ctx, client, nsQuery), metricClient, dsQuery)
+}
+
+// GetWorkloadChannels returns channels of all lists required by GetWorkloadsFromChannels. Pods
+// and events are listed only once and shared by all workload lists.
+func GetWorkloadChannels(ctx context.Context, client client.Interface, nsQuery *common.NamespaceQuery) *common.ResourceChannels {
+	return &common.ResourceChannels{
+		DeploymentList:            common.GetDeploymentListChannel(ctx, client, nsQuery, 1),
+		ReplicaSetList:            common.GetReplicaSetListChannel(ctx, client, nsQuery, 2),
+		ReplicationControllerList: common.GetReplicationControllerListChannel(ctx, client, nsQuery, 1),
+		DaemonSetList:             common.GetDaemonSetListChannel(ctx, client, nsQuery, 1),
+		StatefulSetList:           common.GetStatefulSetListChannel(ctx, client, nsQuery, 1),
+		JobList:                   common.GetJobListChannel(ctx, client, nsQuery, 1),
+		CronJobList:               common.GetCronJobListChannel(ctx, client, nsQuery, 1),
+		PodList:                   common.GetPodListChannel(ctx, client, nsQuery, 7),
+		EventList:                 common.GetEventListChannel(ctx, client, nsQuery, 7),
+	}
+}
+
+// GetWorkloadsFromChannels returns the first page of every workload list reading required resource
+// lists from the channels. Lists are built concurrently, because each of them may download metrics.
+func GetWorkloadsFromChannels(ctx context.Context, channels *common.ResourceChannels,
+	metricClient metricapi.MetricClient, dsQuery *dataselect.DataSelectQuery) (*Workloads, error) {
+	dsQuery = FirstPageQuery(dsQuery)
+	workloads := &Workloads{}
+
+	err := readConcurrently(
+		func() (err error) {
+			workloads.DeploymentList, err = deployment.GetDeploymentListFromChannels(ctx, channels, dsQuery, metricClient)
+			return
+		},
+		func() (err error) {
+			workloads.ReplicaSetList, err = replicaset.GetReplicaSetListFromChannels(ctx, channels, dsQuery, metricClient)
+			return
+		},
+		func() (err error) {
+			workloads.ReplicationControllerList, err = replicationcontroller.GetReplicationControllerListFromChannels(
+				ctx, channels, dsQuery, metricClient)
+			return
+		},
+		func() (err error) {
+			workloads.DaemonSetList, err = daemonset.GetDaemonSetListFromChannels(ctx, channels, dsQuery, metricClient)
+			return
+		},
+		func() (err error) {
+			workloads.StatefulSetList, err = statefulset.GetStatefulSetListFromChannels(ctx, channels, dsQuery, metricClient)
+			return
+		},
+		func() (err error) {
+			workloads.JobList, err = job.GetJobListFromChannels(ctx, channels, dsQuery, metricClient)
+			return
+		},
+		func() (err error) {
+			workloads.CronJobList, err = cronjob.GetCronJobListFromChannels(ctx, channels, dsQuery, metricClient)
+			return
+		},
+		func() (err error) {
+			workloads.PodList, err = pod.GetPodListFromChannels(ctx, channels, dsQuery, metricClient)
+			return
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	workloads.Status = map[api.ResourceKind]common.ResourceStatus{
+		api.ResourceKindDeployment:            workloads.DeploymentList.Status,
+		api.ResourceKindReplicaSet:            workloads.ReplicaSetList.Status,
+		api.ResourceKindReplicationController: workloads.ReplicationControllerList.Status,
+		api.ResourceKindDaemonSet:             workloads.DaemonSetList.Status,
+		api.ResourceKindStatefulSet:           workloads.StatefulSetList.Status,
+		api.ResourceKindJob:                   workloads.JobList.Status,
+		api.ResourceKindCronJob:               workloads.CronJobList.Status,
+		api.ResourceKindPod:                   workloads.PodList.Status,
+	}
+
+	workloads.CumulativeMetrics = workloads.PodList.CumulativeMetrics
+	if workloads.CumulativeMetrics == nil {
+		workloads.CumulativeMetrics = make([]metricapi.Metric, 0)
+	}
+
+	workloads.Errors = errors.MergeErrors(
+		workloads.DeploymentList.Errors,
+		workloads.ReplicaSetList.Errors,
+		workloads.ReplicationControllerList.Errors,
+		workloads.DaemonSetList.Errors,
+		workloads.StatefulSetList.Errors,
+		workloads.JobList.Errors,
+		workloads.CronJobList.Errors,
+		workloads.PodList.Errors,
+	)
+
+	return workloads, nil
+}
+
+// FirstPageQuery returns a copy of the query that selects the first page of a list. Cursors and
+// selectors are specific to a single kind, so they are not used when many kinds are listed at once.
+func FirstPageQuery(dsQuery *dataselect.DataSelectQuery) *dataselect.DataSelectQuery {
+	itemsPerPage := DefaultItemsPerPage
+	if dsQuery.PaginationQuery != nil && dsQuery.PaginationQuery.ItemsPerPage > 0 {
+		itemsPerPage = dsQuery.PaginationQuery.ItemsPerPage
+	}
+
+	return dataselect.NewDataSelectQuery(dataselect.NewPaginationQuery(itemsPerPage, 0), dsQuery.SortQuery,
+		dsQuery.FilterQuery, dsQuery.MetricQuery)
+}
+
+// readConcurrently runs all readers at once and returns the first critical error.
+func readConcurrently(readers ...func() error) error {
+	errs := make([]error, len(readers))
+
+	var wg sync.WaitGroup
+	for i, reader := range readers {
+		wg.Add(1)
+		go func(i int, reader func() error) {
+			defer wg.Done()
+			errs[i] = reader()
+		}(i, reader)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
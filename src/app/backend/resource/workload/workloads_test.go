@@ -0,0 +1,104 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workload
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+func TestGetWorkloads(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&apps.Deployment{ObjectMeta: metaV1.ObjectMeta{Name: "checkout", Namespace: "shop"}},
+		&apps.StatefulSet{ObjectMeta: metaV1.ObjectMeta{Name: "db", Namespace: "shop"}},
+		&v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{Name: "checkout-1", Namespace: "shop"},
+			Status: v1.PodStatus{
+				Phase: v1.PodRunning,
+				Conditions: []v1.PodCondition{
+					{Type: v1.PodInitialized, Status: v1.ConditionTrue},
+					{Type: v1.PodReady, Status: v1.ConditionTrue},
+				},
+			},
+		},
+		&v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{Name: "checkout-2", Namespace: "shop"},
+			Status:     v1.PodStatus{Phase: v1.PodPending},
+		},
+		&v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{Name: "checkout-3", Namespace: "shop"},
+			Status:     v1.PodStatus{Phase: v1.PodFailed},
+		},
+	)
+
+	dsQuery := dataselect.NewDataSelectQuery(dataselect.NewPaginationQuery(2, 3), dataselect.NoSort,
+		dataselect.NoFilter, dataselect.NoMetrics)
+	actual, err := GetWorkloads(context.TODO(), client, nil, common.NewSameNamespaceQuery("shop"), dsQuery)
+	if err != nil {
+		t.Fatalf("GetWorkloads() returned error: %s", err)
+	}
+
+	if len(actual.DeploymentList.Deployments) != 1 || len(actual.StatefulSetList.StatefulSets) != 1 {
+		t.Errorf("GetWorkloads() should return all workloads, got %#v", actual)
+	}
+	if len(actual.PodList.Pods) != 2 || actual.PodList.ListMeta.TotalItems != 3 {
+		t.Errorf("GetWorkloads() should return the first page of pods, got %d pods of %d",
+			len(actual.PodList.Pods), actual.PodList.ListMeta.TotalItems)
+	}
+
+	expectedPodStatus := common.ResourceStatus{Running: 1, Pending: 1, Failed: 1}
+	if !reflect.DeepEqual(actual.Status[api.ResourceKindPod], expectedPodStatus) {
+		t.Errorf("GetWorkloads() pod status == %#v, expected %#v", actual.Status[api.ResourceKindPod],
+			expectedPodStatus)
+	}
+	if len(actual.Status) != 8 {
+		t.Errorf("GetWorkloads() should return status of all 8 workload kinds, got %d", len(actual.Status))
+	}
+}
+
+func TestFirstPageQuery(t *testing.T) {
+	cases := []struct {
+		pagination *dataselect.PaginationQuery
+		expected   *dataselect.PaginationQuery
+	}{
+		{dataselect.NoPagination, dataselect.NewPaginationQuery(DefaultItemsPerPage, 0)},
+		{dataselect.NewPaginationQuery(25, 4), dataselect.NewPaginationQuery(25, 0)},
+	}
+
+	for _, c := range cases {
+		dsQuery := dataselect.NewDataSelectQuery(c.pagination, dataselect.NoSort, dataselect.NoFilter,
+			dataselect.NoMetrics)
+		dsQuery.CursorQuery = &dataselect.CursorQuery{Limit: 5}
+
+		actual := FirstPageQuery(dsQuery)
+		if !reflect.DeepEqual(actual.PaginationQuery, c.expected) {
+			t.Errorf("FirstPageQuery(%#v) pagination == %#v, expected %#v", c.pagination,
+				actual.PaginationQuery, c.expected)
+		}
+		if actual.CursorQuery != nil {
+			t.Errorf("FirstPageQuery() should drop the cursor query")
+		}
+	}
+}
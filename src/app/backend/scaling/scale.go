@@ -37,14 +37,14 @@ type ReplicaCounts struct {
 }
 
 // GetReplicaCounts returns a populated ReplicaCounts object with desired and actual number of replicas.
-func GetReplicaCounts(cfg *rest.Config, kind, namespace, name string) (*ReplicaCounts, error) {
+func GetReplicaCounts(ctx context.Context, cfg *rest.Config, kind, namespace, name string) (*ReplicaCounts, error) {
 	sc, err := getScaleGetter(cfg)
 	if err != nil {
 		return nil, err
 	}
 
 	gr := getGroupResource(kind)
-	res, err := sc.Scales(namespace).Get(context.TODO(), gr, name, metaV1.GetOptions{})
+	res, err := sc.Scales(namespace).Get(ctx, gr, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -58,14 +58,14 @@ func GetReplicaCounts(cfg *rest.Config, kind, namespace, name string) (*ReplicaC
 // ScaleResource scales the provided resource using the client scale method in the case of Deployment,
 // ReplicaSet, Replication Controller. In the case of a job we are using the jobs resource update
 // method since the client scale method does not provide one for the job.
-func ScaleResource(cfg *rest.Config, kind, namespace, name, count string) (*ReplicaCounts, error) {
+func ScaleResource(ctx context.Context, cfg *rest.Config, kind, namespace, name, count string) (*ReplicaCounts, error) {
 	sc, err := getScaleGetter(cfg)
 	if err != nil {
 		return nil, err
 	}
 
 	gr := getGroupResource(kind)
-	res, err := sc.Scales(namespace).Get(context.TODO(), gr, name, metaV1.GetOptions{})
+	res, err := sc.Scales(namespace).Get(ctx, gr, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +77,7 @@ func ScaleResource(cfg *rest.Config, kind, namespace, name, count string) (*Repl
 
 	res.Spec.Replicas = int32(c)
 
-	res, err = sc.Scales(namespace).Update(context.TODO(), gr, res, metaV1.UpdateOptions{})
+	res, err = sc.Scales(namespace).Update(ctx, gr, res, metaV1.UpdateOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 
 	corev1 "k8s.io/api/core/v1"
@@ -52,15 +53,15 @@ const (
 // SettingsManager is used for user settings management.
 type SettingsManager interface {
 	// GetGlobalSettings gets current global settings from config map.
-	GetGlobalSettings(client kubernetes.Interface) (s Settings)
+	GetGlobalSettings(ctx context.Context, client kubernetes.Interface) (s Settings)
 	// SaveGlobalSettings saves provided global settings in config map.
-	SaveGlobalSettings(client kubernetes.Interface, s *Settings) error
+	SaveGlobalSettings(ctx context.Context, client kubernetes.Interface, s *Settings) error
 	// GetPinnedResources gets the pinned resources from config map.
-	GetPinnedResources(client kubernetes.Interface) (r []PinnedResource)
+	GetPinnedResources(ctx context.Context, client kubernetes.Interface) (r []PinnedResource)
 	// SavePinnedResource adds a new pinned resource to config map.
-	SavePinnedResource(client kubernetes.Interface, r *PinnedResource) error
+	SavePinnedResource(ctx context.Context, client kubernetes.Interface, r *PinnedResource) error
 	// DeletePinnedResource removes a pinned resource from config map.
-	DeletePinnedResource(client kubernetes.Interface, r *PinnedResource) error
+	DeletePinnedResource(ctx context.Context, client kubernetes.Interface, r *PinnedResource) error
 }
 
 // PinnedResource represents a pinned resource.
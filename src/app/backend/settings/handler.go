@@ -85,7 +85,7 @@ func (self *SettingsHandler) handleSettingsGlobalCanI(request *restful.Request,
 
 func (self *SettingsHandler) handleSettingsGlobalGet(request *restful.Request, response *restful.Response) {
 	client := self.clientManager.InsecureClient()
-	result := self.manager.GetGlobalSettings(client)
+	result := self.manager.GetGlobalSettings(request.Request.Context(), client)
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
@@ -102,7 +102,7 @@ func (self *SettingsHandler) handleSettingsGlobalSave(request *restful.Request,
 		return
 	}
 
-	if err := self.manager.SaveGlobalSettings(client, settings); err != nil {
+	if err := self.manager.SaveGlobalSettings(request.Request.Context(), client, settings); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
@@ -111,7 +111,7 @@ func (self *SettingsHandler) handleSettingsGlobalSave(request *restful.Request,
 
 func (self *SettingsHandler) handleSettingsGetPinned(request *restful.Request, response *restful.Response) {
 	client := self.clientManager.InsecureClient()
-	result := self.manager.GetPinnedResources(client)
+	result := self.manager.GetPinnedResources(request.Request.Context(), client)
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
@@ -128,7 +128,7 @@ func (self *SettingsHandler) handleSettingsSavePinned(request *restful.Request,
 		return
 	}
 
-	if err := self.manager.SavePinnedResource(client, pinnedResource); err != nil {
+	if err := self.manager.SavePinnedResource(request.Request.Context(), client, pinnedResource); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
@@ -148,7 +148,7 @@ func (self *SettingsHandler) handleSettingsDeletePinned(request *restful.Request
 		return
 	}
 
-	if err := self.manager.DeletePinnedResource(client, pinnedResource); err != nil {
+	if err := self.manager.DeletePinnedResource(request.Request.Context(), client, pinnedResource); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
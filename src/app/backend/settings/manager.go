@@ -47,12 +47,13 @@ func NewSettingsManager() api.SettingsManager {
 }
 
 // load config map data into settings manager and return true if new settings are different.
-func (sm *SettingsManager) load(client kubernetes.Interface) (configMap *v1.ConfigMap, isDifferent bool) {
+func (sm *SettingsManager) load(ctx context.Context, client kubernetes.Interface) (configMap *v1.ConfigMap,
+	isDifferent bool) {
 	configMap, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).
-		Get(context.TODO(), api.SettingsConfigMapName, metav1.GetOptions{})
+		Get(ctx, api.SettingsConfigMapName, metav1.GetOptions{})
 	if err != nil {
 		log.Printf("Cannot find settings config map: %s", err.Error())
-		sm.restoreConfigMap(client)
+		sm.restoreConfigMap(ctx, client)
 		return
 	}
 
@@ -88,9 +89,9 @@ func (sm *SettingsManager) load(client kubernetes.Interface) (configMap *v1.Conf
 }
 
 // restoreConfigMap restores settings config map using default global settings.
-func (sm *SettingsManager) restoreConfigMap(client kubernetes.Interface) {
+func (sm *SettingsManager) restoreConfigMap(ctx context.Context, client kubernetes.Interface) {
 	restoredConfigMap, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).
-		Create(context.TODO(), api.GetDefaultSettingsConfigMap(args.Holder.GetNamespace()), metav1.CreateOptions{})
+		Create(ctx, api.GetDefaultSettingsConfigMap(args.Holder.GetNamespace()), metav1.CreateOptions{})
 	if err != nil {
 		log.Printf("Cannot restore settings config map: %s", err.Error())
 	} else {
@@ -101,8 +102,8 @@ func (sm *SettingsManager) restoreConfigMap(client kubernetes.Interface) {
 }
 
 // GetGlobalSettings implements SettingsManager interface. Check it for more information.
-func (sm *SettingsManager) GetGlobalSettings(client kubernetes.Interface) api.Settings {
-	cm, _ := sm.load(client)
+func (sm *SettingsManager) GetGlobalSettings(ctx context.Context, client kubernetes.Interface) api.Settings {
+	cm, _ := sm.load(ctx, client)
 	if cm == nil {
 		return api.GetDefaultSettings()
 	}
@@ -116,8 +117,8 @@ func (sm *SettingsManager) GetGlobalSettings(client kubernetes.Interface) api.Se
 }
 
 // GetGlobalSettings implements SettingsManager interface. Check it for more information.
-func (sm *SettingsManager) SaveGlobalSettings(client kubernetes.Interface, s *api.Settings) error {
-	cm, isDiff := sm.load(client)
+func (sm *SettingsManager) SaveGlobalSettings(ctx context.Context, client kubernetes.Interface, s *api.Settings) error {
+	cm, isDiff := sm.load(ctx, client)
 	if isDiff {
 		return errors.NewInvalid(api.ConcurrentSettingsChangeError)
 	}
@@ -127,14 +128,15 @@ func (sm *SettingsManager) SaveGlobalSettings(client kubernetes.Interface, s *ap
 		cm.Data = make(map[string]string)
 	}
 
-	defer sm.load(client)
+	defer sm.load(ctx, client)
 	cm.Data[api.GlobalSettingsKey] = s.Marshal()
-	_, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	_, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).Update(ctx, cm, metav1.UpdateOptions{})
 	return err
 }
 
-func (sm *SettingsManager) GetPinnedResources(client kubernetes.Interface) (r []api.PinnedResource) {
-	cm, _ := sm.load(client)
+func (sm *SettingsManager) GetPinnedResources(ctx context.Context,
+	client kubernetes.Interface) (r []api.PinnedResource) {
+	cm, _ := sm.load(ctx, client)
 	if cm == nil {
 		return
 	}
@@ -142,8 +144,9 @@ func (sm *SettingsManager) GetPinnedResources(client kubernetes.Interface) (r []
 	return sm.pinnedResources
 }
 
-func (sm *SettingsManager) SavePinnedResource(client kubernetes.Interface, r *api.PinnedResource) error {
-	cm, isDiff := sm.load(client)
+func (sm *SettingsManager) SavePinnedResource(ctx context.Context, client kubernetes.Interface,
+	r *api.PinnedResource) error {
+	cm, isDiff := sm.load(ctx, client)
 	if isDiff {
 		return errors.NewInvalid(api.ConcurrentSettingsChangeError)
 	}
@@ -164,15 +167,16 @@ func (sm *SettingsManager) SavePinnedResource(client kubernetes.Interface, r *ap
 		return errors.NewGenericResponse(http.StatusConflict, api.ResourceAlreadyPinnedError)
 	}
 
-	defer sm.load(client)
+	defer sm.load(ctx, client)
 	sm.pinnedResources = append(sm.pinnedResources, *r)
 	cm.Data[api.PinnedResourcesKey] = api.MarshalPinnedResources(sm.pinnedResources)
-	_, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	_, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).Update(ctx, cm, metav1.UpdateOptions{})
 	return err
 }
 
-func (sm *SettingsManager) DeletePinnedResource(client kubernetes.Interface, r *api.PinnedResource) error {
-	cm, isDiff := sm.load(client)
+func (sm *SettingsManager) DeletePinnedResource(ctx context.Context, client kubernetes.Interface,
+	r *api.PinnedResource) error {
+	cm, isDiff := sm.load(ctx, client)
 	if isDiff {
 		return errors.NewInvalid(api.ConcurrentSettingsChangeError)
 	}
@@ -193,9 +197,9 @@ func (sm *SettingsManager) DeletePinnedResource(client kubernetes.Interface, r *
 		return errors.NewNotFound(api.PinnedResourceNotFoundError)
 	}
 
-	defer sm.load(client)
+	defer sm.load(ctx, client)
 	sm.pinnedResources = append(sm.pinnedResources[:index], sm.pinnedResources[index+1:]...)
 	cm.Data[api.PinnedResourcesKey] = api.MarshalPinnedResources(sm.pinnedResources)
-	_, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	_, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).Update(ctx, cm, metav1.UpdateOptions{})
 	return err
 }
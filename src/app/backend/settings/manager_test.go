@@ -15,6 +15,7 @@
 package settings
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -33,7 +34,7 @@ func TestNewSettingsManager(t *testing.T) {
 func TestSettingsManager_GetGlobalSettings(t *testing.T) {
 	sm := NewSettingsManager()
 	client := fake.NewSimpleClientset(api.GetDefaultSettingsConfigMap(""))
-	gs := sm.GetGlobalSettings(client)
+	gs := sm.GetGlobalSettings(context.TODO(), client)
 
 	if !reflect.DeepEqual(api.GetDefaultSettings(), gs) {
 		t.Errorf("it should return default settings \"%v\" instead of \"%v\"", api.GetDefaultSettings(), gs)
@@ -44,7 +45,7 @@ func TestSettingsManager_SaveGlobalSettings(t *testing.T) {
 	sm := NewSettingsManager()
 	client := fake.NewSimpleClientset(api.GetDefaultSettingsConfigMap(""))
 	defaults := api.GetDefaultSettings()
-	err := sm.SaveGlobalSettings(client, &defaults)
+	err := sm.SaveGlobalSettings(context.TODO(), client, &defaults)
 
 	if err == nil {
 		t.Errorf("it should fail with \"%s\" error if trying to save but manager has deprecated data",
@@ -56,7 +57,7 @@ func TestSettingsManager_SaveGlobalSettings(t *testing.T) {
 			api.ConcurrentSettingsChangeError, err.Error())
 	}
 
-	err = sm.SaveGlobalSettings(client, &defaults)
+	err = sm.SaveGlobalSettings(context.TODO(), client, &defaults)
 
 	if err != nil {
 		t.Errorf("it should save settings if manager has no deprecated data instead of failing with \"%s\" error",
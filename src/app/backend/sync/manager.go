@@ -23,6 +23,9 @@ import (
 // Implements SynchronizerManager interface.
 type synchronizerManager struct {
 	client kubernetes.Interface
+	// Name of the cluster to which client connects. It is added to names of synchronizers when Dashboard serves
+	// multiple clusters.
+	cluster string
 }
 
 // Secret implements synchronizer manager. See SynchronizerManager interface for more information.
@@ -30,6 +33,7 @@ func (self *synchronizerManager) Secret(namespace, name string) syncApi.Synchron
 	return &secretSynchronizer{
 		namespace:      namespace,
 		name:           name,
+		cluster:        self.cluster,
 		client:         self.client,
 		actionHandlers: make(map[watch.EventType][]syncApi.ActionHandlerFunction),
 	}
@@ -39,3 +43,9 @@ func (self *synchronizerManager) Secret(namespace, name string) syncApi.Synchron
 func NewSynchronizerManager(client kubernetes.Interface) syncApi.SynchronizerManager {
 	return &synchronizerManager{client: client}
 }
+
+// NewClusterSynchronizerManager creates new instance of SynchronizerManager, that creates synchronizers of given
+// cluster. Their names contain the cluster name, so they are unique among synchronizers of all served clusters.
+func NewClusterSynchronizerManager(client kubernetes.Interface, cluster string) syncApi.SynchronizerManager {
+	return &synchronizerManager{client: client, cluster: cluster}
+}
@@ -18,6 +18,8 @@ import (
 	"testing"
 
 	"k8s.io/client-go/kubernetes/fake"
+
+	syncApi "github.com/kubernetes/dashboard/src/app/backend/sync/api"
 )
 
 func TestNewSynchronizerManager(t *testing.T) {
@@ -33,3 +35,19 @@ func TestSynchronizerManager_Secret(t *testing.T) {
 		t.Fatalf("Secret(%s, %s): Expected secret synchronizer not to be nil", "", "")
 	}
 }
+
+func TestClusterSynchronizerManager_Secret(t *testing.T) {
+	cases := []struct {
+		manager  syncApi.SynchronizerManager
+		expected string
+	}{
+		{NewSynchronizerManager(fake.NewSimpleClientset()), "key-holder-kube-system"},
+		{NewClusterSynchronizerManager(fake.NewSimpleClientset(), "production"), "key-holder-kube-system-production"},
+	}
+
+	for _, c := range cases {
+		if name := c.manager.Secret("kube-system", "key-holder").Name(); name != c.expected {
+			t.Errorf("Name(): Expected synchronizer name %s, got %s", c.expected, name)
+		}
+	}
+}
@@ -17,6 +17,8 @@ package sync
 import (
 	"fmt"
 	"log"
+	"sort"
+	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -34,6 +36,7 @@ func init() {
 		syncMap:      make(map[string]syncApi.Synchronizer),
 		policyMap:    make(map[string]RestartPolicy),
 		restartCount: make(map[string]int),
+		errorMap:     make(map[string]error),
 
 		registrationSignal: make(chan string),
 		restartSignal:      make(chan string),
@@ -61,23 +64,57 @@ type overwatch struct {
 	syncMap      map[string]syncApi.Synchronizer
 	policyMap    map[string]RestartPolicy
 	restartCount map[string]int
+	errorMap     map[string]error
 
 	registrationSignal chan string
 	restartSignal      chan string
+
+	mux sync.RWMutex
 }
 
 // RegisterSynchronizer registers given synchronizer with given restart policy.
 func (self *overwatch) RegisterSynchronizer(synchronizer syncApi.Synchronizer, policy RestartPolicy) {
+	self.mux.Lock()
 	if _, exists := self.syncMap[synchronizer.Name()]; exists {
+		self.mux.Unlock()
 		log.Printf("Synchronizer %s is already registered. Skipping", synchronizer.Name())
 		return
 	}
 
 	self.syncMap[synchronizer.Name()] = synchronizer
 	self.policyMap[synchronizer.Name()] = policy
+	self.mux.Unlock()
 	self.broadcastRegistrationEvent(synchronizer.Name())
 }
 
+// Check returns an error if any of registered synchronizers exited with error and was not restarted yet, or did
+// not sync its object from the server.
+func (self *overwatch) Check() error {
+	self.mux.RLock()
+	names := make([]string, 0, len(self.syncMap))
+	for name := range self.syncMap {
+		names = append(names, name)
+	}
+	self.mux.RUnlock()
+	sort.Strings(names)
+
+	for _, name := range names {
+		self.mux.RLock()
+		synchronizer, err := self.syncMap[name], self.errorMap[name]
+		self.mux.RUnlock()
+
+		if err != nil {
+			return fmt.Errorf("synchronizer %s exited with error: %s", name, err.Error())
+		}
+
+		if synchronizer.Get() == nil {
+			return fmt.Errorf("synchronizer %s did not sync its object yet", name)
+		}
+	}
+
+	return nil
+}
+
 // Run starts overwatch.
 func (self *overwatch) Run() {
 	self.monitorRegistrationEvents()
@@ -93,7 +130,10 @@ func (self *overwatch) monitorRestartEvents() {
 			}
 
 			log.Printf("Restarting synchronizer: %s.", name)
+			self.mux.Lock()
 			synchronizer := self.syncMap[name]
+			delete(self.errorMap, name)
+			self.mux.Unlock()
 			synchronizer.Start()
 			self.monitorSynchronizerStatus(synchronizer)
 		}
@@ -104,7 +144,9 @@ func (self *overwatch) monitorRegistrationEvents() {
 	go wait.Forever(func() {
 		select {
 		case name := <-self.registrationSignal:
+			self.mux.RLock()
 			synchronizer := self.syncMap[name]
+			self.mux.RUnlock()
 			log.Printf("New synchronizer has been registered: %s. Starting", name)
 			self.monitorSynchronizerStatus(synchronizer)
 			synchronizer.Start()
@@ -119,7 +161,11 @@ func (self *overwatch) monitorSynchronizerStatus(synchronizer syncApi.Synchroniz
 		select {
 		case err := <-synchronizer.Error():
 			log.Printf("Synchronizer %s exited with error: %s", name, err.Error())
-			if self.policyMap[name] == AlwaysRestart {
+			self.mux.Lock()
+			self.errorMap[name] = err
+			policy := self.policyMap[name]
+			self.mux.Unlock()
+			if policy == AlwaysRestart {
 				// Wait a sec before restarting synchronizer in case it exited with error.
 				time.Sleep(RestartDelay)
 				self.broadcastRestartEvent(name)
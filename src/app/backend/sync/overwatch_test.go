@@ -0,0 +1,71 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"errors"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	syncApi "github.com/kubernetes/dashboard/src/app/backend/sync/api"
+)
+
+type fakeSynchronizer struct {
+	name   string
+	object runtime.Object
+}
+
+func (self *fakeSynchronizer) Name() string                    { return self.name }
+func (self *fakeSynchronizer) Start()                          {}
+func (self *fakeSynchronizer) Error() chan error               { return nil }
+func (self *fakeSynchronizer) Create(runtime.Object) error     { return nil }
+func (self *fakeSynchronizer) Get() runtime.Object             { return self.object }
+func (self *fakeSynchronizer) Update(runtime.Object) error     { return nil }
+func (self *fakeSynchronizer) Delete() error                   { return nil }
+func (self *fakeSynchronizer) Refresh()                        {}
+func (self *fakeSynchronizer) SetPoller(poller syncApi.Poller) {}
+func (self *fakeSynchronizer) RegisterActionHandler(syncApi.ActionHandlerFunction, ...watch.EventType) {
+}
+
+func TestOverwatchCheck(t *testing.T) {
+	cases := []struct {
+		info         string
+		synchronizer *fakeSynchronizer
+		err          error
+		expectError  bool
+	}{
+		{"synced synchronizer", &fakeSynchronizer{name: "key", object: &v1.Secret{}}, nil, false},
+		{"not synced synchronizer", &fakeSynchronizer{name: "key"}, nil, true},
+		{"failed synchronizer", &fakeSynchronizer{name: "key", object: &v1.Secret{}}, errors.New("watch ended"), true},
+	}
+
+	for _, c := range cases {
+		o := &overwatch{
+			syncMap:  map[string]syncApi.Synchronizer{c.synchronizer.name: c.synchronizer},
+			errorMap: map[string]error{},
+		}
+		if c.err != nil {
+			o.errorMap[c.synchronizer.name] = c.err
+		}
+
+		err := o.Check()
+		if (err != nil) != c.expectError {
+			t.Errorf("Test Case: %s. Expected error: %v, got %v", c.info, c.expectError, err)
+		}
+	}
+}
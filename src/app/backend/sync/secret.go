@@ -40,6 +40,7 @@ const secretSyncPeriod = 5 * time.Minute
 type secretSynchronizer struct {
 	namespace string
 	name      string
+	cluster   string
 
 	secret         *v1.Secret
 	client         kubernetes.Interface
@@ -52,6 +53,10 @@ type secretSynchronizer struct {
 
 // Name implements Synchronizer interface. See Synchronizer for more information.
 func (self *secretSynchronizer) Name() string {
+	if len(self.cluster) > 0 {
+		return fmt.Sprintf("%s-%s-%s", self.name, self.namespace, self.cluster)
+	}
+
 	return fmt.Sprintf("%s-%s", self.name, self.namespace)
 }
 
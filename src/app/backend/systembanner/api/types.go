@@ -18,6 +18,8 @@ package api
 type SystemBannerManager interface {
 	// Get system banner.
 	Get() *SystemBanner
+	// Set system banner message and severity.
+	Set(message, severity string)
 }
 
 // SystemBanner represents system banner.
@@ -23,7 +23,7 @@ import (
 
 // SystemBannerHandler manages all endpoints related to system banner management.
 type SystemBannerHandler struct {
-	manager SystemBannerManager
+	manager *SystemBannerManager
 }
 
 // Install creates new endpoints for system banner management.
@@ -39,6 +39,6 @@ func (self *SystemBannerHandler) handleGet(request *restful.Request, response *r
 }
 
 // NewSystemBannerHandler creates SystemBannerHandler.
-func NewSystemBannerHandler(manager SystemBannerManager) SystemBannerHandler {
+func NewSystemBannerHandler(manager *SystemBannerManager) SystemBannerHandler {
 	return SystemBannerHandler{manager: manager}
 }
@@ -15,25 +15,37 @@
 package systembanner
 
 import (
+	"sync"
+
 	"github.com/kubernetes/dashboard/src/app/backend/systembanner/api"
 )
 
 // SystemBannerManager is a structure containing all system banner manager members.
 type SystemBannerManager struct {
 	systemBanner api.SystemBanner
+	mux          sync.RWMutex
 }
 
 // NewSystemBannerManager creates new settings manager.
-func NewSystemBannerManager(message, severity string) SystemBannerManager {
-	return SystemBannerManager{
-		systemBanner: api.SystemBanner{
-			Message:  message,
-			Severity: api.GetSeverity(severity),
-		},
-	}
+func NewSystemBannerManager(message, severity string) *SystemBannerManager {
+	sbm := &SystemBannerManager{}
+	sbm.Set(message, severity)
+	return sbm
 }
 
 // Get implements SystemBannerManager interface. Check it for more information.
 func (sbm *SystemBannerManager) Get() api.SystemBanner {
+	sbm.mux.RLock()
+	defer sbm.mux.RUnlock()
 	return sbm.systemBanner
 }
+
+// Set implements SystemBannerManager interface. Check it for more information.
+func (sbm *SystemBannerManager) Set(message, severity string) {
+	sbm.mux.Lock()
+	defer sbm.mux.Unlock()
+	sbm.systemBanner = api.SystemBanner{
+		Message:  message,
+		Severity: api.GetSeverity(severity),
+	}
+}
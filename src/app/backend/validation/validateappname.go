@@ -38,13 +38,13 @@ type AppNameValidity struct {
 
 // ValidateAppName validates application name. When error is returned, name validity could not be
 // determined.
-func ValidateAppName(spec *AppNameValiditySpec, client client.Interface) (*AppNameValidity, error) {
+func ValidateAppName(ctx context.Context, spec *AppNameValiditySpec, client client.Interface) (*AppNameValidity, error) {
 	log.Printf("Validating %s application name in %s namespace", spec.Name, spec.Namespace)
 
 	isValidDeployment := false
 	isValidService := false
 
-	_, err := client.AppsV1().Deployments(spec.Namespace).Get(context.TODO(), spec.Name, metaV1.GetOptions{})
+	_, err := client.AppsV1().Deployments(spec.Namespace).Get(ctx, spec.Name, metaV1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFoundError(err) || errors.IsForbiddenError(err) {
 			isValidDeployment = true
@@ -53,7 +53,7 @@ func ValidateAppName(spec *AppNameValiditySpec, client client.Interface) (*AppNa
 		}
 	}
 
-	_, err = client.CoreV1().Services(spec.Namespace).Get(context.TODO(), spec.Name, metaV1.GetOptions{})
+	_, err = client.CoreV1().Services(spec.Namespace).Get(ctx, spec.Name, metaV1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFoundError(err) || errors.IsForbiddenError(err) {
 			isValidService = true
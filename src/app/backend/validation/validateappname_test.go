@@ -15,6 +15,7 @@
 package validation
 
 import (
+	"context"
 	"testing"
 
 	api "k8s.io/api/core/v1"
@@ -73,7 +74,7 @@ func TestValidateName(t *testing.T) {
 
 	for _, c := range cases {
 		testClient := fake.NewSimpleClientset(c.objects...)
-		validity, _ := ValidateAppName(c.spec, testClient)
+		validity, _ := ValidateAppName(context.TODO(), c.spec, testClient)
 		if validity.Valid != c.expected {
 			t.Errorf("Expected %#v validity to be %#v for objects %#v, but was %#v\n",
 				c.spec, c.expected, c.objects, validity)
@@ -46,6 +46,8 @@ const localizedErrors: {[key: string]: string} = {
   MSG_LOGIN_UNAUTHORIZED_ERROR: 'Invalid credentials provided',
   MSG_DEPLOY_NAMESPACE_MISMATCH_ERROR: 'Cannot deploy to the namespace different than the currently selected one.',
   MSG_DEPLOY_EMPTY_NAMESPACE_ERROR: 'Cannot deploy the content as the target namespace is not specified.',
+  MSG_REQUEST_TIMEOUT_ERROR: 'The request has timed out. Try again later or narrow down the request.',
+  MSG_TOO_MANY_REQUESTS_ERROR: 'Too many requests have been sent. Wait a moment and try again.',
 };
 
 /**